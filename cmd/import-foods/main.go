@@ -0,0 +1,71 @@
+// Command import-foods loads a food catalog dump into the foods table.
+//
+//	import-foods -format usda -dir ./FoodData_Central_csv
+//	import-foods -format openfoodfacts -file ./en.openfoodfacts.org.products.csv
+//
+// Re-running it with a newer dump updates the foods imported before.
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+
+	"github.com/msskobelina/fit-profi/internal/infrastructure/foodimport"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/repository/foods"
+	"github.com/msskobelina/fit-profi/pkg/logger"
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+func main() {
+	format := flag.String("format", "", "dump format: usda or openfoodfacts")
+	dir := flag.String("dir", "", "FoodData Central CSV directory (usda)")
+	file := flag.String("file", "", "products CSV export (openfoodfacts)")
+	batch := flag.Int("batch", 1000, "rows per insert")
+	flag.Parse()
+
+	l := logger.New(os.Getenv("LOG_LEVEL"))
+
+	var (
+		read  foodimport.ReadFunc
+		files []*os.File
+	)
+	open := func(path string) *os.File {
+		f, err := os.Open(path)
+		if err != nil {
+			l.Fatal("failed to open dump", "err", err)
+		}
+		files = append(files, f)
+		return f
+	}
+	switch *format {
+	case "usda":
+		read = foodimport.USDA(open(filepath.Join(*dir, "food.csv")), open(filepath.Join(*dir, "food_nutrient.csv")))
+	case "openfoodfacts":
+		read = foodimport.OpenFoodFacts(open(*file))
+	default:
+		l.Fatal("unknown dump format", "format", *format)
+	}
+	defer func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}()
+
+	sql, err := mysql.New(mysql.MySQLConfig{
+		User:     os.Getenv("MYSQL_USER"),
+		Password: os.Getenv("MYSQL_PASSWORD"),
+		Host:     os.Getenv("MYSQL_HOST"),
+		Database: os.Getenv("MYSQL_DATABASE"),
+	})
+	if err != nil {
+		l.Fatal("failed to connect to mysql", "err", err)
+	}
+
+	n, err := foodimport.Load(context.Background(), foods.NewRepository(sql), *batch, read)
+	if err != nil {
+		l.Fatal("food import failed", "err", err, "imported", n)
+	}
+	l.Info("foods imported", "imported", n, "format", *format)
+}
@@ -0,0 +1,40 @@
+// Command reencrypt-tokens seals the OAuth tokens in user_integrations with the
+// current primary key. Run it once after enabling token encryption and again
+// after every key rotation, before the retired key is removed from
+// TOKEN_ENCRYPTION_KEYS.
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/msskobelina/fit-profi/internal/infrastructure/repository/integrations"
+	"github.com/msskobelina/fit-profi/pkg/envelope"
+	"github.com/msskobelina/fit-profi/pkg/logger"
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+func main() {
+	l := logger.New(os.Getenv("LOG_LEVEL"))
+
+	keys, err := envelope.Parse(os.Getenv("TOKEN_ENCRYPTION_KEY_ID"), os.Getenv("TOKEN_ENCRYPTION_KEYS"))
+	if err != nil {
+		l.Fatal("invalid token encryption keys", "err", err)
+	}
+
+	sql, err := mysql.New(mysql.MySQLConfig{
+		User:     os.Getenv("MYSQL_USER"),
+		Password: os.Getenv("MYSQL_PASSWORD"),
+		Host:     os.Getenv("MYSQL_HOST"),
+		Database: os.Getenv("MYSQL_DATABASE"),
+	})
+	if err != nil {
+		l.Fatal("failed to connect to mysql", "err", err)
+	}
+
+	n, err := integrations.Reencrypt(context.Background(), sql, keys)
+	if err != nil {
+		l.Fatal("re-encryption failed", "err", err, "updated", n)
+	}
+	l.Info("tokens re-encrypted", "updated", n, "keyId", keys.PrimaryID())
+}
@@ -15,29 +15,47 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/calendar/list": {
-            "get": {
+        "/admin/exercises/{id}/merge": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns all calendars from the user's connected Google account.",
+                "description": "Folds a duplicate catalog entry into another: its name and aliases become aliases of the remaining entry, program exercises referring to it are repointed and the duplicate is deleted. Admin only.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Calendar"
+                    "Admin"
+                ],
+                "summary": "Merge duplicate exercises",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Duplicate catalog exercise ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Entry to keep",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_admin.MergeExercisesRequest"
+                        }
+                    }
                 ],
-                "summary": "List Google Calendars",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_application_query_calendar.CalendarInfo"
-                            }
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Exercise"
                         }
                     },
                     "400": {
@@ -51,44 +69,68 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/calendar/me/events": {
-            "post": {
+        "/admin/food-submissions": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new event in the user's connected Google Calendar.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns a page of barcode submissions, oldest first. Defaults to the pending ones. Admin only.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Calendar"
+                    "Admin"
                 ],
-                "summary": "Create calendar event",
+                "summary": "List food submissions",
                 "parameters": [
                     {
-                        "description": "Event data",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_delivery_controller_calendar.CreateEventRequest"
-                        }
+                        "enum": [
+                            "pending",
+                            "approved",
+                            "rejected",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "Filter by status (default pending)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/internal_delivery_controller_calendar.CreateEventResult"
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_application_query_admin.ListFoodSubmissionsResult"
                         }
                     },
                     "400": {
@@ -102,41 +144,45 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/integrations/google/callback": {
-            "get": {
-                "description": "Receives the OAuth authorization code from Google and stores the access token.",
+        "/admin/food-submissions/{id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a pending barcode submission to the food catalog. Admin only.",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Integrations"
+                    "Admin"
                 ],
-                "summary": "Google OAuth callback",
+                "summary": "Approve food submission",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "OAuth state parameter",
-                        "name": "state",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "OAuth authorization code",
-                        "name": "code",
-                        "in": "query",
+                        "type": "integer",
+                        "description": "Submission ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Google connected ✓",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.FoodSubmission"
                         }
                     },
                     "400": {
@@ -144,34 +190,27 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/integrations/google/connect": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Redirects the authenticated user to Google OAuth consent screen.",
-                "tags": [
-                    "Integrations"
-                ],
-                "summary": "Connect Google Calendar",
-                "responses": {
-                    "302": {
-                        "description": "Redirect to Google OAuth"
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
@@ -179,38 +218,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/nutrition/entries": {
-            "get": {
+        "/admin/food-submissions/{id}/reject": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns all diary entries for the authenticated user on a given date (default: today).",
+                "description": "Rejects a pending barcode submission with an optional reason. Admin only.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Nutrition"
+                    "Admin"
                 ],
-                "summary": "List nutrition diary entries",
+                "summary": "Reject food submission",
                 "parameters": [
                     {
-                        "type": "string",
-                        "example": "2024-03-15",
-                        "description": "Date in YYYY-MM-DD format",
-                        "name": "date",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "Submission ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rejection reason",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_admin.RejectFoodSubmissionRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryEntry"
-                            }
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.FoodSubmission"
                         }
                     },
                     "400": {
@@ -224,42 +272,79 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/admin/users": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Records a meal with food items for the authenticated user.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns a page of users, optionally filtered by a name/email search string and role. Admin only.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Nutrition"
+                    "Admin"
                 ],
-                "summary": "Create nutrition diary entry",
+                "summary": "List users",
                 "parameters": [
                     {
-                        "description": "Diary entry",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_delivery_controller_nutrition.CreateEntryRequest"
-                        }
+                        "type": "string",
+                        "description": "Search by full name or email",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "user",
+                            "coach",
+                            "admin"
+                        ],
+                        "type": "string",
+                        "description": "Filter by role",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page offset",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryEntry"
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_application_query_admin.ListUsersResult"
                         }
                     },
                     "400": {
@@ -273,40 +358,43 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/nutrition/entries/{id}": {
-            "get": {
+        "/admin/users/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a single diary entry by ID.",
+                "description": "Soft-deletes a user account and revokes its refresh tokens. Admin only.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Nutrition"
+                    "Admin"
                 ],
-                "summary": "Get nutrition diary entry",
+                "summary": "Delete user",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Entry ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryEntry"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -319,16 +407,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/admin/users/{id}/role": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Replaces the meal type and food items of an existing diary entry.",
+                "description": "Promotes or demotes a user. Admins cannot change their own role. Admin only.",
                 "consumes": [
                     "application/json"
                 ],
@@ -336,33 +432,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Nutrition"
+                    "Admin"
                 ],
-                "summary": "Update nutrition diary entry",
+                "summary": "Change user role",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Entry ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated entry data",
+                        "description": "New role",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_delivery_controller_nutrition.UpdateEntryRequest"
+                            "$ref": "#/definitions/internal_delivery_controller_admin.ChangeUserRoleRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryEntry"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -375,30 +468,50 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/admin/users/{id}/status": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a diary entry belonging to the authenticated user.",
+                "description": "Disables or re-enables a user account. Disabling also revokes the user's refresh tokens. Admin only.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Nutrition"
+                    "Admin"
                 ],
-                "summary": "Delete nutrition diary entry",
+                "summary": "Disable or enable user",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Entry ID",
+                        "description": "User ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Account status",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_admin.SetUserStatusRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -416,30 +529,59 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
-                    }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "/profiles/coach": {
+        "/calendar/availability": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the coach profile of the authenticated user.",
+                "description": "Returns the authenticated coach's slots, or the open slots of the caller's coach when coachId is set. Defaults to the next 30 days.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profiles"
+                    "Calendar"
+                ],
+                "summary": "List availability",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Coach user ID (clients only)",
+                        "name": "coachId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window start (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window end (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get coach profile",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachProfile"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachAvailability"
+                            }
                         }
                     },
                     "400": {
@@ -453,16 +595,22 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Replaces the coach profile for the authenticated user.",
+                "description": "Publishes bookable time slots for the authenticated coach. Slots must be in the future and must not overlap each other or existing slots.",
                 "consumes": [
                     "application/json"
                 ],
@@ -470,17 +618,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Profiles"
+                    "Calendar"
                 ],
-                "summary": "Update coach profile",
+                "summary": "Publish availability",
                 "parameters": [
                     {
-                        "description": "Updated coach profile data",
+                        "description": "Availability slots",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_delivery_controller_profiles.UpdateCoachProfileRequest"
+                            "$ref": "#/definitions/internal_delivery_controller_calendar.AddAvailabilityRequest"
                         }
                     }
                 ],
@@ -488,7 +636,10 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachProfile"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachAvailability"
+                            }
                         }
                     },
                     "400": {
@@ -502,52 +653,15 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Creates a coach profile for the authenticated user.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Profiles"
-                ],
-                "summary": "Create coach profile",
-                "parameters": [
-                    {
-                        "description": "Coach profile data",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_delivery_controller_profiles.CreateCoachProfileRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachProfile"
-                        }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
@@ -555,26 +669,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/profiles/user": {
+        "/calendar/availability/exceptions": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns the fitness profile of the authenticated user.",
+                "description": "Returns the exceptions and holidays of the authenticated coach.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profiles"
+                    "Calendar"
                 ],
-                "summary": "Get user profile",
+                "summary": "List availability exceptions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.UserProfile"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.AvailabilityException"
+                            }
                         }
                     },
                     "400": {
@@ -591,13 +708,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Replaces the fitness profile for the authenticated user.",
+                "description": "Removes a local date from one recurring rule, or from all of the coach's rules when ruleId is omitted.",
                 "consumes": [
                     "application/json"
                 ],
@@ -605,17 +722,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Profiles"
+                    "Calendar"
                 ],
-                "summary": "Update user profile",
+                "summary": "Add availability exception",
                 "parameters": [
                     {
-                        "description": "Updated profile data",
+                        "description": "Exception",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_delivery_controller_profiles.UpdateUserProfileRequest"
+                            "$ref": "#/definitions/internal_delivery_controller_calendar.AddAvailabilityExceptionRequest"
                         }
                     }
                 ],
@@ -623,7 +740,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.UserProfile"
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.AvailabilityException"
                         }
                     },
                     "400": {
@@ -637,43 +754,49 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/calendar/availability/exceptions/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a fitness profile for the authenticated user.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Deletes an exception of the authenticated coach, making the date available again.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Profiles"
+                    "Calendar"
                 ],
-                "summary": "Create user profile",
+                "summary": "Delete availability exception",
                 "parameters": [
                     {
-                        "description": "User profile data",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_delivery_controller_profiles.CreateUserProfileRequest"
-                        }
+                        "type": "integer",
+                        "description": "Exception ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.UserProfile"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -686,32 +809,38 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/programs": {
+        "/calendar/availability/rules": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns all training programs belonging to the authenticated user.",
+                "description": "Returns the recurring availability rules of the authenticated coach.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Programs"
+                    "Calendar"
                 ],
-                "summary": "List training programs",
+                "summary": "List recurring availability",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.TrainingProgram"
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.AvailabilityRule"
                             }
                         }
                     },
@@ -735,7 +864,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a new training program with optional days and exercises.",
+                "description": "Adds a recurring availability rule for the authenticated coach. start is local time in timezone; rrule supports FREQ=DAILY|WEEKLY with INTERVAL, BYDAY, COUNT and UNTIL.",
                 "consumes": [
                     "application/json"
                 ],
@@ -743,17 +872,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Programs"
+                    "Calendar"
                 ],
-                "summary": "Create training program",
+                "summary": "Create recurring availability",
                 "parameters": [
                     {
-                        "description": "Program data",
+                        "description": "Recurring rule",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_delivery_controller_programs.CreateProgramRequest"
+                            "$ref": "#/definitions/internal_delivery_controller_calendar.CreateAvailabilityRuleRequest"
                         }
                     }
                 ],
@@ -761,7 +890,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.TrainingProgram"
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.AvailabilityRule"
                         }
                     },
                     "400": {
@@ -775,18 +904,73 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/programs/progress": {
+        "/calendar/availability/rules/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a recurring availability rule of the authenticated coach. Sessions already booked from it are kept.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Delete recurring availability",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/calendar/bookings": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Records a completed set of an exercise for the authenticated user.",
+                "description": "Books one of the open availability slots of the authenticated user's coach. Occurrences of recurring rules are booked by ruleId and start.",
                 "consumes": [
                     "application/json"
                 ],
@@ -794,17 +978,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Programs"
+                    "Calendar"
                 ],
-                "summary": "Track exercise progress",
+                "summary": "Book session",
                 "parameters": [
                     {
-                        "description": "Progress data",
+                        "description": "Slot to book",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_delivery_controller_programs.TrackProgressRequest"
+                            "$ref": "#/definitions/internal_delivery_controller_calendar.BookSessionRequest"
                         }
                     }
                 ],
@@ -812,7 +996,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ExerciseProgress"
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Booking"
                         }
                     },
                     "400": {
@@ -826,39 +1010,45 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/programs/{id}": {
+        "/calendar/bookings/upcoming": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a training program by ID.",
+                "description": "Returns booked sessions that haven't ended yet where the authenticated user is the coach or the client.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Programs"
-                ],
-                "summary": "Get training program",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Program ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Calendar"
                 ],
+                "summary": "List my upcoming sessions",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.TrainingProgram"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Booking"
+                            }
                         }
                     },
                     "400": {
@@ -874,33 +1064,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/calendar/bookings/{id}/cancel": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Deletes a training program owned by the authenticated user.",
+                "description": "Cancels a booked session. Clients must cancel at least 24 hours in advance; coaches can cancel until the session starts.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Programs"
+                    "Calendar"
                 ],
-                "summary": "Delete training program",
+                "summary": "Cancel booking",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Program ID",
+                        "description": "Booking ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Booking"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -913,34 +1108,15 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/users/check": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Returns the user ID and role extracted from the JWT token.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Auth"
-                ],
-                "summary": "Check auth token",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/internal_delivery_controller_authorize.CheckResponse"
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
@@ -948,9 +1124,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/login": {
+        "/calendar/bookings/{id}/reschedule": {
             "post": {
-                "description": "Authenticates the user and returns a JWT token.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a booked session to another open slot of the same coach. Clients must reschedule at least 24 hours in advance.",
                 "consumes": [
                     "application/json"
                 ],
@@ -958,17 +1139,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Calendar"
                 ],
-                "summary": "Login",
+                "summary": "Reschedule booking",
                 "parameters": [
                     {
-                        "description": "Login credentials",
+                        "type": "integer",
+                        "description": "Booking ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New slot",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_delivery_controller_authorize.LoginRequest"
+                            "$ref": "#/definitions/internal_delivery_controller_calendar.RescheduleBookingRequest"
                         }
                     }
                 ],
@@ -976,7 +1164,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_application_command_authorize.LoginUserResult"
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Booking"
                         }
                     },
                     "400": {
@@ -984,41 +1172,82 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/users/logout": {
-            "post": {
+        "/calendar/list": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Revokes the current JWT token.",
+                "description": "Returns all calendars from the user's connected Google account.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Calendar"
                 ],
-                "summary": "Logout",
+                "summary": "List Google Calendars",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_application_query_calendar.CalendarInfo"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/users/register": {
+        "/calendar/me/events": {
             "post": {
-                "description": "Creates a new account and returns a JWT token.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new event in the user's connected Google Calendar.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1026,17 +1255,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Calendar"
                 ],
-                "summary": "Register a new user",
+                "summary": "Create calendar event",
                 "parameters": [
                     {
-                        "description": "Registration payload",
+                        "description": "Event data",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_delivery_controller_authorize.RegisterRequest"
+                            "$ref": "#/definitions/internal_delivery_controller_calendar.CreateEventRequest"
                         }
                     }
                 ],
@@ -1044,7 +1273,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_application_command_authorize.RegisterUserResult"
+                            "$ref": "#/definitions/internal_delivery_controller_calendar.CreateEventResult"
                         }
                     },
                     "400": {
@@ -1052,40 +1281,65 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/users/reset-password": {
-            "patch": {
-                "description": "Resets the user password using a one-time reset token.",
-                "consumes": [
-                    "application/json"
+        "/cardio/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Returns imported runs, rides and other cardio sessions of the authenticated user (or one of their active clients when userId is set), newest first. Defaults to the last 30 days.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Cardio"
                 ],
-                "summary": "Reset password",
+                "summary": "List cardio sessions",
                 "parameters": [
                     {
-                        "description": "Reset token and new password",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_delivery_controller_authorize.ResetPasswordRequest"
-                        }
+                        "type": "integer",
+                        "description": "Client user ID (coaches only)",
+                        "name": "userId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window start (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window end (RFC3339)",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CardioSession"
+                            }
                         }
                     },
                     "400": {
@@ -1093,40 +1347,59 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/users/send-email": {
-            "post": {
-                "description": "Sends a one-time password-reset link to the given email address.",
-                "consumes": [
-                    "application/json"
+        "/coaching/clients": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Returns the coaching relationships of the authenticated coach, optionally filtered by status.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Coaching"
                 ],
-                "summary": "Send password-reset email",
+                "summary": "List my clients",
                 "parameters": [
                     {
-                        "description": "Email address",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/internal_delivery_controller_authorize.SendEmailRequest"
-                        }
+                        "enum": [
+                            "pending",
+                            "active",
+                            "rejected",
+                            "terminated"
+                        ],
+                        "type": "string",
+                        "description": "Relationship status",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachingRelationship"
+                            }
                         }
                     },
                     "400": {
@@ -1134,165 +1407,5955 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_msskobelina_fit-profi_internal_application_command_authorize.LoginUserResult": {
-            "type": "object",
-            "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "fullName": {
-                    "type": "string"
-                },
-                "token": {
-                    "type": "string"
-                },
-                "userID": {
-                    "type": "integer"
-                }
-            }
         },
-        "github_com_msskobelina_fit-profi_internal_application_command_authorize.RegisterUserResult": {
-            "type": "object",
-            "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "fullName": {
-                    "type": "string"
-                },
-                "token": {
-                    "type": "string"
-                },
-                "userID": {
-                    "type": "integer"
+        "/coaching/coach": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the active coaching relationship of the authenticated client.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Coaching"
+                ],
+                "summary": "Get my coach",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachingRelationship"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "github_com_msskobelina_fit-profi_internal_application_query_calendar.CalendarInfo": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "string"
-                },
-                "summary": {
-                    "type": "string"
+        "/coaching/invitations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns pending coaching invitations addressed to the authenticated user's email.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Coaching"
+                ],
+                "summary": "List my invitations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachingRelationship"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sends a coaching invitation to the given email. Coaches only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Coaching"
+                ],
+                "summary": "Invite client",
+                "parameters": [
+                    {
+                        "description": "Client email",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_coaching.InviteClientRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachingRelationship"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/coaching/invitations/{id}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Accepts a pending coaching invitation. A client can have only one active coach.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Coaching"
+                ],
+                "summary": "Accept invitation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Invitation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachingRelationship"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/coaching/invitations/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Rejects a pending coaching invitation.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Coaching"
+                ],
+                "summary": "Reject invitation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Invitation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachingRelationship"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/coaching/relationships/{id}/terminate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ends an active coaching relationship (either party) or withdraws a pending invitation (coach).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Coaching"
+                ],
+                "summary": "Terminate coaching relationship",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Relationship ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachingRelationship"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/integrations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the user's connected providers with their status. An integration in the needs_reconnect status was revoked by the provider and has to be connected again.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Integrations"
+                ],
+                "summary": "List connected integrations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_application_query_integrations.IntegrationInfo"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/integrations/google": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets the calendar sessions and workouts are written to. The calendar must be one of GET /calendar/list that the user can edit; its time zone becomes the integration's time zone.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Integrations"
+                ],
+                "summary": "Select Google Calendar",
+                "parameters": [
+                    {
+                        "description": "Calendar",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_integrations.SelectGoogleCalendarRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_application_command_integrations.SelectGoogleCalendarResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/integrations/strava/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Imports the activities recorded since the previous import as cardio sessions. An import interrupted by Strava's rate limit continues from where it stopped on the next call.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Integrations"
+                ],
+                "summary": "Import Strava activities",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_application_command_integrations.ImportStravaResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/integrations/{provider}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes the user's token at the provider and removes the integration.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Integrations"
+                ],
+                "summary": "Disconnect integration",
+                "parameters": [
+                    {
+                        "enum": [
+                            "google",
+                            "strava"
+                        ],
+                        "type": "string",
+                        "description": "Provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/integrations/{provider}/callback": {
+            "get": {
+                "description": "Receives the OAuth authorization code from the provider and stores the access token.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Integrations"
+                ],
+                "summary": "OAuth callback",
+                "parameters": [
+                    {
+                        "enum": [
+                            "google",
+                            "strava"
+                        ],
+                        "type": "string",
+                        "description": "Provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "OAuth state parameter",
+                        "name": "state",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "OAuth authorization code",
+                        "name": "code",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Integration connected ✓",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/integrations/{provider}/connect": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Redirects the authenticated user to the provider's OAuth consent screen.",
+                "tags": [
+                    "Integrations"
+                ],
+                "summary": "Connect integration",
+                "parameters": [
+                    {
+                        "enum": [
+                            "google",
+                            "strava"
+                        ],
+                        "type": "string",
+                        "description": "Provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Redirect to the provider's OAuth consent screen"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/integrations/{provider}/disconnect": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes the user's token at the provider and removes the integration.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Integrations"
+                ],
+                "summary": "Disconnect integration",
+                "parameters": [
+                    {
+                        "enum": [
+                            "google",
+                            "strava"
+                        ],
+                        "type": "string",
+                        "description": "Provider",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/entries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all diary entries for the authenticated user (or one of their active clients when userId is set) on a given date (default: today).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "List nutrition diary entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "2024-03-15",
+                        "description": "Date in YYYY-MM-DD format",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Client user ID (coaches only)",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryEntry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Records a meal with food items for the authenticated user. Items with a foodId from the food catalog only need grams; their name and macros are computed from the catalog.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Create nutrition diary entry",
+                "parameters": [
+                    {
+                        "description": "Diary entry",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_nutrition.CreateEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/entries/copy": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Clones the entries of fromDate, with their items, onto toDate. Set mealType to copy a single meal and toMealType to file the copies under another meal.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Copy diary day or meal",
+                "parameters": [
+                    {
+                        "description": "What to copy",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_nutrition.CopyEntriesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryEntry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/entries/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a single diary entry by ID. Coaches pass userId to read an entry of an active client.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Get nutrition diary entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Client user ID (coaches only)",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the meal type and food items of an existing diary entry. Items with a foodId get their name and macros computed from the food catalog.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Update nutrition diary entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated entry data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_nutrition.UpdateEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a diary entry belonging to the authenticated user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Delete nutrition diary entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/entries/{id}/items": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds one food item to an existing diary entry. Items with a foodId from the food catalog only need grams. Micronutrients and water are optional.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Add item to diary entry",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Food item",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_nutrition.AddItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/entries/{id}/items/{itemId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes one item from a diary entry. The last item cannot be removed; delete the entry instead.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Delete diary entry item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Item ID",
+                        "name": "itemId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the given fields of one item of a diary entry. Catalog items are recomputed from the food, so only foodId, grams and micronutrients apply to them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Update diary entry item",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Item ID",
+                        "name": "itemId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_nutrition.UpdateItemRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/foods": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Full-text search over food names and brands, best matches first. Each word matches as a prefix. Nutrients are per 100 g; pass the food ID with grams when logging a diary item to have macros computed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Search food catalog",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "greek yogurt",
+                        "description": "Search text",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max results (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Food"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/foods/barcode/{ean}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Finds a packaged food by its EAN-13, EAN-8 or UPC-A code after validating the check digit. Serving is one label serving (100 g when unknown) ready to add as a diary item. When the code is not in the catalog, submit it via POST /nutrition/foods/submissions.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Look up food by barcode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "3017620422003",
+                        "description": "EAN or UPC code",
+                        "name": "ean",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_application_query_nutrition.LookupBarcodeResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/foods/submissions": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Queues a packaged food that is missing from the catalog for admin review. Nutrients are per 100 g as printed on the label.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Submit missing barcode",
+                "parameters": [
+                    {
+                        "description": "Food label data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_nutrition.SubmitFoodRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.FoodSubmission"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/hydration": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the drinks logged by the authenticated user (or one of their active clients when userId is set) on a given date (default: today).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "List hydration log",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "2024-03-15",
+                        "description": "Date in YYYY-MM-DD format",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Client user ID (coaches only)",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.HydrationEntry"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Adds a drink to the authenticated user's hydration log. Drinks count towards the day's water and caffeine totals in the nutrition summary.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Log a drink",
+                "parameters": [
+                    {
+                        "description": "Drink",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_nutrition.LogHydrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.HydrationEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/hydration/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a drink from the authenticated user's hydration log.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Delete a drink",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Hydration entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/limits": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the daily sugar, sodium and caffeine ceilings and fibre, potassium and water floors for the authenticated user (or one of their active clients when userId is set). Users who never set limits get the WHO defaults. A null limit is not checked.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Get daily nutrition limits",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Client user ID (coaches only)",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.NutritionLimits"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the daily micronutrient and water limits of the authenticated user, or of one of the coach's active clients when userId is set. Days in the nutrition summary that break a limit carry a warning.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Set daily nutrition limits",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Client user ID (coaches only)",
+                        "name": "userId",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Daily limits",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_nutrition.SetLimitsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.NutritionLimits"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/recipes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the recipes and saved meals of the authenticated user, including the ones their coach shared, or of one of their active clients when userId is set.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "List recipes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Client user ID (coaches only)",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Recipe"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Saves a recipe or a saved meal (a recipe with one serving) made of ingredients. Ingredients with a foodId from the food catalog only need grams; their name and macros are computed from the catalog.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Create recipe",
+                "parameters": [
+                    {
+                        "description": "Recipe",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_nutrition.CreateRecipeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Recipe"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/recipes/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a single recipe with its ingredients. Coaches pass userId to read a recipe of an active client.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Get recipe",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recipe ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Client user ID (coaches only)",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Recipe"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the name, servings, yield and ingredients of one of the user's recipes. Diary items already logged from it keep their macros.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Update recipe",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recipe ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated recipe",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_nutrition.UpdateRecipeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Recipe"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes one of the user's recipes. Copies shared with clients are kept.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Delete recipe",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recipe ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/recipes/{id}/entries": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a diary entry from one of the user's recipes. Servings is the portion multiplier; the item's weight and macros are the recipe totals divided by its servings and multiplied by it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Log recipe to diary",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recipe ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Portion",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_nutrition.LogRecipeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/recipes/{id}/share": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Copies one of the coach's recipes to each of the given active clients.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Share recipe with clients",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Recipe ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Clients to share with",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_nutrition.ShareRecipeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Recipe"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/summary": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns calorie, macro and micronutrient totals per day and per meal for the authenticated user (or one of their active clients when userId is set), with period totals and averages over the logged days. Micronutrient and water totals are included when recorded, and drinks from the hydration log count towards the day's water and caffeine. When the user has nutrition targets, each day also reports what remains and what was exceeded; days that break the user's daily limits carry warnings. Both dates are inclusive; by default the last 7 days up to today in the user's profile time zone.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Nutrition summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "2024-03-09",
+                        "description": "First date in YYYY-MM-DD format",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-03-15",
+                        "description": "Last date in YYYY-MM-DD format",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Client user ID (coaches only)",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.NutritionSummary"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/targets": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns daily calorie and macro targets for the authenticated user (or one of their active clients when userId is set). Targets set by the current coach take precedence; otherwise they are computed from the profile's age, weight, height, sex, activity level and goal.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Get nutrition targets",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Client user ID (coaches only)",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.NutritionTargets"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/nutrition/targets/{clientId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets daily calorie and macro targets for one of the coach's active clients, replacing the ones computed from the client's profile.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Override client nutrition targets",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Client user ID",
+                        "name": "clientId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Daily targets",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_nutrition.SetTargetsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.NutritionTargets"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the targets the coach set for a client, so they are computed from the client's profile again.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Nutrition"
+                ],
+                "summary": "Remove client nutrition targets override",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Client user ID",
+                        "name": "clientId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/profiles/coach": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the coach profile of the authenticated user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Get coach profile",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachProfile"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the coach profile for the authenticated user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Update coach profile",
+                "parameters": [
+                    {
+                        "description": "Updated coach profile data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_profiles.UpdateCoachProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachProfile"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a coach profile for the authenticated user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Create coach profile",
+                "parameters": [
+                    {
+                        "description": "Coach profile data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_profiles.CreateCoachProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachProfile"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/profiles/user": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the fitness profile of the authenticated user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Get user profile",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.UserProfile"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the fitness profile for the authenticated user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Update user profile",
+                "parameters": [
+                    {
+                        "description": "Updated profile data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_profiles.UpdateUserProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.UserProfile"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a fitness profile for the authenticated user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Profiles"
+                ],
+                "summary": "Create user profile",
+                "parameters": [
+                    {
+                        "description": "User profile data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_profiles.CreateUserProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.UserProfile"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns all training programs belonging to the authenticated user, or to one of their active clients when userId is set.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "List training programs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Client user ID (coaches only)",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.TrainingProgram"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a new training program with optional days and exercises. Coaches may set assigneeId to build the program directly for one of their active clients. Exercises may refer to a catalog entry by catalogId, which follows entries merged into another; without it they are linked to the entry matching their name or an alias. They prescribe a rep range (reps to repsMax), load as weightKg or percentOneRm, effort as rpe or rir, tempo, rest, duration and distance. Exercises of a day sharing a group label form a superset or circuit of at least two.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "Create training program",
+                "parameters": [
+                    {
+                        "description": "Program data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_programs.CreateProgramRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.TrainingProgram"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs/exercises": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Full-text search over exercise names and aliases, best matches first, optionally filtered by muscle group (primary or secondary), equipment and movement pattern. Without q entries are listed by name. Pass the entry ID as catalogId when creating a program.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "Search exercise catalog",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "bench",
+                        "description": "Search text",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "chest",
+                            "upper_back",
+                            "lats",
+                            "lower_back",
+                            "traps",
+                            "shoulders",
+                            "biceps",
+                            "triceps",
+                            "forearms",
+                            "abs",
+                            "obliques",
+                            "quads",
+                            "hamstrings",
+                            "glutes",
+                            "adductors",
+                            "calves"
+                        ],
+                        "type": "string",
+                        "description": "Muscle group",
+                        "name": "muscle",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "barbell",
+                            "dumbbell",
+                            "kettlebell",
+                            "machine",
+                            "cable",
+                            "bodyweight",
+                            "band",
+                            "other"
+                        ],
+                        "type": "string",
+                        "description": "Equipment",
+                        "name": "equipment",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "horizontal_push",
+                            "vertical_push",
+                            "horizontal_pull",
+                            "vertical_pull",
+                            "squat",
+                            "hinge",
+                            "lunge",
+                            "carry",
+                            "core",
+                            "isolation"
+                        ],
+                        "type": "string",
+                        "description": "Movement pattern",
+                        "name": "pattern",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max results (default 20, max 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Exercise"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs/exercises/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns an entry of the exercise catalog. Entries merged into another are not found.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "Get catalog exercise",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Catalog exercise ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Exercise"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs/progress": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the progress, newest first, that the authenticated user (or one of their active clients when userId is set) logged across all programs, optionally narrowed to one exercise and a date window.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "List progress",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Program exercise ID",
+                        "name": "exerciseId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window start (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window end (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Client user ID (coaches only)",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ExerciseProgress"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Records a completed set of an exercise from one of the authenticated user's programs. Workout sessions record whole workouts set by set and are preferred; this endpoint stays for clients that log aggregate sets. Personal records the sets beat are returned in records and the user's coach is notified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "Track exercise progress",
+                "parameters": [
+                    {
+                        "description": "Progress data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_programs.TrackProgressRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ExerciseProgress"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs/progress/analytics": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns, per exercise, a weekly or monthly time series of estimated 1RM, total volume and best set built from finished workout sessions and tracked progress. Program exercises of the same catalog entry share one series, carrying catalogId, across all programs. Defaults to the last 12 periods with the Epley formula.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "Get progress analytics",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Program exercise ID; includes program exercises of the same catalog entry",
+                        "name": "exerciseId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window start (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window end (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "description": "Aggregation period (default week)",
+                        "name": "period",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "epley",
+                            "brzycki"
+                        ],
+                        "type": "string",
+                        "description": "1RM estimation formula (default epley)",
+                        "name": "formula",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Client user ID (coaches only)",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ProgressAnalytics"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs/records": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the personal record timeline, newest first, of the authenticated user (or of one of their active clients when userId is set). Records are detected when progress is tracked or a workout session is finished, against the history of the exercise in every program when it refers to the exercise catalog.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "List personal records",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Program exercise ID; includes program exercises of the same catalog entry",
+                        "name": "exerciseId",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "heaviest_weight",
+                            "most_reps",
+                            "best_e1rm",
+                            "session_volume"
+                        ],
+                        "type": "string",
+                        "description": "Record kind",
+                        "name": "kind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window start (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window end (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Client user ID (coaches only)",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.PersonalRecord"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs/schedule": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the authenticated user's scheduled workouts in the window. Defaults to the next 30 days.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "List scheduled workouts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Window start (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window end (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ScheduledWorkout"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs/schedule/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Moves a scheduled workout of the authenticated user. The duration is kept unless durationMinutes is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "Reschedule workout",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Scheduled workout ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New time",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_programs.RescheduleWorkoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ScheduledWorkout"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a scheduled workout of the authenticated user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "Unschedule workout",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Scheduled workout ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the workout sessions, newest first, that the authenticated user (or one of their active clients when userId is set) started in the window. Defaults to the last 30 days.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "List workout sessions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Window start (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Window end (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Client user ID (coaches only)",
+                        "name": "userId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.WorkoutSession"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Starts a workout of a day of one of the authenticated user's programs. startedAt defaults to now. Only one session can be in progress at a time.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "Start workout session",
+                "parameters": [
+                    {
+                        "description": "Program day to perform",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_programs.StartSessionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.WorkoutSession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs/sessions/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a workout session with its sets. Coaches can read the sessions of their active clients.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "Get workout session",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.WorkoutSession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs/sessions/{id}/abandon": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Closes one of the authenticated user's sessions in progress without saving any sets.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "Abandon workout session",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reason",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_programs.AbandonSessionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.WorkoutSession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs/sessions/{id}/finish": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Closes one of the authenticated user's sessions in progress and saves the sets performed, all in one transaction. Every set must be of an exercise of the session's program day; sets without setNumber are numbered in order per exercise. endedAt defaults to now. Personal records the completed sets beat are returned in records and the user's coach is notified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "Finish workout session",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Sets performed",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_programs.FinishSessionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.WorkoutSession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a training program by ID. Coaches can read programs of their active clients.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "Get training program",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Program ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.TrainingProgram"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a training program the authenticated user owns. Coaches may delete programs they authored for their active clients.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "Delete training program",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Program ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs/{id}/assign": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Copies a program authored by the coach to each of the given active clients.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "Assign training program",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Program ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Clients to assign to",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_programs.AssignProgramRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.TrainingProgram"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs/{id}/progress": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the progress logged against a program. Coaches can read programs of their active clients, and the coach who authored a program also sees the progress of the active clients it was assigned to.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "List program progress",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Program ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ExerciseProgress"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/programs/{id}/schedule": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Puts a day of one of the authenticated user's programs on their calendar. The event is mirrored to Google Calendar when connected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Programs"
+                ],
+                "summary": "Schedule workout",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Program ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Schedule",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_programs.ScheduleWorkoutRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ScheduledWorkout"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/check": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the user ID and role extracted from the JWT token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Check auth token",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_authorize.CheckResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/login": {
+            "post": {
+                "description": "Authenticates the user and returns a short-lived JWT access token and a refresh token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Login",
+                "parameters": [
+                    {
+                        "description": "Login credentials",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_authorize.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_application_command_authorize.LoginUserResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/logout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes the current access token and its refresh token.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Logout",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/register": {
+            "post": {
+                "description": "Creates a new account and returns a short-lived JWT access token and a refresh token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "Registration payload",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_authorize.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_application_command_authorize.RegisterUserResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/reset-password": {
+            "patch": {
+                "description": "Resets the user password using a one-time reset token.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Reset password",
+                "parameters": [
+                    {
+                        "description": "Reset token and new password",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_authorize.ResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/send-email": {
+            "post": {
+                "description": "Sends a one-time password-reset link to the given email address.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Send password-reset email",
+                "parameters": [
+                    {
+                        "description": "Email address",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_authorize.SendEmailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/token/refresh": {
+            "post": {
+                "description": "Exchanges a refresh token for a new access token and rotates the refresh token. Reusing an already rotated refresh token revokes the whole session.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Refresh access token",
+                "parameters": [
+                    {
+                        "description": "Refresh token",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_delivery_controller_authorize.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_application_command_authorize.RefreshTokenResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_msskobelina_fit-profi_internal_application_command_authorize.LoginUserResult": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "fullName": {
+                    "type": "string"
+                },
+                "refreshToken": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "userID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_application_command_authorize.RefreshTokenResult": {
+            "type": "object",
+            "properties": {
+                "refreshToken": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_application_command_authorize.RegisterUserResult": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "fullName": {
+                    "type": "string"
+                },
+                "refreshToken": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "userID": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_application_command_integrations.ImportStravaResult": {
+            "type": "object",
+            "properties": {
+                "imported": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_application_command_integrations.SelectGoogleCalendarResult": {
+            "type": "object",
+            "properties": {
+                "calendarID": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_application_query_admin.ListFoodSubmissionsResult": {
+            "type": "object",
+            "properties": {
+                "submissions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.FoodSubmission"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "format": "int64"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_application_query_admin.ListUsersResult": {
+            "type": "object",
+            "properties": {
+                "total": {
+                    "type": "integer",
+                    "format": "int64"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_application_query_admin.UserInfo"
+                    }
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_application_query_admin.UserInfo": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "disabled": {
+                    "type": "boolean"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "fullName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_application_query_calendar.CalendarInfo": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_application_query_integrations.IntegrationInfo": {
+            "type": "object",
+            "properties": {
+                "calendarID": {
+                    "type": "string"
+                },
+                "capabilities": {
+                    "description": "Capabilities lists what the provider is used for, e.g. calendar sync.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_infrastructure_providers.Capability"
+                    }
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "provider": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Provider"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.IntegrationStatus"
+                },
+                "timezone": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_application_query_nutrition.LookupBarcodeResult": {
+            "type": "object",
+            "properties": {
+                "food": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Food"
+                },
+                "serving": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryItem"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "validation error message"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.ActivityLevel": {
+            "type": "string",
+            "enum": [
+                "sedentary",
+                "light",
+                "moderate",
+                "active",
+                "very_active"
+            ],
+            "x-enum-varnames": [
+                "ActivitySedentary",
+                "ActivityLight",
+                "ActivityModerate",
+                "ActivityActive",
+                "ActivityVeryActive"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.AnalyticsPeriod": {
+            "type": "string",
+            "enum": [
+                "week",
+                "month"
+            ],
+            "x-enum-varnames": [
+                "PeriodWeek",
+                "PeriodMonth"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.AvailabilityException": {
+            "type": "object",
+            "properties": {
+                "coachId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "ruleId": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.AvailabilityRule": {
+            "type": "object",
+            "properties": {
+                "coachId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "durationMinutes": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "rrule": {
+                    "type": "string"
+                },
+                "start": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.BestSet": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2024-03-14"
+                },
+                "estimatedOneRmKg": {
+                    "type": "number",
+                    "example": 116.7
+                },
+                "reps": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "weightKg": {
+                    "type": "number",
+                    "example": 100
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.Booking": {
+            "type": "object",
+            "properties": {
+                "availabilityId": {
+                    "type": "integer"
+                },
+                "cancelledAt": {
+                    "type": "string"
+                },
+                "cancelledBy": {
+                    "type": "integer"
+                },
+                "clientId": {
+                    "type": "integer"
+                },
+                "coachId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.BookingStatus"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.BookingStatus": {
+            "type": "string",
+            "enum": [
+                "booked",
+                "cancelled"
+            ],
+            "x-enum-varnames": [
+                "BookingBooked",
+                "BookingCancelled"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.CardioSession": {
+            "type": "object",
+            "properties": {
+                "avgHeartRate": {
+                    "type": "number"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "distanceMeters": {
+                    "type": "number"
+                },
+                "elapsedSeconds": {
+                    "type": "integer"
+                },
+                "elevationGainMeters": {
+                    "type": "number"
+                },
+                "externalId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "maxHeartRate": {
+                    "type": "number"
+                },
+                "movingSeconds": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "source": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CardioSource"
+                },
+                "sportType": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.CardioSource": {
+            "type": "string",
+            "enum": [
+                "strava"
+            ],
+            "x-enum-varnames": [
+                "CardioSourceStrava"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.CoachAchievement": {
+            "type": "object",
+            "properties": {
+                "certificateUrl": {
+                    "type": "string"
+                },
+                "coachProfileId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "endPeriod": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "startPeriod": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.CoachAvailability": {
+            "type": "object",
+            "properties": {
+                "coachId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "ruleId": {
+                    "type": "integer"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.CoachCategory": {
+            "type": "string",
+            "enum": [
+                "standard",
+                "master",
+                "professional"
+            ],
+            "x-enum-varnames": [
+                "CoachStandard",
+                "CoachMaster",
+                "CoachPro"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.CoachEducation": {
+            "type": "object",
+            "properties": {
+                "coachProfileId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "endPeriod": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "place": {
+                    "type": "string"
+                },
+                "startPeriod": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.CoachProfile": {
+            "type": "object",
+            "properties": {
+                "achievements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachAchievement"
+                    }
+                },
+                "category": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachCategory"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "education": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachEducation"
+                    }
+                },
+                "fullName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "info": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.CoachingRelationship": {
+            "type": "object",
+            "properties": {
+                "acceptedAt": {
+                    "type": "string"
+                },
+                "clientEmail": {
+                    "type": "string"
+                },
+                "clientId": {
+                    "type": "integer"
+                },
+                "coachId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "invitedAt": {
+                    "type": "string"
+                },
+                "rejectedAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachingStatus"
+                },
+                "terminatedAt": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.CoachingStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "active",
+                "rejected",
+                "terminated"
+            ],
+            "x-enum-varnames": [
+                "CoachingPending",
+                "CoachingActive",
+                "CoachingRejected",
+                "CoachingTerminated"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.DiaryEntry": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryItem"
+                    }
+                },
+                "mealType": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.DiaryItem": {
+            "type": "object",
+            "properties": {
+                "caffeineMg": {
+                    "type": "number",
+                    "example": 0
+                },
+                "calories": {
+                    "type": "number"
+                },
+                "carbsG": {
+                    "type": "number"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "entryId": {
+                    "type": "integer"
+                },
+                "fatG": {
+                    "type": "number"
+                },
+                "fibreG": {
+                    "type": "number",
+                    "example": 3.1
+                },
+                "foodId": {
+                    "type": "integer"
+                },
+                "grams": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "potassiumMg": {
+                    "type": "number",
+                    "example": 422
+                },
+                "proteinG": {
+                    "type": "number"
+                },
+                "recipeId": {
+                    "type": "integer"
+                },
+                "sodiumMg": {
+                    "type": "number",
+                    "example": 1
+                },
+                "sugarG": {
+                    "type": "number",
+                    "example": 14
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "waterMl": {
+                    "type": "number",
+                    "example": 89
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.Equipment": {
+            "type": "string",
+            "enum": [
+                "barbell",
+                "dumbbell",
+                "kettlebell",
+                "machine",
+                "cable",
+                "bodyweight",
+                "band",
+                "other"
+            ],
+            "x-enum-varnames": [
+                "EquipmentBarbell",
+                "EquipmentDumbbell",
+                "EquipmentKettlebell",
+                "EquipmentMachine",
+                "EquipmentCable",
+                "EquipmentBodyweight",
+                "EquipmentBand",
+                "EquipmentOther"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.Exercise": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "equipment": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Equipment"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "mergedIntoId": {
+                    "type": "integer"
+                },
+                "movementPattern": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.MovementPattern"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "primaryMuscles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.MuscleGroup"
+                    }
+                },
+                "secondaryMuscles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.MuscleGroup"
+                    }
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.ExerciseGroupType": {
+            "type": "string",
+            "enum": [
+                "superset",
+                "circuit"
+            ],
+            "x-enum-varnames": [
+                "GroupSuperset",
+                "GroupCircuit"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.ExercisePoint": {
+            "type": "object",
+            "properties": {
+                "bestSet": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.BestSet"
+                },
+                "estimatedOneRmKg": {
+                    "type": "number",
+                    "example": 116.7
+                },
+                "periodStart": {
+                    "type": "string",
+                    "example": "2024-03-11"
+                },
+                "sets": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "volumeKg": {
+                    "type": "number",
+                    "example": 5400
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.ExerciseProgress": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "exerciseId": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.PersonalRecord"
+                    }
+                },
+                "reps": {
+                    "type": "integer"
+                },
+                "sets": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                },
+                "weightKg": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.ExerciseSeries": {
+            "type": "object",
+            "properties": {
+                "catalogId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "exerciseId": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Back squat"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ExercisePoint"
+                    }
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.Food": {
+            "type": "object",
+            "properties": {
+                "barcode": {
+                    "type": "string"
+                },
+                "brand": {
+                    "type": "string"
+                },
+                "caloriesPer100g": {
+                    "type": "number"
+                },
+                "carbsPer100g": {
+                    "type": "number"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "externalId": {
+                    "type": "string"
+                },
+                "fatPer100g": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "proteinPer100g": {
+                    "type": "number"
+                },
+                "servingGrams": {
+                    "type": "number"
+                },
+                "source": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.FoodSource"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.FoodSource": {
+            "type": "string",
+            "enum": [
+                "usda",
+                "openfoodfacts",
+                "community"
+            ],
+            "x-enum-varnames": [
+                "FoodSourceUSDA",
+                "FoodSourceOpenFoodFacts",
+                "FoodSourceCommunity"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.FoodSubmission": {
+            "type": "object",
+            "properties": {
+                "barcode": {
+                    "type": "string"
+                },
+                "brand": {
+                    "type": "string"
+                },
+                "caloriesPer100g": {
+                    "type": "number"
+                },
+                "carbsPer100g": {
+                    "type": "number"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "fatPer100g": {
+                    "type": "number"
+                },
+                "foodId": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "proteinPer100g": {
+                    "type": "number"
+                },
+                "rejectReason": {
+                    "type": "string"
+                },
+                "reviewedAt": {
+                    "type": "string"
+                },
+                "reviewedBy": {
+                    "type": "integer"
+                },
+                "servingGrams": {
+                    "type": "number"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.FoodSubmissionStatus"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.FoodSubmissionStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "approved",
+                "rejected"
+            ],
+            "x-enum-varnames": [
+                "FoodSubmissionPending",
+                "FoodSubmissionApproved",
+                "FoodSubmissionRejected"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.Goal": {
+            "type": "string",
+            "enum": [
+                "lose_weight",
+                "gain_weight",
+                "rehab",
+                "keep_fit",
+                "competition"
+            ],
+            "x-enum-varnames": [
+                "GoalLoseWeight",
+                "GoalGainWeight",
+                "GoalRehab",
+                "GoalKeepFit",
+                "GoalCompetition"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.HydrationEntry": {
+            "type": "object",
+            "properties": {
+                "amountMl": {
+                    "type": "number",
+                    "example": 500
+                },
+                "beverage": {
+                    "type": "string",
+                    "example": "water"
+                },
+                "caffeineMg": {
+                    "type": "number",
+                    "example": 0
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.IntegrationStatus": {
+            "type": "string",
+            "enum": [
+                "active",
+                "needs_reconnect"
+            ],
+            "x-enum-varnames": [
+                "IntegrationActive",
+                "IntegrationNeedsReconnect"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.LimitKind": {
+            "type": "string",
+            "enum": [
+                "above_max",
+                "below_min"
+            ],
+            "x-enum-varnames": [
+                "LimitAboveMax",
+                "LimitBelowMin"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.MovementPattern": {
+            "type": "string",
+            "enum": [
+                "horizontal_push",
+                "vertical_push",
+                "horizontal_pull",
+                "vertical_pull",
+                "squat",
+                "hinge",
+                "lunge",
+                "carry",
+                "core",
+                "isolation"
+            ],
+            "x-enum-varnames": [
+                "PatternHorizontalPush",
+                "PatternVerticalPush",
+                "PatternHorizontalPull",
+                "PatternVerticalPull",
+                "PatternSquat",
+                "PatternHinge",
+                "PatternLunge",
+                "PatternCarry",
+                "PatternCore",
+                "PatternIsolation"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.MuscleGroup": {
+            "type": "string",
+            "enum": [
+                "chest",
+                "upper_back",
+                "lats",
+                "lower_back",
+                "traps",
+                "shoulders",
+                "biceps",
+                "triceps",
+                "forearms",
+                "abs",
+                "obliques",
+                "quads",
+                "hamstrings",
+                "glutes",
+                "adductors",
+                "calves"
+            ],
+            "x-enum-varnames": [
+                "MuscleChest",
+                "MuscleUpperBack",
+                "MuscleLats",
+                "MuscleLowerBack",
+                "MuscleTraps",
+                "MuscleShoulders",
+                "MuscleBiceps",
+                "MuscleTriceps",
+                "MuscleForearms",
+                "MuscleAbs",
+                "MuscleObliques",
+                "MuscleQuads",
+                "MuscleHamstrings",
+                "MuscleGlutes",
+                "MuscleAdductors",
+                "MuscleCalves"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.NutritionDay": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2024-03-15"
+                },
+                "exceeded": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.NutritionTotals"
+                },
+                "hydrationMl": {
+                    "type": "number",
+                    "example": 1500
+                },
+                "meals": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.NutritionTotals"
+                    }
+                },
+                "remaining": {
+                    "description": "Remaining and Exceeded compare Totals with the daily target and are\nomitted when the user has none.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.NutritionTotals"
+                        }
+                    ]
+                },
+                "totals": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.NutritionTotals"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.NutritionWarning"
+                    }
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.NutritionLimits": {
+            "type": "object",
+            "properties": {
+                "caffeineMaxMg": {
+                    "type": "number",
+                    "example": 400
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "fibreMinG": {
+                    "type": "number",
+                    "example": 25
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "potassiumMinMg": {
+                    "type": "number",
+                    "example": 3500
+                },
+                "setBy": {
+                    "type": "integer"
+                },
+                "sodiumMaxMg": {
+                    "type": "number",
+                    "example": 2000
+                },
+                "sugarMaxG": {
+                    "type": "number",
+                    "example": 50
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                },
+                "waterMinMl": {
+                    "type": "number",
+                    "example": 2000
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.NutritionSummary": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.NutritionTotals"
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.NutritionDay"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2024-03-09"
+                },
+                "limits": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.NutritionLimits"
+                },
+                "loggedDays": {
+                    "type": "integer"
+                },
+                "target": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.NutritionTargets"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Europe/Kyiv"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2024-03-15"
+                },
+                "totals": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.NutritionTotals"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.NutritionTargets": {
+            "type": "object",
+            "properties": {
+                "bmr": {
+                    "type": "number",
+                    "example": 1700
+                },
+                "calories": {
+                    "type": "number",
+                    "example": 2108
+                },
+                "carbsG": {
+                    "type": "number",
+                    "example": 240
+                },
+                "fatG": {
+                    "type": "number",
+                    "example": 59
+                },
+                "proteinG": {
+                    "type": "number",
+                    "example": 150
+                },
+                "setBy": {
+                    "type": "integer"
+                },
+                "source": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.TargetsSource"
+                        }
+                    ],
+                    "example": "computed"
+                },
+                "tdee": {
+                    "type": "number",
+                    "example": 2635
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.NutritionTotals": {
+            "type": "object",
+            "properties": {
+                "caffeineMg": {
+                    "type": "number"
+                },
+                "calories": {
+                    "type": "number"
+                },
+                "carbsG": {
+                    "type": "number"
+                },
+                "fatG": {
+                    "type": "number"
+                },
+                "fibreG": {
+                    "type": "number"
+                },
+                "potassiumMg": {
+                    "type": "number"
+                },
+                "proteinG": {
+                    "type": "number"
+                },
+                "sodiumMg": {
+                    "type": "number"
+                },
+                "sugarG": {
+                    "type": "number"
+                },
+                "waterMl": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.NutritionWarning": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.LimitKind"
+                        }
+                    ],
+                    "example": "above_max"
+                },
+                "limit": {
+                    "type": "number",
+                    "example": 2000
+                },
+                "nutrient": {
+                    "type": "string",
+                    "example": "sodiumMg"
+                },
+                "value": {
+                    "type": "number",
+                    "example": 2650
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.OneRMFormula": {
+            "type": "string",
+            "enum": [
+                "epley",
+                "brzycki"
+            ],
+            "x-enum-varnames": [
+                "FormulaEpley",
+                "FormulaBrzycki"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.PersonalRecord": {
+            "type": "object",
+            "properties": {
+                "achievedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "exerciseId": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.RecordKind"
+                },
+                "previousValue": {
+                    "type": "number"
+                },
+                "progressId": {
+                    "type": "integer"
+                },
+                "reps": {
+                    "type": "integer"
+                },
+                "sessionId": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                },
+                "value": {
+                    "type": "number"
+                },
+                "weightKg": {
+                    "type": "number"
                 }
             }
         },
-        "github_com_msskobelina_fit-profi_internal_delivery_controller.ErrorResponse": {
+        "github_com_msskobelina_fit-profi_internal_domain_model.ProgramDay": {
             "type": "object",
             "properties": {
-                "error": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "dayNumber": {
+                    "type": "integer"
+                },
+                "exercises": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ProgramExercise"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "programId": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.ProgramExercise": {
+            "type": "object",
+            "properties": {
+                "catalogId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "dayId": {
+                    "type": "integer"
+                },
+                "distanceMeters": {
+                    "type": "number"
+                },
+                "durationSeconds": {
+                    "type": "integer"
+                },
+                "group": {
+                    "type": "string"
+                },
+                "groupType": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ExerciseGroupType"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "percentOneRm": {
+                    "type": "number"
+                },
+                "reps": {
+                    "type": "integer"
+                },
+                "repsMax": {
+                    "type": "integer"
+                },
+                "restSeconds": {
+                    "type": "integer"
+                },
+                "rir": {
+                    "type": "integer"
+                },
+                "rpe": {
+                    "type": "number"
+                },
+                "sets": {
+                    "type": "integer"
+                },
+                "tempo": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "weightKg": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.ProgressAnalytics": {
+            "type": "object",
+            "properties": {
+                "exercises": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ExerciseSeries"
+                    }
+                },
+                "formula": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.OneRMFormula"
+                        }
+                    ],
+                    "example": "epley"
+                },
+                "from": {
                     "type": "string",
-                    "example": "validation error message"
+                    "example": "2023-12-18"
+                },
+                "period": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.AnalyticsPeriod"
+                        }
+                    ],
+                    "example": "week"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2024-03-15"
                 }
             }
         },
-        "github_com_msskobelina_fit-profi_internal_domain_model.CoachAchievement": {
+        "github_com_msskobelina_fit-profi_internal_domain_model.Provider": {
+            "type": "string",
+            "enum": [
+                "google",
+                "strava"
+            ],
+            "x-enum-varnames": [
+                "ProviderGoogle",
+                "ProviderStrava"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.Recipe": {
             "type": "object",
             "properties": {
-                "certificateUrl": {
+                "authorId": {
+                    "type": "integer"
+                },
+                "createdAt": {
                     "type": "string"
                 },
-                "coachProfileId": {
+                "id": {
+                    "type": "integer"
+                },
+                "ingredients": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.RecipeIngredient"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "servings": {
+                    "type": "integer"
+                },
+                "sharedFromId": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
                     "type": "integer"
                 },
+                "yieldGrams": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.RecipeIngredient": {
+            "type": "object",
+            "properties": {
+                "caffeineMg": {
+                    "type": "number",
+                    "example": 0
+                },
+                "calories": {
+                    "type": "number"
+                },
+                "carbsG": {
+                    "type": "number"
+                },
                 "createdAt": {
                     "type": "string"
                 },
-                "endPeriod": {
+                "fatG": {
+                    "type": "number"
+                },
+                "fibreG": {
+                    "type": "number",
+                    "example": 3.1
+                },
+                "foodId": {
+                    "type": "integer"
+                },
+                "grams": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "potassiumMg": {
+                    "type": "number",
+                    "example": 422
+                },
+                "proteinG": {
+                    "type": "number"
+                },
+                "recipeId": {
+                    "type": "integer"
+                },
+                "sodiumMg": {
+                    "type": "number",
+                    "example": 1
+                },
+                "sugarG": {
+                    "type": "number",
+                    "example": 14
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "waterMl": {
+                    "type": "number",
+                    "example": 89
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.RecordKind": {
+            "type": "string",
+            "enum": [
+                "heaviest_weight",
+                "most_reps",
+                "best_e1rm",
+                "session_volume"
+            ],
+            "x-enum-varnames": [
+                "RecordHeaviestWeight",
+                "RecordMostReps",
+                "RecordBestOneRM",
+                "RecordSessionVolume"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.ScheduledWorkout": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "dayId": {
+                    "type": "integer"
+                },
+                "endTime": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "startPeriod": {
+                "notes": {
                     "type": "string"
                 },
-                "title": {
+                "programId": {
+                    "type": "integer"
+                },
+                "startTime": {
                     "type": "string"
                 },
                 "updatedAt": {
                     "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_msskobelina_fit-profi_internal_domain_model.CoachCategory": {
+        "github_com_msskobelina_fit-profi_internal_domain_model.Sex": {
             "type": "string",
             "enum": [
-                "standard",
-                "master",
-                "professional"
+                "male",
+                "female"
             ],
             "x-enum-varnames": [
-                "CoachStandard",
-                "CoachMaster",
-                "CoachPro"
+                "SexMale",
+                "SexFemale"
             ]
         },
-        "github_com_msskobelina_fit-profi_internal_domain_model.CoachEducation": {
+        "github_com_msskobelina_fit-profi_internal_domain_model.TargetsSource": {
+            "type": "string",
+            "enum": [
+                "computed",
+                "coach"
+            ],
+            "x-enum-varnames": [
+                "TargetsComputed",
+                "TargetsCoach"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.TrainingProgram": {
             "type": "object",
             "properties": {
-                "coachProfileId": {
+                "assignedFromId": {
+                    "type": "integer"
+                },
+                "authorId": {
                     "type": "integer"
                 },
                 "createdAt": {
                     "type": "string"
                 },
-                "description": {
-                    "type": "string"
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ProgramDay"
+                    }
                 },
-                "endPeriod": {
+                "description": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "place": {
-                    "type": "string"
-                },
-                "startPeriod": {
+                "title": {
                     "type": "string"
                 },
                 "updatedAt": {
                     "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_msskobelina_fit-profi_internal_domain_model.CoachProfile": {
+        "github_com_msskobelina_fit-profi_internal_domain_model.UserProfile": {
             "type": "object",
             "properties": {
-                "achievements": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachAchievement"
-                    }
+                "activityLevel": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ActivityLevel"
                 },
-                "category": {
-                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachCategory"
+                "age": {
+                    "type": "integer"
                 },
                 "createdAt": {
                     "type": "string"
                 },
-                "education": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.CoachEducation"
-                    }
+                "description": {
+                    "type": "string"
                 },
                 "fullName": {
                     "type": "string"
                 },
+                "goal": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Goal"
+                },
+                "heightCm": {
+                    "description": "HeightCm, Sex and ActivityLevel are optional; nutrition targets can only\nbe computed once all three are set.",
+                    "type": "number"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "info": {
+                "sex": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Sex"
+                },
+                "timezone": {
+                    "description": "Timezone is an IANA zone name; it decides where the user's days start\nand end.",
                     "type": "string"
                 },
                 "updatedAt": {
@@ -1300,30 +7363,51 @@ const docTemplate = `{
                 },
                 "userId": {
                     "type": "integer"
+                },
+                "weightKg": {
+                    "type": "number"
                 }
             }
         },
-        "github_com_msskobelina_fit-profi_internal_domain_model.DiaryEntry": {
+        "github_com_msskobelina_fit-profi_internal_domain_model.WorkoutSession": {
             "type": "object",
             "properties": {
                 "createdAt": {
                     "type": "string"
                 },
-                "date": {
+                "dayId": {
+                    "type": "integer"
+                },
+                "endedAt": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "items": {
+                "notes": {
+                    "type": "string"
+                },
+                "programId": {
+                    "type": "integer"
+                },
+                "records": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryItem"
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.PersonalRecord"
                     }
                 },
-                "mealType": {
+                "sets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.WorkoutSet"
+                    }
+                },
+                "startedAt": {
                     "type": "string"
                 },
+                "status": {
+                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.WorkoutSessionStatus"
+                },
                 "updatedAt": {
                     "type": "string"
                 },
@@ -1332,407 +7416,944 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_msskobelina_fit-profi_internal_domain_model.DiaryItem": {
+        "github_com_msskobelina_fit-profi_internal_domain_model.WorkoutSessionStatus": {
+            "type": "string",
+            "enum": [
+                "in_progress",
+                "finished",
+                "abandoned"
+            ],
+            "x-enum-varnames": [
+                "SessionInProgress",
+                "SessionFinished",
+                "SessionAbandoned"
+            ]
+        },
+        "github_com_msskobelina_fit-profi_internal_domain_model.WorkoutSet": {
             "type": "object",
             "properties": {
-                "calories": {
-                    "type": "number"
-                },
-                "carbsG": {
-                    "type": "number"
+                "completed": {
+                    "type": "boolean"
                 },
                 "createdAt": {
                     "type": "string"
                 },
-                "entryId": {
+                "exerciseId": {
                     "type": "integer"
                 },
-                "fatG": {
-                    "type": "number"
+                "id": {
+                    "type": "integer"
                 },
-                "grams": {
+                "reps": {
+                    "type": "integer"
+                },
+                "restSeconds": {
+                    "type": "integer"
+                },
+                "rpe": {
                     "type": "number"
                 },
-                "id": {
+                "sessionId": {
                     "type": "integer"
                 },
-                "name": {
+                "setNumber": {
+                    "type": "integer"
+                },
+                "tempo": {
                     "type": "string"
                 },
-                "proteinG": {
+                "updatedAt": {
+                    "type": "string"
+                },
+                "weightKg": {
                     "type": "number"
+                }
+            }
+        },
+        "github_com_msskobelina_fit-profi_internal_infrastructure_providers.Capability": {
+            "type": "string",
+            "enum": [
+                "calendar",
+                "activities"
+            ],
+            "x-enum-varnames": [
+                "CapabilityCalendar",
+                "CapabilityActivities"
+            ]
+        },
+        "internal_delivery_controller_admin.ChangeUserRoleRequest": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "user",
+                        "coach",
+                        "admin"
+                    ],
+                    "example": "coach"
+                }
+            }
+        },
+        "internal_delivery_controller_admin.MergeExercisesRequest": {
+            "type": "object",
+            "required": [
+                "intoId"
+            ],
+            "properties": {
+                "intoId": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "internal_delivery_controller_admin.RejectFoodSubmissionRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Nutrition values do not match the label"
+                }
+            }
+        },
+        "internal_delivery_controller_admin.SetUserStatusRequest": {
+            "type": "object",
+            "required": [
+                "disabled"
+            ],
+            "properties": {
+                "disabled": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "internal_delivery_controller_authorize.CheckResponse": {
+            "type": "object",
+            "properties": {
+                "role": {
+                    "type": "string",
+                    "example": "user"
+                },
+                "userId": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
+        },
+        "internal_delivery_controller_authorize.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "secret123"
+                }
+            }
+        },
+        "internal_delivery_controller_authorize.RefreshTokenRequest": {
+            "type": "object",
+            "required": [
+                "refreshToken"
+            ],
+            "properties": {
+                "refreshToken": {
+                    "type": "string",
+                    "example": "3q2-7wRkT9m..."
+                }
+            }
+        },
+        "internal_delivery_controller_authorize.RegisterRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "fullName",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                },
+                "fullName": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "password": {
+                    "type": "string",
+                    "minLength": 6,
+                    "example": "secret123"
+                }
+            }
+        },
+        "internal_delivery_controller_authorize.ResetPasswordRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "token"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "minLength": 6,
+                    "example": "newSecret123"
+                },
+                "token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiJ9..."
+                }
+            }
+        },
+        "internal_delivery_controller_authorize.SendEmailRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "john@example.com"
+                }
+            }
+        },
+        "internal_delivery_controller_calendar.AddAvailabilityExceptionRequest": {
+            "type": "object",
+            "required": [
+                "date"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2024-12-25"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Holiday"
+                },
+                "ruleId": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "internal_delivery_controller_calendar.AddAvailabilityRequest": {
+            "type": "object",
+            "required": [
+                "slots"
+            ],
+            "properties": {
+                "slots": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/internal_delivery_controller_calendar.AvailabilitySlotRequest"
+                    }
+                }
+            }
+        },
+        "internal_delivery_controller_calendar.AvailabilitySlotRequest": {
+            "type": "object",
+            "required": [
+                "end",
+                "start"
+            ],
+            "properties": {
+                "end": {
+                    "type": "string",
+                    "example": "2024-03-15T11:00:00Z"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Gym, 2nd floor"
                 },
-                "updatedAt": {
-                    "type": "string"
+                "start": {
+                    "type": "string",
+                    "example": "2024-03-15T10:00:00Z"
                 }
             }
         },
-        "github_com_msskobelina_fit-profi_internal_domain_model.ExerciseProgress": {
+        "internal_delivery_controller_calendar.BookSessionRequest": {
             "type": "object",
             "properties": {
-                "createdAt": {
-                    "type": "string"
-                },
-                "exerciseId": {
-                    "type": "integer"
-                },
-                "id": {
-                    "type": "integer"
+                "availabilityId": {
+                    "type": "integer",
+                    "example": 17
                 },
                 "notes": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Focus on squat technique"
                 },
-                "reps": {
-                    "type": "integer"
+                "ruleId": {
+                    "type": "integer",
+                    "example": 3
                 },
-                "sets": {
-                    "type": "integer"
+                "start": {
+                    "type": "string",
+                    "example": "2024-03-18T07:00:00Z"
+                }
+            }
+        },
+        "internal_delivery_controller_calendar.CreateAvailabilityRuleRequest": {
+            "type": "object",
+            "required": [
+                "durationMinutes",
+                "rrule",
+                "start",
+                "timezone"
+            ],
+            "properties": {
+                "durationMinutes": {
+                    "type": "integer",
+                    "maximum": 1440,
+                    "example": 60
                 },
-                "updatedAt": {
-                    "type": "string"
+                "notes": {
+                    "type": "string",
+                    "example": "Gym, 2nd floor"
                 },
-                "userId": {
-                    "type": "integer"
+                "rrule": {
+                    "type": "string",
+                    "example": "FREQ=WEEKLY;BYDAY=MO,WE,FR"
                 },
-                "weightKg": {
-                    "type": "integer"
+                "start": {
+                    "type": "string",
+                    "example": "2024-03-04T09:00:00"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Europe/Kyiv"
                 }
             }
         },
-        "github_com_msskobelina_fit-profi_internal_domain_model.Goal": {
-            "type": "string",
-            "enum": [
-                "lose_weight",
-                "gain_weight",
-                "rehab",
-                "keep_fit",
-                "competition"
-            ],
-            "x-enum-varnames": [
-                "GoalLoseWeight",
-                "GoalGainWeight",
-                "GoalRehab",
-                "GoalKeepFit",
-                "GoalCompetition"
-            ]
-        },
-        "github_com_msskobelina_fit-profi_internal_domain_model.ProgramDay": {
+        "internal_delivery_controller_calendar.CreateEventRequest": {
             "type": "object",
+            "required": [
+                "end",
+                "start",
+                "title"
+            ],
             "properties": {
-                "createdAt": {
-                    "type": "string"
+                "attendees": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "coach@example.com"
+                    ]
                 },
-                "dayNumber": {
-                    "type": "integer"
+                "calendarId": {
+                    "type": "string",
+                    "example": "primary"
                 },
-                "exercises": {
+                "end": {
+                    "type": "string",
+                    "example": "2024-03-15T11:00:00Z"
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Leg day"
+                },
+                "reminders": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ProgramExercise"
-                    }
-                },
-                "id": {
-                    "type": "integer"
+                        "type": "integer"
+                    },
+                    "example": [
+                        10,
+                        30
+                    ]
                 },
-                "programId": {
-                    "type": "integer"
+                "start": {
+                    "type": "string",
+                    "example": "2024-03-15T10:00:00Z"
                 },
                 "title": {
-                    "type": "string"
-                },
-                "updatedAt": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Training session"
                 }
             }
         },
-        "github_com_msskobelina_fit-profi_internal_domain_model.ProgramExercise": {
+        "internal_delivery_controller_calendar.CreateEventResult": {
             "type": "object",
             "properties": {
-                "createdAt": {
-                    "type": "string"
+                "eventId": {
+                    "type": "string",
+                    "example": "abc123xyz"
+                }
+            }
+        },
+        "internal_delivery_controller_calendar.RescheduleBookingRequest": {
+            "type": "object",
+            "properties": {
+                "availabilityId": {
+                    "type": "integer",
+                    "example": 18
                 },
-                "dayId": {
-                    "type": "integer"
+                "ruleId": {
+                    "type": "integer",
+                    "example": 3
                 },
-                "id": {
-                    "type": "integer"
+                "start": {
+                    "type": "string",
+                    "example": "2024-03-20T07:00:00Z"
+                }
+            }
+        },
+        "internal_delivery_controller_coaching.InviteClientRequest": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "athlete@example.com"
+                }
+            }
+        },
+        "internal_delivery_controller_integrations.SelectGoogleCalendarRequest": {
+            "type": "object",
+            "required": [
+                "calendarId"
+            ],
+            "properties": {
+                "calendarId": {
+                    "type": "string",
+                    "example": "team@group.calendar.google.com"
+                }
+            }
+        },
+        "internal_delivery_controller_nutrition.AddItemRequest": {
+            "type": "object",
+            "properties": {
+                "caffeineMg": {
+                    "type": "number",
+                    "maximum": 5000,
+                    "minimum": 0,
+                    "example": 0
                 },
-                "name": {
-                    "type": "string"
+                "calories": {
+                    "type": "number",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 107
                 },
-                "notes": {
-                    "type": "string"
+                "carbsG": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 27
                 },
-                "reps": {
-                    "type": "integer"
+                "fatG": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 0.4
                 },
-                "sets": {
-                    "type": "integer"
+                "fibreG": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 3.1
                 },
-                "updatedAt": {
-                    "type": "string"
+                "foodId": {
+                    "type": "integer",
+                    "example": 42
                 },
-                "weightKg": {
-                    "type": "integer"
-                }
-            }
-        },
-        "github_com_msskobelina_fit-profi_internal_domain_model.TrainingProgram": {
-            "type": "object",
-            "properties": {
-                "createdAt": {
-                    "type": "string"
+                "grams": {
+                    "type": "number",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 120
                 },
-                "days": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ProgramDay"
-                    }
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Banana"
                 },
-                "description": {
-                    "type": "string"
+                "potassiumMg": {
+                    "type": "number",
+                    "maximum": 50000,
+                    "minimum": 0,
+                    "example": 422
                 },
-                "id": {
-                    "type": "integer"
+                "proteinG": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 1.3
                 },
-                "title": {
-                    "type": "string"
+                "sodiumMg": {
+                    "type": "number",
+                    "maximum": 50000,
+                    "minimum": 0,
+                    "example": 1
                 },
-                "updatedAt": {
-                    "type": "string"
+                "sugarG": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 14
                 },
-                "userId": {
-                    "type": "integer"
+                "waterMl": {
+                    "type": "number",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 89
                 }
             }
         },
-        "github_com_msskobelina_fit-profi_internal_domain_model.UserProfile": {
+        "internal_delivery_controller_nutrition.CopyEntriesRequest": {
             "type": "object",
+            "required": [
+                "fromDate",
+                "toDate"
+            ],
             "properties": {
-                "age": {
-                    "type": "integer"
-                },
-                "createdAt": {
-                    "type": "string"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "fullName": {
-                    "type": "string"
-                },
-                "goal": {
-                    "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.Goal"
-                },
-                "id": {
-                    "type": "integer"
+                "fromDate": {
+                    "type": "string",
+                    "example": "2024-03-14"
                 },
-                "updatedAt": {
-                    "type": "string"
+                "mealType": {
+                    "type": "string",
+                    "enum": [
+                        "breakfast",
+                        "lunch",
+                        "dinner",
+                        "snack"
+                    ],
+                    "example": "breakfast"
                 },
-                "userId": {
-                    "type": "integer"
+                "toDate": {
+                    "type": "string",
+                    "example": "2024-03-15"
                 },
-                "weightKg": {
-                    "type": "number"
+                "toMealType": {
+                    "type": "string",
+                    "enum": [
+                        "breakfast",
+                        "lunch",
+                        "dinner",
+                        "snack"
+                    ],
+                    "example": "snack"
                 }
             }
         },
-        "internal_delivery_controller_authorize.CheckResponse": {
+        "internal_delivery_controller_nutrition.CreateEntryRequest": {
             "type": "object",
+            "required": [
+                "date",
+                "items",
+                "mealType"
+            ],
             "properties": {
-                "role": {
+                "date": {
                     "type": "string",
-                    "example": "user"
+                    "example": "2024-03-15"
                 },
-                "userId": {
-                    "type": "integer",
-                    "example": 42
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryItem"
+                    }
+                },
+                "mealType": {
+                    "type": "string",
+                    "enum": [
+                        "breakfast",
+                        "lunch",
+                        "dinner",
+                        "snack"
+                    ],
+                    "example": "lunch"
                 }
             }
         },
-        "internal_delivery_controller_authorize.LoginRequest": {
+        "internal_delivery_controller_nutrition.CreateRecipeRequest": {
             "type": "object",
             "required": [
-                "email",
-                "password"
+                "ingredients",
+                "name",
+                "servings"
             ],
             "properties": {
-                "email": {
-                    "type": "string",
-                    "example": "john@example.com"
+                "ingredients": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.RecipeIngredient"
+                    }
                 },
-                "password": {
+                "name": {
                     "type": "string",
-                    "example": "secret123"
+                    "maxLength": 255,
+                    "example": "Overnight oats"
+                },
+                "servings": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1,
+                    "example": 2
+                },
+                "yieldGrams": {
+                    "type": "number",
+                    "maximum": 50000,
+                    "minimum": 0,
+                    "example": 600
                 }
             }
         },
-        "internal_delivery_controller_authorize.RegisterRequest": {
+        "internal_delivery_controller_nutrition.LogHydrationRequest": {
             "type": "object",
             "required": [
-                "email",
-                "fullName",
-                "password"
+                "amountMl",
+                "beverage",
+                "date"
             ],
             "properties": {
-                "email": {
-                    "type": "string",
-                    "example": "john@example.com"
+                "amountMl": {
+                    "type": "number",
+                    "maximum": 5000,
+                    "minimum": 0,
+                    "example": 500
                 },
-                "fullName": {
+                "beverage": {
                     "type": "string",
-                    "example": "John Doe"
+                    "maxLength": 64,
+                    "example": "water"
                 },
-                "password": {
+                "caffeineMg": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 0
+                },
+                "date": {
                     "type": "string",
-                    "minLength": 6,
-                    "example": "secret123"
+                    "example": "2024-03-15"
                 }
             }
         },
-        "internal_delivery_controller_authorize.ResetPasswordRequest": {
+        "internal_delivery_controller_nutrition.LogRecipeRequest": {
             "type": "object",
             "required": [
-                "password",
-                "token"
+                "date",
+                "mealType",
+                "servings"
             ],
             "properties": {
-                "password": {
+                "date": {
                     "type": "string",
-                    "minLength": 6,
-                    "example": "newSecret123"
+                    "example": "2024-03-15"
                 },
-                "token": {
+                "mealType": {
                     "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiJ9..."
+                    "enum": [
+                        "breakfast",
+                        "lunch",
+                        "dinner",
+                        "snack"
+                    ],
+                    "example": "breakfast"
+                },
+                "servings": {
+                    "type": "number",
+                    "maximum": 50,
+                    "example": 1.5
                 }
             }
         },
-        "internal_delivery_controller_authorize.SendEmailRequest": {
+        "internal_delivery_controller_nutrition.SetLimitsRequest": {
+            "type": "object",
+            "properties": {
+                "caffeineMaxMg": {
+                    "type": "number",
+                    "maximum": 2000,
+                    "example": 400
+                },
+                "fibreMinG": {
+                    "type": "number",
+                    "maximum": 200,
+                    "example": 30
+                },
+                "potassiumMinMg": {
+                    "type": "number",
+                    "maximum": 20000,
+                    "example": 3500
+                },
+                "sodiumMaxMg": {
+                    "type": "number",
+                    "maximum": 20000,
+                    "example": 2000
+                },
+                "sugarMaxG": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "example": 50
+                },
+                "waterMinMl": {
+                    "type": "number",
+                    "maximum": 10000,
+                    "example": 3000
+                }
+            }
+        },
+        "internal_delivery_controller_nutrition.SetTargetsRequest": {
             "type": "object",
             "required": [
-                "email"
+                "calories"
             ],
             "properties": {
-                "email": {
-                    "type": "string",
-                    "example": "john@example.com"
+                "calories": {
+                    "type": "number",
+                    "maximum": 10000,
+                    "example": 2200
+                },
+                "carbsG": {
+                    "type": "number",
+                    "maximum": 2000,
+                    "minimum": 0,
+                    "example": 230
+                },
+                "fatG": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 70
+                },
+                "proteinG": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 160
                 }
             }
         },
-        "internal_delivery_controller_calendar.CreateEventRequest": {
+        "internal_delivery_controller_nutrition.ShareRecipeRequest": {
             "type": "object",
             "required": [
-                "end",
-                "start",
-                "title"
+                "clientIds"
             ],
             "properties": {
-                "attendees": {
+                "clientIds": {
                     "type": "array",
+                    "minItems": 1,
                     "items": {
-                        "type": "string"
+                        "type": "integer"
                     },
                     "example": [
-                        "coach@example.com"
+                        12,
+                        15
                     ]
-                },
-                "calendarId": {
+                }
+            }
+        },
+        "internal_delivery_controller_nutrition.SubmitFoodRequest": {
+            "type": "object",
+            "required": [
+                "barcode",
+                "name"
+            ],
+            "properties": {
+                "barcode": {
                     "type": "string",
-                    "example": "primary"
+                    "example": "4820000000007"
                 },
-                "end": {
+                "brand": {
                     "type": "string",
-                    "example": "2024-03-15T11:00:00Z"
+                    "maxLength": 255,
+                    "example": "Halychyna"
                 },
-                "notes": {
-                    "type": "string",
-                    "example": "Leg day"
+                "caloriesPer100g": {
+                    "type": "number",
+                    "maximum": 900,
+                    "minimum": 0,
+                    "example": 53
                 },
-                "reminders": {
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    },
-                    "example": [
-                        10,
-                        30
-                    ]
+                "carbsPer100g": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 4.7
                 },
-                "start": {
-                    "type": "string",
-                    "example": "2024-03-15T10:00:00Z"
+                "fatPer100g": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 2.5
                 },
-                "title": {
+                "name": {
                     "type": "string",
-                    "example": "Training session"
+                    "maxLength": 255,
+                    "example": "Kefir 2.5%"
+                },
+                "proteinPer100g": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 2.8
+                },
+                "servingGrams": {
+                    "type": "number",
+                    "maximum": 5000,
+                    "minimum": 0,
+                    "example": 250
                 }
             }
         },
-        "internal_delivery_controller_calendar.CreateEventResult": {
+        "internal_delivery_controller_nutrition.UpdateEntryRequest": {
             "type": "object",
+            "required": [
+                "items",
+                "mealType"
+            ],
             "properties": {
-                "eventId": {
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryItem"
+                    }
+                },
+                "mealType": {
                     "type": "string",
-                    "example": "abc123xyz"
+                    "enum": [
+                        "breakfast",
+                        "lunch",
+                        "dinner",
+                        "snack"
+                    ],
+                    "example": "dinner"
                 }
             }
         },
-        "internal_delivery_controller_nutrition.CreateEntryRequest": {
+        "internal_delivery_controller_nutrition.UpdateItemRequest": {
             "type": "object",
-            "required": [
-                "date",
-                "items",
-                "mealType"
-            ],
             "properties": {
-                "date": {
+                "caffeineMg": {
+                    "type": "number",
+                    "maximum": 5000,
+                    "minimum": 0,
+                    "example": 0
+                },
+                "calories": {
+                    "type": "number",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 134
+                },
+                "carbsG": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 34
+                },
+                "fatG": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 0.5
+                },
+                "fibreG": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 3.9
+                },
+                "foodId": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "grams": {
+                    "type": "number",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 150
+                },
+                "name": {
                     "type": "string",
-                    "example": "2024-03-15"
+                    "maxLength": 255,
+                    "minLength": 1,
+                    "example": "Banana"
+                },
+                "potassiumMg": {
+                    "type": "number",
+                    "maximum": 50000,
+                    "minimum": 0,
+                    "example": 537
+                },
+                "proteinG": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 1.6
                 },
-                "items": {
-                    "type": "array",
-                    "minItems": 1,
-                    "items": {
-                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryItem"
-                    }
+                "sodiumMg": {
+                    "type": "number",
+                    "maximum": 50000,
+                    "minimum": 0,
+                    "example": 2
                 },
-                "mealType": {
-                    "type": "string",
-                    "enum": [
-                        "breakfast",
-                        "lunch",
-                        "dinner",
-                        "snack"
-                    ],
-                    "example": "lunch"
+                "sugarG": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 18
+                },
+                "waterMl": {
+                    "type": "number",
+                    "maximum": 10000,
+                    "minimum": 0,
+                    "example": 112
                 }
             }
         },
-        "internal_delivery_controller_nutrition.UpdateEntryRequest": {
+        "internal_delivery_controller_nutrition.UpdateRecipeRequest": {
             "type": "object",
             "required": [
-                "items",
-                "mealType"
+                "ingredients",
+                "name",
+                "servings"
             ],
             "properties": {
-                "items": {
+                "ingredients": {
                     "type": "array",
                     "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.DiaryItem"
+                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.RecipeIngredient"
                     }
                 },
-                "mealType": {
+                "name": {
                     "type": "string",
-                    "enum": [
-                        "breakfast",
-                        "lunch",
-                        "dinner",
-                        "snack"
-                    ],
-                    "example": "dinner"
+                    "maxLength": 255,
+                    "example": "Overnight oats"
+                },
+                "servings": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1,
+                    "example": 2
+                },
+                "yieldGrams": {
+                    "type": "number",
+                    "maximum": 50000,
+                    "minimum": 0,
+                    "example": 600
                 }
             }
         },
@@ -1784,6 +8405,17 @@ const docTemplate = `{
                 "weightKg"
             ],
             "properties": {
+                "activityLevel": {
+                    "type": "string",
+                    "enum": [
+                        "sedentary",
+                        "light",
+                        "moderate",
+                        "active",
+                        "very_active"
+                    ],
+                    "example": "moderate"
+                },
                 "age": {
                     "type": "integer",
                     "example": 25
@@ -1807,6 +8439,22 @@ const docTemplate = `{
                     ],
                     "example": "keep_fit"
                 },
+                "heightCm": {
+                    "type": "number",
+                    "example": 180
+                },
+                "sex": {
+                    "type": "string",
+                    "enum": [
+                        "male",
+                        "female"
+                    ],
+                    "example": "male"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Europe/Kyiv"
+                },
                 "weightKg": {
                     "type": "number",
                     "example": 75.5
@@ -1861,6 +8509,17 @@ const docTemplate = `{
                 "weightKg"
             ],
             "properties": {
+                "activityLevel": {
+                    "type": "string",
+                    "enum": [
+                        "sedentary",
+                        "light",
+                        "moderate",
+                        "active",
+                        "very_active"
+                    ],
+                    "example": "moderate"
+                },
                 "age": {
                     "type": "integer",
                     "example": 26
@@ -1884,22 +8543,73 @@ const docTemplate = `{
                     ],
                     "example": "lose_weight"
                 },
+                "heightCm": {
+                    "type": "number",
+                    "example": 180
+                },
+                "sex": {
+                    "type": "string",
+                    "enum": [
+                        "male",
+                        "female"
+                    ],
+                    "example": "male"
+                },
+                "timezone": {
+                    "type": "string",
+                    "example": "Europe/Kyiv"
+                },
                 "weightKg": {
                     "type": "number",
                     "example": 74
                 }
             }
         },
+        "internal_delivery_controller_programs.AbandonSessionRequest": {
+            "type": "object",
+            "properties": {
+                "notes": {
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "Shoulder pain"
+                }
+            }
+        },
+        "internal_delivery_controller_programs.AssignProgramRequest": {
+            "type": "object",
+            "required": [
+                "clientIds"
+            ],
+            "properties": {
+                "clientIds": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        12,
+                        15
+                    ]
+                }
+            }
+        },
         "internal_delivery_controller_programs.CreateProgramRequest": {
             "type": "object",
             "required": [
                 "title"
             ],
             "properties": {
+                "assigneeId": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 12
+                },
                 "days": {
                     "type": "array",
+                    "maxItems": 31,
                     "items": {
-                        "$ref": "#/definitions/github_com_msskobelina_fit-profi_internal_domain_model.ProgramDay"
+                        "$ref": "#/definitions/internal_delivery_controller_programs.ProgramDayRequest"
                     }
                 },
                 "description": {
@@ -1912,6 +8622,262 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_delivery_controller_programs.FinishSessionRequest": {
+            "type": "object",
+            "required": [
+                "sets"
+            ],
+            "properties": {
+                "endedAt": {
+                    "type": "string",
+                    "example": "2024-03-15T19:10:00Z"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "Felt strong today"
+                },
+                "sets": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "items": {
+                        "$ref": "#/definitions/internal_delivery_controller_programs.SessionSetRequest"
+                    }
+                }
+            }
+        },
+        "internal_delivery_controller_programs.ProgramDayRequest": {
+            "type": "object",
+            "properties": {
+                "dayNumber": {
+                    "type": "integer",
+                    "maximum": 31,
+                    "minimum": 0,
+                    "example": 1
+                },
+                "exercises": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "$ref": "#/definitions/internal_delivery_controller_programs.ProgramExerciseRequest"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Day 1"
+                }
+            }
+        },
+        "internal_delivery_controller_programs.ProgramExerciseRequest": {
+            "type": "object",
+            "properties": {
+                "catalogId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "distanceMeters": {
+                    "type": "number",
+                    "maximum": 1000000,
+                    "minimum": 0,
+                    "example": 400
+                },
+                "durationSeconds": {
+                    "type": "integer",
+                    "maximum": 86400,
+                    "minimum": 0,
+                    "example": 60
+                },
+                "group": {
+                    "type": "string",
+                    "maxLength": 8,
+                    "example": "A"
+                },
+                "groupType": {
+                    "type": "string",
+                    "enum": [
+                        "superset",
+                        "circuit"
+                    ],
+                    "example": "superset"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Bench press"
+                },
+                "notes": {
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "Pause at the chest"
+                },
+                "percentOneRm": {
+                    "type": "number",
+                    "maximum": 150,
+                    "example": 75
+                },
+                "reps": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 8
+                },
+                "repsMax": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "example": 12
+                },
+                "restSeconds": {
+                    "type": "integer",
+                    "maximum": 3600,
+                    "minimum": 0,
+                    "example": 90
+                },
+                "rir": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 0,
+                    "example": 2
+                },
+                "rpe": {
+                    "type": "number",
+                    "maximum": 10,
+                    "minimum": 1,
+                    "example": 8
+                },
+                "sets": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 3
+                },
+                "tempo": {
+                    "type": "string",
+                    "example": "3-1-1-0"
+                },
+                "weightKg": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 62.5
+                }
+            }
+        },
+        "internal_delivery_controller_programs.RescheduleWorkoutRequest": {
+            "type": "object",
+            "required": [
+                "start"
+            ],
+            "properties": {
+                "durationMinutes": {
+                    "type": "integer",
+                    "maximum": 1440,
+                    "example": 60
+                },
+                "start": {
+                    "type": "string",
+                    "example": "2024-03-16T18:00:00Z"
+                }
+            }
+        },
+        "internal_delivery_controller_programs.ScheduleWorkoutRequest": {
+            "type": "object",
+            "required": [
+                "dayId",
+                "durationMinutes",
+                "start"
+            ],
+            "properties": {
+                "dayId": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "durationMinutes": {
+                    "type": "integer",
+                    "maximum": 1440,
+                    "example": 60
+                },
+                "notes": {
+                    "type": "string",
+                    "example": "Before work"
+                },
+                "start": {
+                    "type": "string",
+                    "example": "2024-03-15T18:00:00Z"
+                }
+            }
+        },
+        "internal_delivery_controller_programs.SessionSetRequest": {
+            "type": "object",
+            "required": [
+                "exerciseId"
+            ],
+            "properties": {
+                "completed": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "exerciseId": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "reps": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 8
+                },
+                "restSeconds": {
+                    "type": "integer",
+                    "maximum": 3600,
+                    "minimum": 0,
+                    "example": 120
+                },
+                "rpe": {
+                    "type": "number",
+                    "maximum": 10,
+                    "minimum": 1,
+                    "example": 8.5
+                },
+                "setNumber": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0,
+                    "example": 1
+                },
+                "tempo": {
+                    "type": "string",
+                    "example": "3-1-1-0"
+                },
+                "weightKg": {
+                    "type": "number",
+                    "maximum": 1000,
+                    "minimum": 0,
+                    "example": 82.5
+                }
+            }
+        },
+        "internal_delivery_controller_programs.StartSessionRequest": {
+            "type": "object",
+            "required": [
+                "dayId",
+                "programId"
+            ],
+            "properties": {
+                "dayId": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "programId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "startedAt": {
+                    "type": "string",
+                    "example": "2024-03-15T18:00:00Z"
+                }
+            }
+        },
         "internal_delivery_controller_programs.TrackProgressRequest": {
             "type": "object",
             "required": [
@@ -1937,9 +8903,10 @@ const docTemplate = `{
                     "example": 3
                 },
                 "weightKg": {
-                    "type": "integer",
+                    "type": "number",
+                    "maximum": 1000,
                     "minimum": 0,
-                    "example": 60
+                    "example": 62.5
                 }
             }
         }
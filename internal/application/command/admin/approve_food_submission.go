@@ -0,0 +1,6 @@
+package admin
+
+type ApproveFoodSubmissionCommand struct {
+	ActorID      int
+	SubmissionID int
+}
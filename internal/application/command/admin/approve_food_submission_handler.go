@@ -0,0 +1,25 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type ApproveFoodSubmissionHandler interface {
+	ApproveFoodSubmission(ctx context.Context, cmd ApproveFoodSubmissionCommand) (*model.FoodSubmission, error)
+}
+
+type approveFoodSubmissionService struct {
+	foods repository.FoodsRepository
+}
+
+func NewApproveFoodSubmissionService(foods repository.FoodsRepository) ApproveFoodSubmissionHandler {
+	return &approveFoodSubmissionService{foods: foods}
+}
+
+// ApproveFoodSubmission adds the submitted food to the catalog.
+func (s *approveFoodSubmissionService) ApproveFoodSubmission(ctx context.Context, cmd ApproveFoodSubmissionCommand) (*model.FoodSubmission, error) {
+	return s.foods.ApproveFoodSubmission(ctx, cmd.SubmissionID, cmd.ActorID)
+}
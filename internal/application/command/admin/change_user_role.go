@@ -0,0 +1,7 @@
+package admin
+
+type ChangeUserRoleCommand struct {
+	ActorID int
+	UserID  int
+	Role    string
+}
@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type ChangeUserRoleHandler interface {
+	ChangeUserRole(ctx context.Context, cmd ChangeUserRoleCommand) error
+}
+
+type changeUserRoleService struct {
+	repo repository.UsersRepository
+}
+
+func NewChangeUserRoleService(repo repository.UsersRepository) ChangeUserRoleHandler {
+	return &changeUserRoleService{repo: repo}
+}
+
+func (s *changeUserRoleService) ChangeUserRole(ctx context.Context, cmd ChangeUserRoleCommand) error {
+	if cmd.ActorID == cmd.UserID {
+		return &utilsErrors.Error{Message: "You cannot change your own role"}
+	}
+
+	return s.repo.UpdateUserRole(ctx, cmd.UserID, model.Role(cmd.Role))
+}
@@ -0,0 +1,6 @@
+package admin
+
+type DeleteUserCommand struct {
+	ActorID int
+	UserID  int
+}
@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type DeleteUserHandler interface {
+	DeleteUser(ctx context.Context, cmd DeleteUserCommand) error
+}
+
+type deleteUserService struct {
+	repo repository.UsersRepository
+}
+
+func NewDeleteUserService(repo repository.UsersRepository) DeleteUserHandler {
+	return &deleteUserService{repo: repo}
+}
+
+func (s *deleteUserService) DeleteUser(ctx context.Context, cmd DeleteUserCommand) error {
+	if cmd.ActorID == cmd.UserID {
+		return &utilsErrors.Error{Message: "You cannot delete your own account"}
+	}
+
+	if err := s.repo.DeleteUser(ctx, cmd.UserID); err != nil {
+		return err
+	}
+
+	return s.repo.RevokeUserRefreshTokens(ctx, cmd.UserID)
+}
@@ -0,0 +1,6 @@
+package admin
+
+type MergeExercisesCommand struct {
+	ExerciseID int
+	IntoID     int
+}
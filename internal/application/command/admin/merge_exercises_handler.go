@@ -0,0 +1,30 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type MergeExercisesHandler interface {
+	MergeExercises(ctx context.Context, cmd MergeExercisesCommand) (*model.Exercise, error)
+}
+
+type mergeExercisesService struct {
+	exercises repository.ExercisesRepository
+}
+
+func NewMergeExercisesService(exercises repository.ExercisesRepository) MergeExercisesHandler {
+	return &mergeExercisesService{exercises: exercises}
+}
+
+// MergeExercises folds a duplicate catalog entry into another and returns the
+// entry that remains.
+func (s *mergeExercisesService) MergeExercises(ctx context.Context, cmd MergeExercisesCommand) (*model.Exercise, error) {
+	if cmd.ExerciseID == cmd.IntoID {
+		return nil, &utilsErrors.Error{Message: "Cannot merge an exercise into itself"}
+	}
+	return s.exercises.MergeExercises(ctx, cmd.ExerciseID, cmd.IntoID)
+}
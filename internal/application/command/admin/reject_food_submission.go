@@ -0,0 +1,7 @@
+package admin
+
+type RejectFoodSubmissionCommand struct {
+	ActorID      int
+	SubmissionID int
+	Reason       string
+}
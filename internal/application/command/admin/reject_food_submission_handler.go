@@ -0,0 +1,24 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type RejectFoodSubmissionHandler interface {
+	RejectFoodSubmission(ctx context.Context, cmd RejectFoodSubmissionCommand) (*model.FoodSubmission, error)
+}
+
+type rejectFoodSubmissionService struct {
+	foods repository.FoodsRepository
+}
+
+func NewRejectFoodSubmissionService(foods repository.FoodsRepository) RejectFoodSubmissionHandler {
+	return &rejectFoodSubmissionService{foods: foods}
+}
+
+func (s *rejectFoodSubmissionService) RejectFoodSubmission(ctx context.Context, cmd RejectFoodSubmissionCommand) (*model.FoodSubmission, error) {
+	return s.foods.RejectFoodSubmission(ctx, cmd.SubmissionID, cmd.ActorID, cmd.Reason)
+}
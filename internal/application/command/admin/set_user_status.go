@@ -0,0 +1,7 @@
+package admin
+
+type SetUserStatusCommand struct {
+	ActorID  int
+	UserID   int
+	Disabled bool
+}
@@ -0,0 +1,35 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type SetUserStatusHandler interface {
+	SetUserStatus(ctx context.Context, cmd SetUserStatusCommand) error
+}
+
+type setUserStatusService struct {
+	repo repository.UsersRepository
+}
+
+func NewSetUserStatusService(repo repository.UsersRepository) SetUserStatusHandler {
+	return &setUserStatusService{repo: repo}
+}
+
+func (s *setUserStatusService) SetUserStatus(ctx context.Context, cmd SetUserStatusCommand) error {
+	if cmd.ActorID == cmd.UserID {
+		return &utilsErrors.Error{Message: "You cannot change your own status"}
+	}
+
+	if err := s.repo.SetUserDisabled(ctx, cmd.UserID, cmd.Disabled); err != nil {
+		return err
+	}
+	if !cmd.Disabled {
+		return nil
+	}
+
+	return s.repo.RevokeUserRefreshTokens(ctx, cmd.UserID)
+}
@@ -6,8 +6,9 @@ type LoginUserCommand struct {
 }
 
 type LoginUserResult struct {
-	Token    string
-	UserID   int
-	FullName string
-	Email    string
+	Token        string
+	RefreshToken string
+	UserID       int
+	FullName     string
+	Email        string
 }
@@ -4,10 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
-	"github.com/golang-jwt/jwt/v5"
-	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/msskobelina/fit-profi/internal/domain/repository"
@@ -26,23 +23,19 @@ type loginUserService struct {
 	analytics  analytics.Client
 	metrics    *metric.Service
 	hmacSecret string
-	adminName  string
-	adminEmail string
 }
 
 func NewLoginUserService(
 	repo repository.UsersRepository,
 	analytics analytics.Client,
 	metrics *metric.Service,
-	hmacSecret, adminName, adminEmail string,
+	hmacSecret string,
 ) LoginUserHandler {
 	return &loginUserService{
 		repo:       repo,
 		analytics:  analytics,
 		metrics:    metrics,
 		hmacSecret: hmacSecret,
-		adminName:  adminName,
-		adminEmail: adminEmail,
 	}
 }
 
@@ -61,23 +54,14 @@ func (s *loginUserService) Login(ctx context.Context, cmd LoginUserCommand) (*Lo
 		return nil, err
 	}
 
-	role := access.UserRoleUser
-	if u.FullName == s.adminName && u.Email == s.adminEmail {
-		role = access.UserRoleAdmin
+	if u.Disabled {
+		s.metrics.TrackLoginFailed("disabled")
+		return nil, &utilsErrors.Error{Message: "User is disabled"}
 	}
 
-	now := time.Now()
-	token, err := access.EncodeToken(&access.Token{
-		RegisteredClaims: jwt.RegisteredClaims{
-			ID:        uuid.NewString(),
-			ExpiresAt: jwt.NewNumericDate(now.Add(14 * 24 * time.Hour)),
-			NotBefore: jwt.NewNumericDate(now),
-			IssuedAt:  jwt.NewNumericDate(now),
-			Issuer:    "fit-profi-api",
-		},
-		UserID:   u.ID,
-		UserRole: role,
-	}, s.hmacSecret)
+	role := access.UserRole(u.Role)
+
+	tokens, err := issueTokens(ctx, s.repo, u.ID, role, "", s.hmacSecret)
 	if err != nil {
 		return nil, err
 	}
@@ -89,9 +73,10 @@ func (s *loginUserService) Login(ctx context.Context, cmd LoginUserCommand) (*Lo
 	}
 
 	return &LoginUserResult{
-		Token:    token,
-		UserID:   u.ID,
-		FullName: u.FullName,
-		Email:    u.Email,
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		UserID:       u.ID,
+		FullName:     u.FullName,
+		Email:        u.Email,
 	}, nil
 }
@@ -26,5 +26,11 @@ func (s *logoutUserService) Logout(ctx context.Context, cmd LogoutUserCommand) e
 		return nil
 	}
 
+	if t.FamilyID != "" {
+		if err = s.repo.RevokeRefreshTokenFamily(ctx, t.FamilyID); err != nil {
+			return err
+		}
+	}
+
 	return s.repo.SaveRevokedToken(ctx, t.ID, t.ExpiresAt.Unix())
 }
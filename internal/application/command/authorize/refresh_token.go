@@ -0,0 +1,10 @@
+package authorize
+
+type RefreshTokenCommand struct {
+	RefreshToken string
+}
+
+type RefreshTokenResult struct {
+	Token        string
+	RefreshToken string
+}
@@ -0,0 +1,83 @@
+package authorize
+
+import (
+	"context"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/pkg/access"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type RefreshTokenHandler interface {
+	Refresh(ctx context.Context, cmd RefreshTokenCommand) (*RefreshTokenResult, error)
+}
+
+type refreshTokenService struct {
+	repo       repository.UsersRepository
+	hmacSecret string
+}
+
+func NewRefreshTokenService(repo repository.UsersRepository, hmacSecret string) RefreshTokenHandler {
+	return &refreshTokenService{repo: repo, hmacSecret: hmacSecret}
+}
+
+func (s *refreshTokenService) Refresh(ctx context.Context, cmd RefreshTokenCommand) (*RefreshTokenResult, error) {
+	current, err := s.repo.GetRefreshToken(ctx, hashRefreshToken(cmd.RefreshToken))
+	if err != nil {
+		return nil, err
+	}
+
+	// A revoked token being presented again means it has leaked: kill the
+	// whole family so neither the attacker nor the victim can keep using it.
+	if current.RevokedAt != nil {
+		if err = s.repo.RevokeRefreshTokenFamily(ctx, current.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, &utilsErrors.Error{Message: "Refresh token reuse detected"}
+	}
+	if time.Now().Unix() > current.ExpiresAt {
+		return nil, &utilsErrors.Error{Message: "Refresh token expired"}
+	}
+
+	u, err := s.repo.GetUserByID(ctx, current.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if u.Disabled {
+		return nil, &utilsErrors.Error{Message: "User is disabled"}
+	}
+
+	role := access.UserRole(u.Role)
+
+	accessToken, err := encodeAccessToken(u.ID, role, current.FamilyID, s.hmacSecret)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	rotated, err := s.repo.RotateRefreshToken(ctx, current.ID, model.RefreshToken{
+		UserID:    u.ID,
+		FamilyID:  current.FamilyID,
+		TokenHash: hashRefreshToken(refreshToken),
+		ExpiresAt: time.Now().Add(refreshTokenTTL).Unix(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !rotated {
+		if err = s.repo.RevokeRefreshTokenFamily(ctx, current.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, &utilsErrors.Error{Message: "Refresh token reuse detected"}
+	}
+
+	return &RefreshTokenResult{
+		Token:        accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
@@ -7,8 +7,9 @@ type RegisterUserCommand struct {
 }
 
 type RegisterUserResult struct {
-	Token    string
-	UserID   int
-	FullName string
-	Email    string
+	Token        string
+	RefreshToken string
+	UserID       int
+	FullName     string
+	Email        string
 }
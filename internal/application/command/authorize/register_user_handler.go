@@ -3,10 +3,7 @@ package authorize
 import (
 	"context"
 	"fmt"
-	"time"
 
-	"github.com/golang-jwt/jwt/v5"
-	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/msskobelina/fit-profi/internal/domain/repository"
@@ -24,23 +21,19 @@ type registerUserService struct {
 	analytics  analytics.Client
 	metrics    *metric.Service
 	hmacSecret string
-	adminName  string
-	adminEmail string
 }
 
 func NewRegisterUserService(
 	repo repository.UsersRepository,
 	analytics analytics.Client,
 	metrics *metric.Service,
-	hmacSecret, adminName, adminEmail string,
+	hmacSecret string,
 ) RegisterUserHandler {
 	return &registerUserService{
 		repo:       repo,
 		analytics:  analytics,
 		metrics:    metrics,
 		hmacSecret: hmacSecret,
-		adminName:  adminName,
-		adminEmail: adminEmail,
 	}
 }
 
@@ -57,23 +50,9 @@ func (s *registerUserService) Register(ctx context.Context, cmd RegisterUserComm
 
 	s.metrics.TrackUserCreated("api")
 
-	role := access.UserRoleUser
-	if u.FullName == s.adminName && u.Email == s.adminEmail {
-		role = access.UserRoleAdmin
-	}
+	role := access.UserRole(u.Role)
 
-	now := time.Now()
-	token, err := access.EncodeToken(&access.Token{
-		RegisteredClaims: jwt.RegisteredClaims{
-			ID:        uuid.NewString(),
-			ExpiresAt: jwt.NewNumericDate(now.Add(14 * 24 * time.Hour)),
-			NotBefore: jwt.NewNumericDate(now),
-			IssuedAt:  jwt.NewNumericDate(now),
-			Issuer:    "fit-profi-api",
-		},
-		UserID:   u.ID,
-		UserRole: role,
-	}, s.hmacSecret)
+	tokens, err := issueTokens(ctx, s.repo, u.ID, role, "", s.hmacSecret)
 	if err != nil {
 		return nil, err
 	}
@@ -85,9 +64,10 @@ func (s *registerUserService) Register(ctx context.Context, cmd RegisterUserComm
 	}
 
 	return &RegisterUserResult{
-		Token:    token,
-		UserID:   u.ID,
-		FullName: u.FullName,
-		Email:    u.Email,
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		UserID:       u.ID,
+		FullName:     u.FullName,
+		Email:        u.Email,
 	}, nil
 }
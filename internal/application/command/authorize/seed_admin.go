@@ -0,0 +1,7 @@
+package authorize
+
+type SeedAdminCommand struct {
+	FullName string
+	Email    string
+	Password string
+}
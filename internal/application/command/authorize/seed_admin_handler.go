@@ -0,0 +1,54 @@
+package authorize
+
+import (
+	"context"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type SeedAdminHandler interface {
+	SeedAdmin(ctx context.Context, cmd SeedAdminCommand) error
+}
+
+type seedAdminService struct {
+	repo repository.UsersRepository
+}
+
+func NewSeedAdminService(repo repository.UsersRepository) SeedAdminHandler {
+	return &seedAdminService{repo: repo}
+}
+
+// SeedAdmin makes sure the installation has an administrator. It is a no-op
+// once any admin exists, so later role changes made through the admin API are
+// not undone on restart. Nothing is seeded unless both cmd.Email and
+// cmd.Password are set. An existing account with cmd.Email is promoted;
+// otherwise the account is created.
+func (s *seedAdminService) SeedAdmin(ctx context.Context, cmd SeedAdminCommand) error {
+	if cmd.Email == "" || cmd.Password == "" {
+		return nil
+	}
+
+	n, err := s.repo.CountUsersByRole(ctx, model.RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+
+	u, _ := s.repo.GetUserByEmail(ctx, cmd.Email)
+	if u == nil {
+		hash, err := bcrypt.GenerateFromPassword([]byte(cmd.Password), 14)
+		if err != nil {
+			return err
+		}
+		if u, err = s.repo.CreateUser(ctx, cmd.FullName, cmd.Email, string(hash)); err != nil {
+			return err
+		}
+	}
+
+	return s.repo.UpdateUserRole(ctx, u.ID, model.RoleAdmin)
+}
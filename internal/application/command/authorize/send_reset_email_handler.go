@@ -20,21 +20,17 @@ type sendResetEmailService struct {
 	repo       repository.UsersRepository
 	email      email.Sender
 	hmacSecret string
-	adminName  string
-	adminEmail string
 }
 
 func NewSendResetEmailService(
 	repo repository.UsersRepository,
 	sender email.Sender,
-	hmacSecret, adminName, adminEmail string,
+	hmacSecret string,
 ) SendResetEmailHandler {
 	return &sendResetEmailService{
 		repo:       repo,
 		email:      sender,
 		hmacSecret: hmacSecret,
-		adminName:  adminName,
-		adminEmail: adminEmail,
 	}
 }
 
@@ -44,10 +40,7 @@ func (s *sendResetEmailService) SendResetEmail(ctx context.Context, cmd SendRese
 		return err
 	}
 
-	role := access.UserRoleUser
-	if u.FullName == s.adminName && u.Email == s.adminEmail {
-		role = access.UserRoleAdmin
-	}
+	role := access.UserRole(u.Role)
 
 	now := time.Now()
 	token, err := access.EncodeToken(&access.Token{
@@ -0,0 +1,91 @@
+package authorize
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/pkg/access"
+)
+
+const (
+	accessTokenTTL  = 15 * time.Minute
+	refreshTokenTTL = 14 * 24 * time.Hour
+)
+
+type tokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// issueTokens signs a short-lived access token and stores a new opaque refresh
+// token in the given family. An empty familyID starts a new family.
+func issueTokens(
+	ctx context.Context,
+	repo repository.UsersRepository,
+	userID int,
+	role access.UserRole,
+	familyID, hmacSecret string,
+) (*tokenPair, error) {
+	if familyID == "" {
+		familyID = uuid.NewString()
+	}
+
+	accessToken, err := encodeAccessToken(userID, role, familyID, hmacSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = repo.CreateRefreshToken(ctx, model.RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: hashRefreshToken(refreshToken),
+		ExpiresAt: time.Now().Add(refreshTokenTTL).Unix(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &tokenPair{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+func encodeAccessToken(userID int, role access.UserRole, familyID, hmacSecret string) (string, error) {
+	now := time.Now()
+	return access.EncodeToken(&access.Token{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			ExpiresAt: jwt.NewNumericDate(now.Add(accessTokenTTL)),
+			NotBefore: jwt.NewNumericDate(now),
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    "fit-profi-api",
+		},
+		UserID:   userID,
+		UserRole: role,
+		FamilyID: familyID,
+	}, hmacSecret)
+}
+
+func newRefreshToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
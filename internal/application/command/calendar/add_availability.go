@@ -0,0 +1,15 @@
+package calendar
+
+import "time"
+
+type AddAvailabilityCommand struct {
+	CoachID   int
+	CoachRole string
+	Slots     []AvailabilitySlot
+}
+
+type AvailabilitySlot struct {
+	Start time.Time
+	End   time.Time
+	Notes string
+}
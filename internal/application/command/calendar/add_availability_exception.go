@@ -0,0 +1,9 @@
+package calendar
+
+type AddAvailabilityExceptionCommand struct {
+	CoachID   int
+	CoachRole string
+	RuleID    int
+	Date      string
+	Reason    string
+}
@@ -0,0 +1,52 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type AddAvailabilityExceptionHandler interface {
+	AddAvailabilityException(ctx context.Context, cmd AddAvailabilityExceptionCommand) (*model.AvailabilityException, error)
+}
+
+type addAvailabilityExceptionService struct {
+	repo repository.CalendarRepository
+}
+
+func NewAddAvailabilityExceptionService(repo repository.CalendarRepository) AddAvailabilityExceptionHandler {
+	return &addAvailabilityExceptionService{repo: repo}
+}
+
+// AddAvailabilityException blocks a date for one rule or, when RuleID is
+// zero, for all of the coach's rules.
+func (s *addAvailabilityExceptionService) AddAvailabilityException(ctx context.Context, cmd AddAvailabilityExceptionCommand) (*model.AvailabilityException, error) {
+	if model.Role(cmd.CoachRole) != model.RoleCoach {
+		return nil, &utilsErrors.Error{Message: "Only coaches can manage availability", Status: http.StatusForbidden}
+	}
+	if _, err := time.Parse("2006-01-02", cmd.Date); err != nil {
+		return nil, &utilsErrors.Error{Message: "date must be formatted as YYYY-MM-DD"}
+	}
+
+	ex := model.AvailabilityException{
+		CoachID: cmd.CoachID,
+		Date:    cmd.Date,
+		Reason:  cmd.Reason,
+	}
+	if cmd.RuleID != 0 {
+		rule, err := s.repo.GetAvailabilityRuleByID(ctx, cmd.RuleID)
+		if err != nil {
+			return nil, err
+		}
+		if rule.CoachID != cmd.CoachID {
+			return nil, &utilsErrors.Error{Message: "Availability rule not found", Status: http.StatusNotFound}
+		}
+		ex.RuleID = &rule.ID
+	}
+
+	return s.repo.CreateAvailabilityException(ctx, ex)
+}
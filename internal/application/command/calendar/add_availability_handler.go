@@ -0,0 +1,59 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type AddAvailabilityHandler interface {
+	AddAvailability(ctx context.Context, cmd AddAvailabilityCommand) ([]model.CoachAvailability, error)
+}
+
+type addAvailabilityService struct {
+	repo repository.CalendarRepository
+}
+
+func NewAddAvailabilityService(repo repository.CalendarRepository) AddAvailabilityHandler {
+	return &addAvailabilityService{repo: repo}
+}
+
+func (s *addAvailabilityService) AddAvailability(ctx context.Context, cmd AddAvailabilityCommand) ([]model.CoachAvailability, error) {
+	if model.Role(cmd.CoachRole) != model.RoleCoach {
+		return nil, &utilsErrors.Error{Message: "Only coaches can publish availability", Status: http.StatusForbidden}
+	}
+
+	now := time.Now()
+	rows := make([]model.CoachAvailability, 0, len(cmd.Slots))
+	for _, sl := range cmd.Slots {
+		if !sl.End.After(sl.Start) {
+			return nil, &utilsErrors.Error{Message: "Slot end must be after its start"}
+		}
+		if !sl.Start.After(now) {
+			return nil, &utilsErrors.Error{Message: "Slots must start in the future"}
+		}
+		rows = append(rows, model.CoachAvailability{
+			CoachID:   cmd.CoachID,
+			StartTime: sl.Start.UTC(),
+			EndTime:   sl.End.UTC(),
+			Notes:     sl.Notes,
+		})
+	}
+
+	sort.Slice(rows, func(i, j int) bool { return rows[i].StartTime.Before(rows[j].StartTime) })
+	for i := 1; i < len(rows); i++ {
+		if rows[i].StartTime.Before(rows[i-1].EndTime) {
+			return nil, &utilsErrors.Error{Message: "Slots must not overlap each other"}
+		}
+	}
+
+	if err := s.repo.AddAvailability(ctx, rows); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,13 @@
+package calendar
+
+import "time"
+
+// BookSessionCommand books either a stored slot (AvailabilityID) or an
+// occurrence of a recurring rule (RuleID and Start).
+type BookSessionCommand struct {
+	ClientID       int
+	AvailabilityID int
+	RuleID         int
+	Start          time.Time
+	Notes          string
+}
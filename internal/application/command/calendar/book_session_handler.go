@@ -0,0 +1,68 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/calendarsync"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type BookSessionHandler interface {
+	BookSession(ctx context.Context, cmd BookSessionCommand) (*model.Booking, error)
+}
+
+type bookSessionService struct {
+	repo     repository.CalendarRepository
+	coaching repository.CoachingRepository
+	sync     calendarsync.Syncer
+}
+
+func NewBookSessionService(
+	repo repository.CalendarRepository,
+	coaching repository.CoachingRepository,
+	sync calendarsync.Syncer,
+) BookSessionHandler {
+	return &bookSessionService{repo: repo, coaching: coaching, sync: sync}
+}
+
+func (s *bookSessionService) BookSession(ctx context.Context, cmd BookSessionCommand) (*model.Booking, error) {
+	slot, err := findSlot(ctx, s.repo, cmd.AvailabilityID, cmd.RuleID, cmd.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	// Slots are only visible to the coach's active clients.
+	ok, err := s.coaching.IsActiveCoach(ctx, slot.CoachID, cmd.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, &utilsErrors.Error{Message: "Availability slot not found", Status: http.StatusNotFound}
+	}
+
+	if !slot.StartTime.After(time.Now()) {
+		return nil, &utilsErrors.Error{Message: "Slot has already started"}
+	}
+
+	if slot.ID == 0 {
+		if slot, err = s.repo.MaterializeSlot(ctx, *slot); err != nil {
+			return nil, err
+		}
+	}
+
+	b, err := s.repo.CreateBooking(ctx, model.Booking{
+		AvailabilityID: slot.ID,
+		CoachID:        slot.CoachID,
+		ClientID:       cmd.ClientID,
+		Notes:          cmd.Notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.sync.SyncBooking(b.ID)
+	return b, nil
+}
@@ -0,0 +1,6 @@
+package calendar
+
+type CancelBookingCommand struct {
+	UserID    int
+	BookingID int
+}
@@ -0,0 +1,65 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/calendarsync"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+// clientCancellationCutoff is how long before a session a client may still
+// cancel or reschedule it. Coaches can do so until the session starts.
+const clientCancellationCutoff = 24 * time.Hour
+
+type CancelBookingHandler interface {
+	CancelBooking(ctx context.Context, cmd CancelBookingCommand) (*model.Booking, error)
+}
+
+type cancelBookingService struct {
+	repo repository.CalendarRepository
+	sync calendarsync.Syncer
+}
+
+func NewCancelBookingService(repo repository.CalendarRepository, sync calendarsync.Syncer) CancelBookingHandler {
+	return &cancelBookingService{repo: repo, sync: sync}
+}
+
+func (s *cancelBookingService) CancelBooking(ctx context.Context, cmd CancelBookingCommand) (*model.Booking, error) {
+	b, err := s.repo.GetBookingByID(ctx, cmd.BookingID)
+	if err != nil {
+		return nil, err
+	}
+	if b.CoachID != cmd.UserID && b.ClientID != cmd.UserID {
+		return nil, &utilsErrors.Error{Message: "Booking not found", Status: http.StatusNotFound}
+	}
+	if b.Status != model.BookingBooked {
+		return nil, &utilsErrors.Error{Message: "Booking is already cancelled", Status: http.StatusConflict}
+	}
+
+	now := time.Now()
+	if err = checkCutoff(b, cmd.UserID, now); err != nil {
+		return nil, err
+	}
+
+	b, err = s.repo.CancelBooking(ctx, b.ID, cmd.UserID, now)
+	if err != nil {
+		return nil, err
+	}
+	s.sync.SyncBooking(b.ID)
+	return b, nil
+}
+
+// checkCutoff reports whether the user may still cancel or move the booking.
+func checkCutoff(b *model.Booking, userID int, now time.Time) error {
+	if !b.StartTime.After(now) {
+		return &utilsErrors.Error{Message: "Session has already started"}
+	}
+	if b.ClientID == userID && b.StartTime.Sub(now) < clientCancellationCutoff {
+		return &utilsErrors.Error{Message: "Sessions can only be changed at least 24 hours in advance"}
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package calendar
+
+type CreateAvailabilityRuleCommand struct {
+	CoachID         int
+	CoachRole       string
+	Start           string
+	DurationMinutes int
+	Timezone        string
+	RRule           string
+	Notes           string
+}
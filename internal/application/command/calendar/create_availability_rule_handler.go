@@ -0,0 +1,50 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+	"github.com/msskobelina/fit-profi/pkg/rrule"
+)
+
+type CreateAvailabilityRuleHandler interface {
+	CreateAvailabilityRule(ctx context.Context, cmd CreateAvailabilityRuleCommand) (*model.AvailabilityRule, error)
+}
+
+type createAvailabilityRuleService struct {
+	repo repository.CalendarRepository
+}
+
+func NewCreateAvailabilityRuleService(repo repository.CalendarRepository) CreateAvailabilityRuleHandler {
+	return &createAvailabilityRuleService{repo: repo}
+}
+
+func (s *createAvailabilityRuleService) CreateAvailabilityRule(ctx context.Context, cmd CreateAvailabilityRuleCommand) (*model.AvailabilityRule, error) {
+	if model.Role(cmd.CoachRole) != model.RoleCoach {
+		return nil, &utilsErrors.Error{Message: "Only coaches can publish availability", Status: http.StatusForbidden}
+	}
+
+	loc, err := time.LoadLocation(cmd.Timezone)
+	if err != nil {
+		return nil, &utilsErrors.Error{Message: "Unknown timezone"}
+	}
+	if _, err = time.ParseInLocation(model.AvailabilityRuleLayout, cmd.Start, loc); err != nil {
+		return nil, &utilsErrors.Error{Message: "start must be a local date-time like 2024-03-04T09:00:00"}
+	}
+	if _, err = rrule.Parse(cmd.RRule, loc); err != nil {
+		return nil, &utilsErrors.Error{Message: err.Error()}
+	}
+
+	return s.repo.CreateAvailabilityRule(ctx, model.AvailabilityRule{
+		CoachID:         cmd.CoachID,
+		Start:           cmd.Start,
+		DurationMinutes: cmd.DurationMinutes,
+		Timezone:        cmd.Timezone,
+		RRule:           cmd.RRule,
+		Notes:           cmd.Notes,
+	})
+}
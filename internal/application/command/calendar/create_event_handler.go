@@ -4,12 +4,12 @@ import (
 	"context"
 	"time"
 
-	"golang.org/x/oauth2"
 	googlecalendar "google.golang.org/api/calendar/v3"
 	"google.golang.org/api/option"
 
 	"github.com/msskobelina/fit-profi/internal/domain/model"
 	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/providers"
 )
 
 type CreateEventHandler interface {
@@ -18,11 +18,11 @@ type CreateEventHandler interface {
 
 type createEventService struct {
 	integRepo repository.IntegrationsRepository
-	oauthCfg  *oauth2.Config
+	providers *providers.Registry
 }
 
-func NewCreateEventService(integRepo repository.IntegrationsRepository, oauthCfg *oauth2.Config) CreateEventHandler {
-	return &createEventService{integRepo: integRepo, oauthCfg: oauthCfg}
+func NewCreateEventService(integRepo repository.IntegrationsRepository, registry *providers.Registry) CreateEventHandler {
+	return &createEventService{integRepo: integRepo, providers: registry}
 }
 
 func (s *createEventService) CreateEvent(ctx context.Context, cmd CreateEventCommand) (*CreateEventResult, error) {
@@ -31,19 +31,18 @@ func (s *createEventService) CreateEvent(ctx context.Context, cmd CreateEventCom
 		return nil, err
 	}
 
-	tok := &oauth2.Token{
-		AccessToken:  integ.AccessToken,
-		RefreshToken: integ.RefreshToken,
-		Expiry:       time.Unix(integ.ExpiryUnix, 0),
+	ts, err := s.providers.TokenSource(ctx, s.integRepo, integ)
+	if err != nil {
+		return nil, err
 	}
-	svc, err := googlecalendar.NewService(ctx, option.WithTokenSource(s.oauthCfg.TokenSource(ctx, tok)))
+	svc, err := googlecalendar.NewService(ctx, option.WithTokenSource(ts))
 	if err != nil {
 		return nil, err
 	}
 
 	calID := cmd.CalendarID
 	if calID == "" {
-		calID = "primary"
+		calID = integ.CalendarID
 	}
 
 	var reminders []*googlecalendar.EventReminder
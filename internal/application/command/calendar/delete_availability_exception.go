@@ -0,0 +1,6 @@
+package calendar
+
+type DeleteAvailabilityExceptionCommand struct {
+	CoachID     int
+	ExceptionID int
+}
@@ -0,0 +1,23 @@
+package calendar
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type DeleteAvailabilityExceptionHandler interface {
+	DeleteAvailabilityException(ctx context.Context, cmd DeleteAvailabilityExceptionCommand) error
+}
+
+type deleteAvailabilityExceptionService struct {
+	repo repository.CalendarRepository
+}
+
+func NewDeleteAvailabilityExceptionService(repo repository.CalendarRepository) DeleteAvailabilityExceptionHandler {
+	return &deleteAvailabilityExceptionService{repo: repo}
+}
+
+func (s *deleteAvailabilityExceptionService) DeleteAvailabilityException(ctx context.Context, cmd DeleteAvailabilityExceptionCommand) error {
+	return s.repo.DeleteAvailabilityException(ctx, cmd.ExceptionID, cmd.CoachID)
+}
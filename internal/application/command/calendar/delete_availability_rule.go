@@ -0,0 +1,6 @@
+package calendar
+
+type DeleteAvailabilityRuleCommand struct {
+	CoachID int
+	RuleID  int
+}
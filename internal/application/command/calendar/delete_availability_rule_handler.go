@@ -0,0 +1,23 @@
+package calendar
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type DeleteAvailabilityRuleHandler interface {
+	DeleteAvailabilityRule(ctx context.Context, cmd DeleteAvailabilityRuleCommand) error
+}
+
+type deleteAvailabilityRuleService struct {
+	repo repository.CalendarRepository
+}
+
+func NewDeleteAvailabilityRuleService(repo repository.CalendarRepository) DeleteAvailabilityRuleHandler {
+	return &deleteAvailabilityRuleService{repo: repo}
+}
+
+func (s *deleteAvailabilityRuleService) DeleteAvailabilityRule(ctx context.Context, cmd DeleteAvailabilityRuleCommand) error {
+	return s.repo.DeleteAvailabilityRule(ctx, cmd.RuleID, cmd.CoachID)
+}
@@ -0,0 +1,13 @@
+package calendar
+
+import "time"
+
+// RescheduleBookingCommand moves a booking to a stored slot (AvailabilityID)
+// or to an occurrence of a recurring rule (RuleID and Start).
+type RescheduleBookingCommand struct {
+	UserID         int
+	BookingID      int
+	AvailabilityID int
+	RuleID         int
+	Start          time.Time
+}
@@ -0,0 +1,65 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/calendarsync"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type RescheduleBookingHandler interface {
+	RescheduleBooking(ctx context.Context, cmd RescheduleBookingCommand) (*model.Booking, error)
+}
+
+type rescheduleBookingService struct {
+	repo repository.CalendarRepository
+	sync calendarsync.Syncer
+}
+
+func NewRescheduleBookingService(repo repository.CalendarRepository, sync calendarsync.Syncer) RescheduleBookingHandler {
+	return &rescheduleBookingService{repo: repo, sync: sync}
+}
+
+func (s *rescheduleBookingService) RescheduleBooking(ctx context.Context, cmd RescheduleBookingCommand) (*model.Booking, error) {
+	b, err := s.repo.GetBookingByID(ctx, cmd.BookingID)
+	if err != nil {
+		return nil, err
+	}
+	if b.CoachID != cmd.UserID && b.ClientID != cmd.UserID {
+		return nil, &utilsErrors.Error{Message: "Booking not found", Status: http.StatusNotFound}
+	}
+	if b.Status != model.BookingBooked {
+		return nil, &utilsErrors.Error{Message: "Booking is already cancelled", Status: http.StatusConflict}
+	}
+	now := time.Now()
+	if err = checkCutoff(b, cmd.UserID, now); err != nil {
+		return nil, err
+	}
+
+	slot, err := findSlot(ctx, s.repo, cmd.AvailabilityID, cmd.RuleID, cmd.Start)
+	if err != nil {
+		return nil, err
+	}
+	if slot.CoachID != b.CoachID {
+		return nil, &utilsErrors.Error{Message: "Availability slot not found", Status: http.StatusNotFound}
+	}
+	if !slot.StartTime.After(now) {
+		return nil, &utilsErrors.Error{Message: "Slot has already started"}
+	}
+	if slot.ID == 0 {
+		if slot, err = s.repo.MaterializeSlot(ctx, *slot); err != nil {
+			return nil, err
+		}
+	}
+
+	b, err = s.repo.RescheduleBooking(ctx, b.ID, slot.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.sync.SyncBooking(b.ID)
+	return b, nil
+}
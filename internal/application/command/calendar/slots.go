@@ -0,0 +1,39 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+// findSlot resolves a stored slot by ID or, when ruleID is set, the occurrence
+// of that rule starting at start. Occurrences come back unsaved (ID 0).
+func findSlot(ctx context.Context, repo repository.CalendarRepository, availabilityID, ruleID int, start time.Time) (*model.CoachAvailability, error) {
+	if ruleID == 0 {
+		return repo.GetAvailabilityByID(ctx, availabilityID)
+	}
+
+	rule, err := repo.GetAvailabilityRuleByID(ctx, ruleID)
+	if err != nil {
+		return nil, err
+	}
+	exceptions, err := repo.ListAvailabilityExceptions(ctx, rule.CoachID)
+	if err != nil {
+		return nil, err
+	}
+	end := start.Add(time.Duration(rule.DurationMinutes) * time.Minute)
+	occ, err := rule.Expand(start, end, exceptions)
+	if err != nil {
+		return nil, err
+	}
+	for i := range occ {
+		if occ[i].StartTime.Equal(start) {
+			return &occ[i], nil
+		}
+	}
+	return nil, &utilsErrors.Error{Message: "Availability slot not found", Status: http.StatusNotFound}
+}
@@ -0,0 +1,7 @@
+package coaching
+
+type InviteClientCommand struct {
+	CoachID     int
+	CoachRole   string
+	ClientEmail string
+}
@@ -0,0 +1,60 @@
+package coaching
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type InviteClientHandler interface {
+	InviteClient(ctx context.Context, cmd InviteClientCommand) (*model.CoachingRelationship, error)
+}
+
+type inviteClientService struct {
+	repo  repository.CoachingRepository
+	users repository.UsersRepository
+}
+
+func NewInviteClientService(repo repository.CoachingRepository, users repository.UsersRepository) InviteClientHandler {
+	return &inviteClientService{repo: repo, users: users}
+}
+
+func (s *inviteClientService) InviteClient(ctx context.Context, cmd InviteClientCommand) (*model.CoachingRelationship, error) {
+	if model.Role(cmd.CoachRole) != model.RoleCoach {
+		return nil, &utilsErrors.Error{Message: "Only coaches can invite clients", Status: http.StatusForbidden}
+	}
+
+	email := strings.ToLower(strings.TrimSpace(cmd.ClientEmail))
+	coach, err := s.users.GetUserByID(ctx, cmd.CoachID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(coach.Email, email) {
+		return nil, &utilsErrors.Error{Message: "You cannot invite yourself"}
+	}
+
+	open, err := s.repo.FindOpenRelationship(ctx, cmd.CoachID, email)
+	if err != nil {
+		return nil, err
+	}
+	if open != nil {
+		return nil, &utilsErrors.Error{Message: "Client already invited"}
+	}
+
+	rel := model.CoachingRelationship{
+		CoachID:     cmd.CoachID,
+		ClientEmail: email,
+		Status:      model.CoachingPending,
+		InvitedAt:   time.Now(),
+	}
+	if u, _ := s.users.GetUserByEmail(ctx, email); u != nil {
+		rel.ClientID = u.ID
+	}
+
+	return s.repo.CreateRelationship(ctx, rel)
+}
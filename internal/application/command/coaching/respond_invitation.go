@@ -0,0 +1,7 @@
+package coaching
+
+type RespondInvitationCommand struct {
+	RelationshipID int
+	UserID         int
+	Accept         bool
+}
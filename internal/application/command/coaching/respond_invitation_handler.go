@@ -0,0 +1,67 @@
+package coaching
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type RespondInvitationHandler interface {
+	RespondInvitation(ctx context.Context, cmd RespondInvitationCommand) (*model.CoachingRelationship, error)
+}
+
+type respondInvitationService struct {
+	repo  repository.CoachingRepository
+	users repository.UsersRepository
+}
+
+func NewRespondInvitationService(repo repository.CoachingRepository, users repository.UsersRepository) RespondInvitationHandler {
+	return &respondInvitationService{repo: repo, users: users}
+}
+
+func (s *respondInvitationService) RespondInvitation(ctx context.Context, cmd RespondInvitationCommand) (*model.CoachingRelationship, error) {
+	rel, err := s.repo.GetRelationshipByID(ctx, cmd.RelationshipID)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.users.GetUserByID(ctx, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(rel.ClientEmail, u.Email) {
+		return nil, &utilsErrors.Error{Message: "Invitation not found", Status: http.StatusNotFound}
+	}
+	if rel.Status != model.CoachingPending {
+		return nil, &utilsErrors.Error{Message: "Invitation is no longer pending"}
+	}
+
+	now := time.Now()
+	if !cmd.Accept {
+		return s.repo.UpdateRelationship(ctx, rel.ID, model.CoachingRelationship{
+			ClientID:   u.ID,
+			Status:     model.CoachingRejected,
+			RejectedAt: &now,
+		})
+	}
+
+	current, err := s.repo.GetActiveByClient(ctx, u.ID)
+	var notFound *utilsErrors.Error
+	if err != nil && !errors.As(err, &notFound) {
+		return nil, err
+	}
+	if current != nil {
+		return nil, &utilsErrors.Error{Message: "You already have an active coach"}
+	}
+
+	return s.repo.UpdateRelationship(ctx, rel.ID, model.CoachingRelationship{
+		ClientID:   u.ID,
+		Status:     model.CoachingActive,
+		AcceptedAt: &now,
+	})
+}
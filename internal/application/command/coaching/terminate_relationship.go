@@ -0,0 +1,6 @@
+package coaching
+
+type TerminateRelationshipCommand struct {
+	RelationshipID int
+	UserID         int
+}
@@ -0,0 +1,51 @@
+package coaching
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type TerminateRelationshipHandler interface {
+	TerminateRelationship(ctx context.Context, cmd TerminateRelationshipCommand) (*model.CoachingRelationship, error)
+}
+
+type terminateRelationshipService struct {
+	repo repository.CoachingRepository
+}
+
+func NewTerminateRelationshipService(repo repository.CoachingRepository) TerminateRelationshipHandler {
+	return &terminateRelationshipService{repo: repo}
+}
+
+// TerminateRelationship ends an active relationship, or withdraws a pending
+// invitation when called by the coach. Either party may terminate.
+func (s *terminateRelationshipService) TerminateRelationship(ctx context.Context, cmd TerminateRelationshipCommand) (*model.CoachingRelationship, error) {
+	rel, err := s.repo.GetRelationshipByID(ctx, cmd.RelationshipID)
+	if err != nil {
+		return nil, err
+	}
+
+	isCoach := rel.CoachID == cmd.UserID
+	isClient := rel.ClientID != 0 && rel.ClientID == cmd.UserID
+	if !isCoach && !isClient {
+		return nil, &utilsErrors.Error{Message: "Coaching relationship not found", Status: http.StatusNotFound}
+	}
+
+	switch {
+	case rel.Status == model.CoachingActive:
+	case rel.Status == model.CoachingPending && isCoach:
+	default:
+		return nil, &utilsErrors.Error{Message: "Coaching relationship is not active"}
+	}
+
+	now := time.Now()
+	return s.repo.UpdateRelationship(ctx, rel.ID, model.CoachingRelationship{
+		Status:       model.CoachingTerminated,
+		TerminatedAt: &now,
+	})
+}
@@ -0,0 +1,42 @@
+package integrations
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	googlecalendar "google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/providers"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+// lookupCalendar fetches calendarID from the user's calendar list, which
+// carries the access role and time zone of the calendar.
+func lookupCalendar(
+	ctx context.Context,
+	registry *providers.Registry,
+	repo repository.IntegrationsRepository,
+	integ *model.UserIntegration,
+	calendarID string,
+) (*googlecalendar.CalendarListEntry, error) {
+	ts, err := registry.TokenSource(ctx, repo, integ)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := googlecalendar.NewService(ctx, option.WithTokenSource(ts))
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := svc.CalendarList.Get(calendarID).Context(ctx).Do()
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) && gerr.Code == http.StatusNotFound {
+		return nil, &utilsErrors.Error{Message: "Calendar not found", Status: http.StatusNotFound}
+	}
+	return entry, err
+}
@@ -0,0 +1,12 @@
+package integrations
+
+import "github.com/msskobelina/fit-profi/internal/domain/model"
+
+type ConnectCommand struct {
+	UserID   int
+	Provider model.Provider
+}
+
+type ConnectResult struct {
+	RedirectURL string
+}
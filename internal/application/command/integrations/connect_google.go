@@ -1,9 +0,0 @@
-package integrations
-
-type ConnectGoogleCommand struct {
-	UserID int
-}
-
-type ConnectGoogleResult struct {
-	RedirectURL string
-}
@@ -0,0 +1,30 @@
+package integrations
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/infrastructure/providers"
+)
+
+type ConnectHandler interface {
+	Connect(ctx context.Context, cmd ConnectCommand) (*ConnectResult, error)
+}
+
+type connectService struct {
+	providers  *providers.Registry
+	hmacSecret string
+}
+
+func NewConnectService(registry *providers.Registry, hmacSecret string) ConnectHandler {
+	return &connectService{providers: registry, hmacSecret: hmacSecret}
+}
+
+func (s *connectService) Connect(_ context.Context, cmd ConnectCommand) (*ConnectResult, error) {
+	p, err := s.providers.Get(cmd.Provider)
+	if err != nil {
+		return nil, err
+	}
+	state := signState(s.hmacSecret, cmd.UserID)
+
+	return &ConnectResult{RedirectURL: p.AuthURL(state)}, nil
+}
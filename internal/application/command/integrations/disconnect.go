@@ -0,0 +1,8 @@
+package integrations
+
+import "github.com/msskobelina/fit-profi/internal/domain/model"
+
+type DisconnectCommand struct {
+	UserID   int
+	Provider model.Provider
+}
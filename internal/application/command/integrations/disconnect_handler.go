@@ -0,0 +1,42 @@
+package integrations
+
+import (
+	"context"
+
+	"golang.org/x/oauth2"
+
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/providers"
+)
+
+type DisconnectHandler interface {
+	Disconnect(ctx context.Context, cmd DisconnectCommand) error
+}
+
+type disconnectService struct {
+	repo      repository.IntegrationsRepository
+	providers *providers.Registry
+}
+
+func NewDisconnectService(repo repository.IntegrationsRepository, registry *providers.Registry) DisconnectHandler {
+	return &disconnectService{repo: repo, providers: registry}
+}
+
+func (s *disconnectService) Disconnect(ctx context.Context, cmd DisconnectCommand) error {
+	p, err := s.providers.Get(cmd.Provider)
+	if err != nil {
+		return err
+	}
+	integ, err := s.repo.GetByUserAndProvider(ctx, cmd.UserID, p.Name())
+	if err != nil {
+		return err
+	}
+
+	stored := &oauth2.Token{AccessToken: integ.AccessToken, RefreshToken: integ.RefreshToken}
+	ts := providers.NewTokenSource(ctx, p, s.repo, integ)
+	if err = p.Revoke(ctx, stored, ts); err != nil {
+		return err
+	}
+
+	return s.repo.Delete(ctx, cmd.UserID, p.Name())
+}
@@ -1,6 +1,9 @@
 package integrations
 
+import "github.com/msskobelina/fit-profi/internal/domain/model"
+
 type ExchangeCallbackCommand struct {
-	State string
-	Code  string
+	Provider model.Provider
+	State    string
+	Code     string
 }
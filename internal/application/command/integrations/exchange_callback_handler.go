@@ -2,19 +2,11 @@ package integrations
 
 import (
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/base64"
 	"fmt"
-	"strconv"
-	"strings"
-	"time"
-
-	"golang.org/x/oauth2"
-	"google.golang.org/api/calendar/v3"
 
 	"github.com/msskobelina/fit-profi/internal/domain/model"
 	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/providers"
 )
 
 type ExchangeCallbackHandler interface {
@@ -23,72 +15,62 @@ type ExchangeCallbackHandler interface {
 
 type exchangeCallbackService struct {
 	repo       repository.IntegrationsRepository
-	oauth      *oauth2.Config
+	providers  *providers.Registry
 	hmacSecret string
 }
 
 func NewExchangeCallbackService(
 	repo repository.IntegrationsRepository,
-	oauthCfg *oauth2.Config,
+	registry *providers.Registry,
 	hmacSecret string,
 ) ExchangeCallbackHandler {
-	return &exchangeCallbackService{repo: repo, oauth: oauthCfg, hmacSecret: hmacSecret}
+	return &exchangeCallbackService{repo: repo, providers: registry, hmacSecret: hmacSecret}
 }
 
 func (s *exchangeCallbackService) ExchangeCallback(ctx context.Context, cmd ExchangeCallbackCommand) error {
-	uid, ok := s.verifyState(cmd.State, 10*time.Minute)
+	p, err := s.providers.Get(cmd.Provider)
+	if err != nil {
+		return err
+	}
+	uid, ok := verifyState(s.hmacSecret, cmd.State, stateMaxAge)
 	if !ok || cmd.Code == "" {
 		return fmt.Errorf("invalid state/code")
 	}
 
-	tok, err := s.oauth.Exchange(ctx, cmd.Code)
+	tok, err := p.Exchange(ctx, cmd.Code)
 	if err != nil {
 		return err
 	}
 
-	_, err = s.repo.Upsert(ctx, model.UserIntegration{
+	integ, err := s.repo.Upsert(ctx, model.UserIntegration{
 		UserID:       uid,
-		Provider:     model.ProviderGoogle,
+		Provider:     p.Name(),
 		AccessToken:  tok.AccessToken,
 		RefreshToken: tok.RefreshToken,
 		ExpiryUnix:   tok.Expiry.Unix(),
-		Scope:        calendar.CalendarScope,
-		CalendarID:   "primary",
-		Timezone:     "Europe/Kyiv",
+		Scope:        p.Scope(),
 	})
-
-	return err
-}
-
-func (s *exchangeCallbackService) verifyState(state string, maxAge time.Duration) (int, bool) {
-	parts := strings.Split(state, ".")
-	if len(parts) != 2 {
-		return 0, false
-	}
-	raw, err := base64.RawURLEncoding.DecodeString(parts[0])
 	if err != nil {
-		return 0, false
+		return err
 	}
-	mac := hmac.New(sha256.New, []byte(s.hmacSecret))
-	mac.Write(raw)
-	if base64.RawURLEncoding.EncodeToString(mac.Sum(nil)) != parts[1] {
-		return 0, false
+
+	// Reconnecting clears a previous invalid_grant failure.
+	if err = s.repo.UpdateStatus(ctx, uid, p.Name(), model.IntegrationActive, ""); err != nil {
+		return err
 	}
-	sp := strings.Split(string(raw), ":")
-	if len(sp) != 2 {
-		return 0, false
+
+	// A reconnect keeps the calendar chosen earlier; a first connect starts on
+	// the primary calendar and takes its time zone.
+	if !providers.Has(p, providers.CapabilityCalendar) || integ.Timezone != "" {
+		return nil
 	}
-	uid, err := strconv.Atoi(sp[0])
-	if err != nil {
-		return 0, false
+	calendarID := integ.CalendarID
+	if calendarID == "" {
+		calendarID = "primary"
 	}
-	ts, err := strconv.ParseInt(sp[1], 10, 64)
+	entry, err := lookupCalendar(ctx, s.providers, s.repo, integ, calendarID)
 	if err != nil {
-		return 0, false
-	}
-	if time.Now().Sub(time.Unix(ts, 0)) > maxAge {
-		return 0, false
+		return err
 	}
-
-	return uid, true
+	return s.repo.UpdateCalendar(ctx, uid, p.Name(), calendarID, entry.TimeZone)
 }
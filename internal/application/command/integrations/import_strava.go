@@ -0,0 +1,9 @@
+package integrations
+
+type ImportStravaCommand struct {
+	UserID int
+}
+
+type ImportStravaResult struct {
+	Imported int
+}
@@ -0,0 +1,28 @@
+package integrations
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/infrastructure/strava"
+)
+
+type ImportStravaHandler interface {
+	ImportStrava(ctx context.Context, cmd ImportStravaCommand) (*ImportStravaResult, error)
+}
+
+type importStravaService struct {
+	importer strava.Importer
+}
+
+func NewImportStravaService(importer strava.Importer) ImportStravaHandler {
+	return &importStravaService{importer: importer}
+}
+
+func (s *importStravaService) ImportStrava(ctx context.Context, cmd ImportStravaCommand) (*ImportStravaResult, error) {
+	n, err := s.importer.Import(ctx, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportStravaResult{Imported: n}, nil
+}
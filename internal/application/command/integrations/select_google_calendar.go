@@ -0,0 +1,12 @@
+package integrations
+
+type SelectGoogleCalendarCommand struct {
+	UserID     int
+	CalendarID string
+}
+
+type SelectGoogleCalendarResult struct {
+	CalendarID string
+	Summary    string
+	Timezone   string
+}
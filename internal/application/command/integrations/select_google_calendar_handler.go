@@ -0,0 +1,51 @@
+package integrations
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/providers"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type SelectGoogleCalendarHandler interface {
+	SelectGoogleCalendar(ctx context.Context, cmd SelectGoogleCalendarCommand) (*SelectGoogleCalendarResult, error)
+}
+
+type selectGoogleCalendarService struct {
+	repo      repository.IntegrationsRepository
+	providers *providers.Registry
+}
+
+func NewSelectGoogleCalendarService(repo repository.IntegrationsRepository, registry *providers.Registry) SelectGoogleCalendarHandler {
+	return &selectGoogleCalendarService{repo: repo, providers: registry}
+}
+
+func (s *selectGoogleCalendarService) SelectGoogleCalendar(ctx context.Context, cmd SelectGoogleCalendarCommand) (*SelectGoogleCalendarResult, error) {
+	integ, err := s.repo.GetByUserAndProvider(ctx, cmd.UserID, model.ProviderGoogle)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := lookupCalendar(ctx, s.providers, s.repo, integ, cmd.CalendarID)
+	if err != nil {
+		return nil, err
+	}
+	// Sessions and workouts are written into the calendar, so read-only and
+	// free/busy calendars can't be selected.
+	if entry.AccessRole != "owner" && entry.AccessRole != "writer" {
+		return nil, &utilsErrors.Error{Message: "Calendar is read-only", Status: http.StatusBadRequest}
+	}
+
+	if err = s.repo.UpdateCalendar(ctx, cmd.UserID, model.ProviderGoogle, entry.Id, entry.TimeZone); err != nil {
+		return nil, err
+	}
+
+	return &SelectGoogleCalendarResult{
+		CalendarID: entry.Id,
+		Summary:    entry.Summary,
+		Timezone:   entry.TimeZone,
+	}, nil
+}
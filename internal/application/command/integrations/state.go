@@ -0,0 +1,58 @@
+package integrations
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// stateMaxAge bounds how long a user may take on a provider's consent screen.
+const stateMaxAge = 10 * time.Minute
+
+// signState binds an OAuth state parameter to the user starting the flow, so
+// the unauthenticated callback knows whom the tokens belong to.
+func signState(secret string, uid int) string {
+	payload := fmt.Sprintf("%d:%d", uid, time.Now().Unix())
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	sig := base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + sig
+}
+
+func verifyState(secret, state string, maxAge time.Duration) (int, bool) {
+	parts := strings.Split(state, ".")
+	if len(parts) != 2 {
+		return 0, false
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return 0, false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(raw)
+	if base64.RawURLEncoding.EncodeToString(mac.Sum(nil)) != parts[1] {
+		return 0, false
+	}
+	sp := strings.Split(string(raw), ":")
+	if len(sp) != 2 {
+		return 0, false
+	}
+	uid, err := strconv.Atoi(sp[0])
+	if err != nil {
+		return 0, false
+	}
+	ts, err := strconv.ParseInt(sp[1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	if time.Now().Sub(time.Unix(ts, 0)) > maxAge {
+		return 0, false
+	}
+
+	return uid, true
+}
@@ -0,0 +1,9 @@
+package nutrition
+
+import "github.com/msskobelina/fit-profi/internal/domain/model"
+
+type AddItemCommand struct {
+	EntryID int
+	UserID  int
+	Item    model.DiaryItem
+}
@@ -0,0 +1,29 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type AddItemHandler interface {
+	AddItem(context.Context, AddItemCommand) (*model.DiaryEntry, error)
+}
+
+type addItemService struct {
+	repo  repository.NutritionRepository
+	foods repository.FoodsRepository
+}
+
+func NewAddItemService(repo repository.NutritionRepository, foods repository.FoodsRepository) AddItemHandler {
+	return &addItemService{repo: repo, foods: foods}
+}
+
+func (s *addItemService) AddItem(ctx context.Context, cmd AddItemCommand) (*model.DiaryEntry, error) {
+	items, err := resolveItems(ctx, s.foods, []model.DiaryItem{cmd.Item})
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.AddItem(ctx, cmd.EntryID, cmd.UserID, items[0])
+}
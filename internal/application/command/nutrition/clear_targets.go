@@ -0,0 +1,6 @@
+package nutrition
+
+type ClearTargetsCommand struct {
+	CoachID  int
+	ClientID int
+}
@@ -0,0 +1,29 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type ClearTargetsHandler interface {
+	ClearTargets(context.Context, ClearTargetsCommand) error
+}
+
+type clearTargetsService struct {
+	repo     repository.NutritionRepository
+	coaching repository.CoachingRepository
+}
+
+func NewClearTargetsService(repo repository.NutritionRepository, coaching repository.CoachingRepository) ClearTargetsHandler {
+	return &clearTargetsService{repo: repo, coaching: coaching}
+}
+
+// ClearTargets removes a coach override so the client's targets are computed
+// from their profile again.
+func (s *clearTargetsService) ClearTargets(ctx context.Context, cmd ClearTargetsCommand) error {
+	if err := checkCoach(ctx, s.coaching, cmd.CoachID, cmd.ClientID); err != nil {
+		return err
+	}
+	return s.repo.DeleteTargetOverride(ctx, cmd.ClientID)
+}
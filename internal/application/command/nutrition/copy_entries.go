@@ -0,0 +1,13 @@
+package nutrition
+
+import "time"
+
+// CopyEntriesCommand copies the entries of FromDate to ToDate. MealType limits
+// the copy to one meal; ToMealType files the copies under another meal.
+type CopyEntriesCommand struct {
+	UserID     int
+	FromDate   time.Time
+	ToDate     time.Time
+	MealType   string
+	ToMealType string
+}
@@ -0,0 +1,66 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+type CopyEntriesHandler interface {
+	CopyEntries(context.Context, CopyEntriesCommand) ([]model.DiaryEntry, error)
+}
+
+type copyEntriesService struct {
+	repo repository.NutritionRepository
+}
+
+func NewCopyEntriesService(repo repository.NutritionRepository) CopyEntriesHandler {
+	return &copyEntriesService{repo: repo}
+}
+
+// CopyEntries clones a day or one meal of it, with all items, onto another
+// date. The copies are created in one transaction.
+func (s *copyEntriesService) CopyEntries(ctx context.Context, cmd CopyEntriesCommand) ([]model.DiaryEntry, error) {
+	entries, err := s.repo.ListEntriesByDate(ctx, cmd.UserID, cmd.FromDate)
+	if err != nil {
+		return nil, err
+	}
+
+	copies := make([]model.DiaryEntry, 0, len(entries))
+	for _, e := range entries {
+		if cmd.MealType != "" && e.MealType != cmd.MealType {
+			continue
+		}
+		mealType := e.MealType
+		if cmd.ToMealType != "" {
+			mealType = cmd.ToMealType
+		}
+		copies = append(copies, copyEntry(e, cmd.ToDate, mealType))
+	}
+	if len(copies) == 0 {
+		return nil, &utilsErrors.Error{Message: "No entries to copy", Status: http.StatusNotFound}
+	}
+
+	return s.repo.CreateEntries(ctx, copies)
+}
+
+func copyEntry(src model.DiaryEntry, date time.Time, mealType string) model.DiaryEntry {
+	items := make([]model.DiaryItem, len(src.Items))
+	for i, it := range src.Items {
+		it.ID = 0
+		it.EntryID = 0
+		it.Model = mysql.Model{}
+		items[i] = it
+	}
+	return model.DiaryEntry{
+		UserID:   src.UserID,
+		Date:     date,
+		MealType: mealType,
+		Items:    items,
+	}
+}
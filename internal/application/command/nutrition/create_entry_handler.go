@@ -12,18 +12,23 @@ type CreateEntryHandler interface {
 }
 
 type createEntryService struct {
-	repo repository.NutritionRepository
+	repo  repository.NutritionRepository
+	foods repository.FoodsRepository
 }
 
-func NewCreateEntryService(repo repository.NutritionRepository) CreateEntryHandler {
-	return &createEntryService{repo: repo}
+func NewCreateEntryService(repo repository.NutritionRepository, foods repository.FoodsRepository) CreateEntryHandler {
+	return &createEntryService{repo: repo, foods: foods}
 }
 
 func (s *createEntryService) CreateEntry(ctx context.Context, cmd CreateEntryCommand) (*model.DiaryEntry, error) {
+	items, err := resolveItems(ctx, s.foods, cmd.Items)
+	if err != nil {
+		return nil, err
+	}
 	return s.repo.CreateEntry(ctx, model.DiaryEntry{
 		UserID:   cmd.UserID,
 		Date:     cmd.Date,
 		MealType: cmd.MealType,
-		Items:    cmd.Items,
+		Items:    items,
 	})
 }
@@ -0,0 +1,11 @@
+package nutrition
+
+import "github.com/msskobelina/fit-profi/internal/domain/model"
+
+type CreateRecipeCommand struct {
+	UserID      int
+	Name        string
+	Servings    int
+	YieldGrams  float32
+	Ingredients []model.RecipeIngredient
+}
@@ -0,0 +1,36 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type CreateRecipeHandler interface {
+	CreateRecipe(context.Context, CreateRecipeCommand) (*model.Recipe, error)
+}
+
+type createRecipeService struct {
+	repo  repository.RecipesRepository
+	foods repository.FoodsRepository
+}
+
+func NewCreateRecipeService(repo repository.RecipesRepository, foods repository.FoodsRepository) CreateRecipeHandler {
+	return &createRecipeService{repo: repo, foods: foods}
+}
+
+func (s *createRecipeService) CreateRecipe(ctx context.Context, cmd CreateRecipeCommand) (*model.Recipe, error) {
+	ingredients, err := resolveIngredients(ctx, s.foods, cmd.Ingredients)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.CreateRecipe(ctx, model.Recipe{
+		UserID:      cmd.UserID,
+		AuthorID:    cmd.UserID,
+		Name:        cmd.Name,
+		Servings:    cmd.Servings,
+		YieldGrams:  cmd.YieldGrams,
+		Ingredients: ingredients,
+	})
+}
@@ -0,0 +1,6 @@
+package nutrition
+
+type DeleteHydrationCommand struct {
+	EntryID int
+	UserID  int
+}
@@ -0,0 +1,23 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type DeleteHydrationHandler interface {
+	DeleteHydration(context.Context, DeleteHydrationCommand) error
+}
+
+type deleteHydrationService struct {
+	repo repository.NutritionRepository
+}
+
+func NewDeleteHydrationService(repo repository.NutritionRepository) DeleteHydrationHandler {
+	return &deleteHydrationService{repo: repo}
+}
+
+func (s *deleteHydrationService) DeleteHydration(ctx context.Context, cmd DeleteHydrationCommand) error {
+	return s.repo.DeleteHydrationEntry(ctx, cmd.EntryID, cmd.UserID)
+}
@@ -0,0 +1,7 @@
+package nutrition
+
+type DeleteItemCommand struct {
+	EntryID int
+	ItemID  int
+	UserID  int
+}
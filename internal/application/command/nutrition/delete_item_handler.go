@@ -0,0 +1,24 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type DeleteItemHandler interface {
+	DeleteItem(context.Context, DeleteItemCommand) (*model.DiaryEntry, error)
+}
+
+type deleteItemService struct {
+	repo repository.NutritionRepository
+}
+
+func NewDeleteItemService(repo repository.NutritionRepository) DeleteItemHandler {
+	return &deleteItemService{repo: repo}
+}
+
+func (s *deleteItemService) DeleteItem(ctx context.Context, cmd DeleteItemCommand) (*model.DiaryEntry, error) {
+	return s.repo.DeleteItem(ctx, cmd.EntryID, cmd.ItemID, cmd.UserID)
+}
@@ -0,0 +1,6 @@
+package nutrition
+
+type DeleteRecipeCommand struct {
+	RecipeID int
+	UserID   int
+}
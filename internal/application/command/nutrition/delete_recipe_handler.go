@@ -0,0 +1,23 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type DeleteRecipeHandler interface {
+	DeleteRecipe(context.Context, DeleteRecipeCommand) error
+}
+
+type deleteRecipeService struct {
+	repo repository.RecipesRepository
+}
+
+func NewDeleteRecipeService(repo repository.RecipesRepository) DeleteRecipeHandler {
+	return &deleteRecipeService{repo: repo}
+}
+
+func (s *deleteRecipeService) DeleteRecipe(ctx context.Context, cmd DeleteRecipeCommand) error {
+	return s.repo.DeleteRecipe(ctx, cmd.RecipeID, cmd.UserID)
+}
@@ -0,0 +1,71 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+// resolveItems computes the name and macros of items that reference the food
+// catalog, overwriting whatever the client sent for them. The catalog has no
+// micronutrients, so those are kept as entered. Items without a FoodID are
+// kept as entered.
+func resolveItems(ctx context.Context, foods repository.FoodsRepository, items []model.DiaryItem) ([]model.DiaryItem, error) {
+	var ids []int
+	for _, it := range items {
+		if it.FoodID == nil {
+			continue
+		}
+		if it.Grams <= 0 {
+			return nil, &utilsErrors.Error{Message: "Grams must be positive for catalog foods"}
+		}
+		ids = append(ids, *it.FoodID)
+	}
+	if len(ids) == 0 {
+		return items, nil
+	}
+
+	found, err := foods.GetFoodsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]model.Food, len(found))
+	for _, f := range found {
+		byID[f.ID] = f
+	}
+
+	res := make([]model.DiaryItem, len(items))
+	for i, it := range items {
+		if it.FoodID == nil {
+			res[i] = it
+			continue
+		}
+		f, ok := byID[*it.FoodID]
+		if !ok {
+			return nil, &utilsErrors.Error{Message: "Food not found", Status: http.StatusNotFound}
+		}
+		res[i] = f.Portion(it.Grams)
+		res[i].Micronutrients = it.Micronutrients
+	}
+	return res, nil
+}
+
+// resolveIngredients applies resolveItems to recipe ingredients.
+func resolveIngredients(ctx context.Context, foods repository.FoodsRepository, ingredients []model.RecipeIngredient) ([]model.RecipeIngredient, error) {
+	items := make([]model.DiaryItem, len(ingredients))
+	for i, in := range ingredients {
+		items[i] = in.Item()
+	}
+	items, err := resolveItems(ctx, foods, items)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]model.RecipeIngredient, len(items))
+	for i, it := range items {
+		res[i] = model.IngredientFromItem(it)
+	}
+	return res, nil
+}
@@ -0,0 +1,11 @@
+package nutrition
+
+import "time"
+
+type LogHydrationCommand struct {
+	UserID     int
+	Date       time.Time
+	Beverage   string
+	AmountMl   float32
+	CaffeineMg float32
+}
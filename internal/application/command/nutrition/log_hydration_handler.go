@@ -0,0 +1,30 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type LogHydrationHandler interface {
+	LogHydration(context.Context, LogHydrationCommand) (*model.HydrationEntry, error)
+}
+
+type logHydrationService struct {
+	repo repository.NutritionRepository
+}
+
+func NewLogHydrationService(repo repository.NutritionRepository) LogHydrationHandler {
+	return &logHydrationService{repo: repo}
+}
+
+func (s *logHydrationService) LogHydration(ctx context.Context, cmd LogHydrationCommand) (*model.HydrationEntry, error) {
+	return s.repo.CreateHydrationEntry(ctx, model.HydrationEntry{
+		UserID:     cmd.UserID,
+		Date:       cmd.Date,
+		Beverage:   cmd.Beverage,
+		AmountMl:   cmd.AmountMl,
+		CaffeineMg: cmd.CaffeineMg,
+	})
+}
@@ -0,0 +1,11 @@
+package nutrition
+
+import "time"
+
+type LogRecipeCommand struct {
+	RecipeID int
+	UserID   int
+	Date     time.Time
+	MealType string
+	Servings float32
+}
@@ -0,0 +1,36 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type LogRecipeHandler interface {
+	LogRecipe(context.Context, LogRecipeCommand) (*model.DiaryEntry, error)
+}
+
+type logRecipeService struct {
+	repo    repository.NutritionRepository
+	recipes repository.RecipesRepository
+}
+
+func NewLogRecipeService(repo repository.NutritionRepository, recipes repository.RecipesRepository) LogRecipeHandler {
+	return &logRecipeService{repo: repo, recipes: recipes}
+}
+
+// LogRecipe adds a diary entry with one item holding the given number of
+// servings of the recipe.
+func (s *logRecipeService) LogRecipe(ctx context.Context, cmd LogRecipeCommand) (*model.DiaryEntry, error) {
+	rec, err := s.recipes.GetRecipeByID(ctx, cmd.RecipeID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.CreateEntry(ctx, model.DiaryEntry{
+		UserID:   cmd.UserID,
+		Date:     cmd.Date,
+		MealType: cmd.MealType,
+		Items:    []model.DiaryItem{rec.Portion(cmd.Servings)},
+	})
+}
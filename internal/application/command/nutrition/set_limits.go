@@ -0,0 +1,14 @@
+package nutrition
+
+// SetLimitsCommand replaces all daily limits of OwnerID, or of UserID when
+// OwnerID is zero. Nil limits are not checked.
+type SetLimitsCommand struct {
+	UserID         int
+	OwnerID        int
+	SugarMaxG      *float64
+	SodiumMaxMg    *float64
+	CaffeineMaxMg  *float64
+	FibreMinG      *float64
+	PotassiumMinMg *float64
+	WaterMinMl     *float64
+}
@@ -0,0 +1,43 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type SetLimitsHandler interface {
+	SetLimits(context.Context, SetLimitsCommand) (*model.NutritionLimits, error)
+}
+
+type setLimitsService struct {
+	repo     repository.NutritionRepository
+	coaching repository.CoachingRepository
+}
+
+func NewSetLimitsService(repo repository.NutritionRepository, coaching repository.CoachingRepository) SetLimitsHandler {
+	return &setLimitsService{repo: repo, coaching: coaching}
+}
+
+// SetLimits lets users set their own limits and coaches set them for their
+// active clients.
+func (s *setLimitsService) SetLimits(ctx context.Context, cmd SetLimitsCommand) (*model.NutritionLimits, error) {
+	l := model.NutritionLimits{
+		UserID:         cmd.UserID,
+		SugarMaxG:      cmd.SugarMaxG,
+		SodiumMaxMg:    cmd.SodiumMaxMg,
+		CaffeineMaxMg:  cmd.CaffeineMaxMg,
+		FibreMinG:      cmd.FibreMinG,
+		PotassiumMinMg: cmd.PotassiumMinMg,
+		WaterMinMl:     cmd.WaterMinMl,
+	}
+	if cmd.OwnerID != 0 && cmd.OwnerID != cmd.UserID {
+		if err := checkCoach(ctx, s.coaching, cmd.UserID, cmd.OwnerID); err != nil {
+			return nil, err
+		}
+		l.UserID = cmd.OwnerID
+		l.SetBy = cmd.UserID
+	}
+	return s.repo.UpsertLimits(ctx, l)
+}
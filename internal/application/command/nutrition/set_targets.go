@@ -0,0 +1,10 @@
+package nutrition
+
+type SetTargetsCommand struct {
+	CoachID  int
+	ClientID int
+	Calories float64
+	ProteinG float64
+	FatG     float64
+	CarbsG   float64
+}
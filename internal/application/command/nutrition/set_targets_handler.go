@@ -0,0 +1,54 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type SetTargetsHandler interface {
+	SetTargets(context.Context, SetTargetsCommand) (*model.NutritionTargets, error)
+}
+
+type setTargetsService struct {
+	repo     repository.NutritionRepository
+	coaching repository.CoachingRepository
+}
+
+func NewSetTargetsService(repo repository.NutritionRepository, coaching repository.CoachingRepository) SetTargetsHandler {
+	return &setTargetsService{repo: repo, coaching: coaching}
+}
+
+// SetTargets lets a coach replace a client's computed targets with their own.
+func (s *setTargetsService) SetTargets(ctx context.Context, cmd SetTargetsCommand) (*model.NutritionTargets, error) {
+	if err := checkCoach(ctx, s.coaching, cmd.CoachID, cmd.ClientID); err != nil {
+		return nil, err
+	}
+	o, err := s.repo.UpsertTargetOverride(ctx, model.NutritionTargetOverride{
+		UserID:   cmd.ClientID,
+		CoachID:  cmd.CoachID,
+		Calories: cmd.Calories,
+		ProteinG: cmd.ProteinG,
+		FatG:     cmd.FatG,
+		CarbsG:   cmd.CarbsG,
+	})
+	if err != nil {
+		return nil, err
+	}
+	t := o.Targets()
+	return &t, nil
+}
+
+func checkCoach(ctx context.Context, coaching repository.CoachingRepository, coachID, clientID int) error {
+	ok, err := coaching.IsActiveCoach(ctx, coachID, clientID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &utilsErrors.Error{Message: "You are not this athlete's coach", Status: http.StatusForbidden}
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package nutrition_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type fakeNutritionRepo struct {
+	repository.NutritionRepository
+	overrides map[int]model.NutritionTargetOverride
+	items     map[int]*model.DiaryItem
+}
+
+func (f *fakeNutritionRepo) UpdateItem(_ context.Context, entryID, itemID, _ int, patch func(*model.DiaryItem) error) (*model.DiaryEntry, error) {
+	stored, ok := f.items[itemID]
+	if !ok || stored.EntryID != entryID {
+		return nil, &utilsErrors.Error{Message: "Item not found", Status: http.StatusNotFound}
+	}
+	it := *stored
+	if err := patch(&it); err != nil {
+		return nil, err
+	}
+	it.ID, it.EntryID = stored.ID, stored.EntryID
+	*stored = it
+	return &model.DiaryEntry{ID: entryID, Items: []model.DiaryItem{it}}, nil
+}
+
+func (f *fakeNutritionRepo) UpsertTargetOverride(_ context.Context, o model.NutritionTargetOverride) (*model.NutritionTargetOverride, error) {
+	f.overrides[o.UserID] = o
+	return &o, nil
+}
+
+func (f *fakeNutritionRepo) DeleteTargetOverride(_ context.Context, userID int) error {
+	delete(f.overrides, userID)
+	return nil
+}
+
+type fakeCoachingRepo struct {
+	repository.CoachingRepository
+	active map[[2]int]bool
+}
+
+func (f *fakeCoachingRepo) IsActiveCoach(_ context.Context, coachID, clientID int) (bool, error) {
+	return f.active[[2]int{coachID, clientID}], nil
+}
+
+const (
+	athlete = 20
+	coach   = 10
+	exCoach = 11
+)
+
+func TestSetTargets(t *testing.T) {
+	tests := []struct {
+		name       string
+		coachID    int
+		wantStatus int
+	}{
+		{name: "active coach", coachID: coach},
+		{name: "former coach", coachID: exCoach, wantStatus: http.StatusForbidden},
+		{name: "the athlete", coachID: athlete, wantStatus: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeNutritionRepo{overrides: map[int]model.NutritionTargetOverride{}}
+			svc := cmdNutrition.NewSetTargetsService(repo, &fakeCoachingRepo{active: map[[2]int]bool{{coach, athlete}: true}})
+
+			got, err := svc.SetTargets(context.Background(), cmdNutrition.SetTargetsCommand{
+				CoachID: tt.coachID, ClientID: athlete, Calories: 2400, ProteinG: 160, FatG: 70, CarbsG: 280,
+			})
+			if tt.wantStatus == 0 {
+				if err != nil || got.Source != model.TargetsCoach || got.SetBy != tt.coachID || repo.overrides[athlete].Calories != 2400 {
+					t.Fatalf("SetTargets = %+v, %v; want the override saved", got, err)
+				}
+				return
+			}
+			var ue *utilsErrors.Error
+			if !errors.As(err, &ue) || ue.Status != tt.wantStatus {
+				t.Fatalf("err = %v, want status %d", err, tt.wantStatus)
+			}
+			if len(repo.overrides) != 0 {
+				t.Errorf("overrides = %+v, want nothing saved", repo.overrides)
+			}
+		})
+	}
+}
+
+func TestClearTargets(t *testing.T) {
+	tests := []struct {
+		name       string
+		coachID    int
+		wantStatus int
+	}{
+		{name: "active coach", coachID: coach},
+		{name: "former coach", coachID: exCoach, wantStatus: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeNutritionRepo{overrides: map[int]model.NutritionTargetOverride{
+				athlete: {UserID: athlete, CoachID: exCoach, Calories: 1800},
+			}}
+			svc := cmdNutrition.NewClearTargetsService(repo, &fakeCoachingRepo{active: map[[2]int]bool{{coach, athlete}: true}})
+
+			err := svc.ClearTargets(context.Background(), cmdNutrition.ClearTargetsCommand{CoachID: tt.coachID, ClientID: athlete})
+			if tt.wantStatus == 0 {
+				if err != nil || len(repo.overrides) != 0 {
+					t.Fatalf("ClearTargets = %v, overrides = %+v; want the override removed", err, repo.overrides)
+				}
+				return
+			}
+			var ue *utilsErrors.Error
+			if !errors.As(err, &ue) || ue.Status != tt.wantStatus {
+				t.Fatalf("err = %v, want status %d", err, tt.wantStatus)
+			}
+			if len(repo.overrides) != 1 {
+				t.Errorf("overrides = %+v, want the override kept", repo.overrides)
+			}
+		})
+	}
+}
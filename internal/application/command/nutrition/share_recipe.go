@@ -0,0 +1,7 @@
+package nutrition
+
+type ShareRecipeCommand struct {
+	RecipeID  int
+	CoachID   int
+	ClientIDs []int
+}
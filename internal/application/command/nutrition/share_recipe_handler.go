@@ -0,0 +1,71 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type ShareRecipeHandler interface {
+	ShareRecipe(context.Context, ShareRecipeCommand) ([]model.Recipe, error)
+}
+
+type shareRecipeService struct {
+	repo     repository.RecipesRepository
+	coaching repository.CoachingRepository
+}
+
+func NewShareRecipeService(repo repository.RecipesRepository, coaching repository.CoachingRepository) ShareRecipeHandler {
+	return &shareRecipeService{repo: repo, coaching: coaching}
+}
+
+// ShareRecipe copies one of the coach's recipes to each client, so clients
+// can adjust their copy without touching the original. All clients are
+// checked before anything is written.
+func (s *shareRecipeService) ShareRecipe(ctx context.Context, cmd ShareRecipeCommand) ([]model.Recipe, error) {
+	rec, err := s.repo.GetRecipeByID(ctx, cmd.RecipeID, cmd.CoachID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int]bool, len(cmd.ClientIDs))
+	copies := make([]model.Recipe, 0, len(cmd.ClientIDs))
+	for _, clientID := range cmd.ClientIDs {
+		if seen[clientID] {
+			continue
+		}
+		seen[clientID] = true
+
+		if err := checkCoach(ctx, s.coaching, cmd.CoachID, clientID); err != nil {
+			return nil, err
+		}
+		copies = append(copies, copyRecipe(*rec, clientID))
+	}
+
+	return s.repo.CreateRecipes(ctx, copies)
+}
+
+func copyRecipe(src model.Recipe, userID int) model.Recipe {
+	from := src.ID
+	if src.SharedFromID != nil {
+		from = *src.SharedFromID
+	}
+
+	ingredients := make([]model.RecipeIngredient, len(src.Ingredients))
+	for i, in := range src.Ingredients {
+		in.ID = 0
+		in.RecipeID = 0
+		ingredients[i] = in
+	}
+
+	return model.Recipe{
+		UserID:       userID,
+		AuthorID:     src.AuthorID,
+		SharedFromID: &from,
+		Name:         src.Name,
+		Servings:     src.Servings,
+		YieldGrams:   src.YieldGrams,
+		Ingredients:  ingredients,
+	}
+}
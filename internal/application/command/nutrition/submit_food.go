@@ -0,0 +1,13 @@
+package nutrition
+
+type SubmitFoodCommand struct {
+	UserID          int
+	Barcode         string
+	Name            string
+	Brand           string
+	ServingGrams    float64
+	CaloriesPer100g float64
+	ProteinPer100g  float64
+	FatPer100g      float64
+	CarbsPer100g    float64
+}
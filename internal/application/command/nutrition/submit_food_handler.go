@@ -0,0 +1,57 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/pkg/barcode"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type SubmitFoodHandler interface {
+	SubmitFood(context.Context, SubmitFoodCommand) (*model.FoodSubmission, error)
+}
+
+type submitFoodService struct {
+	foods repository.FoodsRepository
+}
+
+func NewSubmitFoodService(foods repository.FoodsRepository) SubmitFoodHandler {
+	return &submitFoodService{foods: foods}
+}
+
+// SubmitFood queues a packaged food missing from the catalog for admin
+// review.
+func (s *submitFoodService) SubmitFood(ctx context.Context, cmd SubmitFoodCommand) (*model.FoodSubmission, error) {
+	code, err := barcode.Normalize(cmd.Barcode)
+	if err != nil {
+		return nil, &utilsErrors.Error{Message: "Invalid barcode"}
+	}
+
+	if _, err := s.foods.GetFoodByBarcode(ctx, code); err == nil {
+		return nil, &utilsErrors.Error{Message: "Food with this barcode already exists", Status: http.StatusConflict}
+	} else if !utilsErrors.IsNotFound(err) {
+		return nil, err
+	}
+	pending, err := s.foods.HasPendingSubmission(ctx, cmd.UserID, code)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, &utilsErrors.Error{Message: "You have already submitted this barcode", Status: http.StatusConflict}
+	}
+
+	return s.foods.CreateFoodSubmission(ctx, model.FoodSubmission{
+		UserID:          cmd.UserID,
+		Barcode:         code,
+		Name:            cmd.Name,
+		Brand:           cmd.Brand,
+		ServingGrams:    cmd.ServingGrams,
+		CaloriesPer100g: cmd.CaloriesPer100g,
+		ProteinPer100g:  cmd.ProteinPer100g,
+		FatPer100g:      cmd.FatPer100g,
+		CarbsPer100g:    cmd.CarbsPer100g,
+	})
+}
@@ -12,16 +12,21 @@ type UpdateEntryHandler interface {
 }
 
 type updateEntryService struct {
-	repo repository.NutritionRepository
+	repo  repository.NutritionRepository
+	foods repository.FoodsRepository
 }
 
-func NewUpdateEntryService(repo repository.NutritionRepository) UpdateEntryHandler {
-	return &updateEntryService{repo: repo}
+func NewUpdateEntryService(repo repository.NutritionRepository, foods repository.FoodsRepository) UpdateEntryHandler {
+	return &updateEntryService{repo: repo, foods: foods}
 }
 
 func (s *updateEntryService) UpdateEntry(ctx context.Context, cmd UpdateEntryCommand) (*model.DiaryEntry, error) {
+	items, err := resolveItems(ctx, s.foods, cmd.Items)
+	if err != nil {
+		return nil, err
+	}
 	return s.repo.UpdateEntry(ctx, cmd.EntryID, cmd.UserID, model.DiaryEntry{
 		MealType: cmd.MealType,
-		Items:    cmd.Items,
+		Items:    items,
 	})
 }
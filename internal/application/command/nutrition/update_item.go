@@ -0,0 +1,19 @@
+package nutrition
+
+import "github.com/msskobelina/fit-profi/internal/domain/model"
+
+// UpdateItemCommand changes the fields that are set and keeps the rest,
+// micronutrients included.
+type UpdateItemCommand struct {
+	EntryID  int
+	ItemID   int
+	UserID   int
+	FoodID   *int
+	Name     *string
+	Grams    *float32
+	Calories *float32
+	ProteinG *float32
+	FatG     *float32
+	CarbsG   *float32
+	model.Micronutrients
+}
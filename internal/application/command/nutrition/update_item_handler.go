@@ -0,0 +1,59 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type UpdateItemHandler interface {
+	UpdateItem(context.Context, UpdateItemCommand) (*model.DiaryEntry, error)
+}
+
+type updateItemService struct {
+	repo  repository.NutritionRepository
+	foods repository.FoodsRepository
+}
+
+func NewUpdateItemService(repo repository.NutritionRepository, foods repository.FoodsRepository) UpdateItemHandler {
+	return &updateItemService{repo: repo, foods: foods}
+}
+
+// UpdateItem patches one item. Catalog items are recomputed from the food
+// and the new weight, so only foodId, grams and micronutrients matter for
+// them. The patch is applied inside the repository transaction, on the item as
+// stored once the entry is locked.
+func (s *updateItemService) UpdateItem(ctx context.Context, cmd UpdateItemCommand) (*model.DiaryEntry, error) {
+	return s.repo.UpdateItem(ctx, cmd.EntryID, cmd.ItemID, cmd.UserID, func(it *model.DiaryItem) error {
+		set := func(dst *float32, v *float32) {
+			if v != nil {
+				*dst = *v
+			}
+		}
+		if cmd.FoodID != nil {
+			it.FoodID = cmd.FoodID
+		}
+		if cmd.Name != nil {
+			it.Name = *cmd.Name
+		}
+		set(&it.Grams, cmd.Grams)
+		set(&it.Calories, cmd.Calories)
+		set(&it.ProteinG, cmd.ProteinG)
+		set(&it.FatG, cmd.FatG)
+		set(&it.CarbsG, cmd.CarbsG)
+		patch := cmd.Micronutrients
+		for k, v := range patch.Fields() {
+			if *v != nil {
+				*it.Micronutrients.Fields()[k] = *v
+			}
+		}
+
+		items, err := resolveItems(ctx, s.foods, []model.DiaryItem{*it})
+		if err != nil {
+			return err
+		}
+		*it = items[0]
+		return nil
+	})
+}
@@ -0,0 +1,32 @@
+package nutrition_test
+
+import (
+	"context"
+	"testing"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+func TestUpdateItem_PatchesStoredItem(t *testing.T) {
+	fibre := float32(2.4)
+	repo := &fakeNutritionRepo{items: map[int]*model.DiaryItem{
+		3: {ID: 3, EntryID: 1, Name: "Apple", Grams: 100, Calories: 52, CarbsG: 14, Micronutrients: model.Micronutrients{FibreG: &fibre}},
+	}}
+	svc := cmdNutrition.NewUpdateItemService(repo, nil)
+
+	calories, name := float32(60), "Green apple"
+	for _, cmd := range []cmdNutrition.UpdateItemCommand{
+		{EntryID: 1, ItemID: 3, UserID: 20, Calories: &calories},
+		{EntryID: 1, ItemID: 3, UserID: 20, Name: &name},
+	} {
+		if _, err := svc.UpdateItem(context.Background(), cmd); err != nil {
+			t.Fatalf("UpdateItem: %v", err)
+		}
+	}
+
+	got := repo.items[3]
+	if got.Name != name || got.Calories != calories || got.Grams != 100 || got.CarbsG != 14 || got.FibreG == nil || *got.FibreG != fibre {
+		t.Errorf("item = %+v, want both edits applied and the other fields kept", got)
+	}
+}
@@ -0,0 +1,12 @@
+package nutrition
+
+import "github.com/msskobelina/fit-profi/internal/domain/model"
+
+type UpdateRecipeCommand struct {
+	RecipeID    int
+	UserID      int
+	Name        string
+	Servings    int
+	YieldGrams  float32
+	Ingredients []model.RecipeIngredient
+}
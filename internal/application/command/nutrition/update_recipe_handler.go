@@ -0,0 +1,34 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type UpdateRecipeHandler interface {
+	UpdateRecipe(context.Context, UpdateRecipeCommand) (*model.Recipe, error)
+}
+
+type updateRecipeService struct {
+	repo  repository.RecipesRepository
+	foods repository.FoodsRepository
+}
+
+func NewUpdateRecipeService(repo repository.RecipesRepository, foods repository.FoodsRepository) UpdateRecipeHandler {
+	return &updateRecipeService{repo: repo, foods: foods}
+}
+
+func (s *updateRecipeService) UpdateRecipe(ctx context.Context, cmd UpdateRecipeCommand) (*model.Recipe, error) {
+	ingredients, err := resolveIngredients(ctx, s.foods, cmd.Ingredients)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.UpdateRecipe(ctx, cmd.RecipeID, cmd.UserID, model.Recipe{
+		Name:        cmd.Name,
+		Servings:    cmd.Servings,
+		YieldGrams:  cmd.YieldGrams,
+		Ingredients: ingredients,
+	})
+}
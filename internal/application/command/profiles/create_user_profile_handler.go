@@ -25,12 +25,16 @@ func (s *createUserProfileService) CreateUserProfile(
 ) (*model.UserProfile, error) {
 
 	profile := model.UserProfile{
-		UserID:      cmd.UserID,
-		FullName:    cmd.FullName,
-		Age:         cmd.Age,
-		WeightKg:    cmd.WeightKg,
-		Goal:        model.Goal(cmd.Goal),
-		Description: cmd.Description,
+		UserID:        cmd.UserID,
+		FullName:      cmd.FullName,
+		Age:           cmd.Age,
+		WeightKg:      cmd.WeightKg,
+		Goal:          model.Goal(cmd.Goal),
+		Description:   cmd.Description,
+		Timezone:      cmd.Timezone,
+		HeightCm:      cmd.HeightCm,
+		Sex:           model.Sex(cmd.Sex),
+		ActivityLevel: model.ActivityLevel(cmd.ActivityLevel),
 	}
 
 	return s.repo.CreateUserProfile(ctx, profile)
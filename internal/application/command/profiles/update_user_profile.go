@@ -1,10 +1,14 @@
 package profiles
 
 type UpdateUserProfileCommand struct {
-	UserID      int
-	FullName    string
-	Age         int
-	WeightKg    float32
-	Goal        string
-	Description string
+	UserID        int
+	FullName      string
+	Age           int
+	WeightKg      float32
+	Goal          string
+	Description   string
+	Timezone      string
+	HeightCm      float32
+	Sex           string
+	ActivityLevel string
 }
@@ -21,10 +21,14 @@ func NewUpdateUserProfileService(repo repository.ProfilesRepository) UpdateUserP
 
 func (s *updateUserProfileService) UpdateUserProfile(ctx context.Context, cmd UpdateUserProfileCommand) (*model.UserProfile, error) {
 	return s.repo.UpdateUserProfile(ctx, cmd.UserID, model.UserProfile{
-		FullName:    cmd.FullName,
-		Age:         cmd.Age,
-		WeightKg:    cmd.WeightKg,
-		Goal:        model.Goal(cmd.Goal),
-		Description: cmd.Description,
+		FullName:      cmd.FullName,
+		Age:           cmd.Age,
+		WeightKg:      cmd.WeightKg,
+		Goal:          model.Goal(cmd.Goal),
+		Description:   cmd.Description,
+		Timezone:      cmd.Timezone,
+		HeightCm:      cmd.HeightCm,
+		Sex:           model.Sex(cmd.Sex),
+		ActivityLevel: model.ActivityLevel(cmd.ActivityLevel),
 	})
 }
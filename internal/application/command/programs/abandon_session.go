@@ -0,0 +1,7 @@
+package programs
+
+type AbandonSessionCommand struct {
+	SessionID int
+	UserID    int
+	Notes     string
+}
@@ -0,0 +1,38 @@
+package programs
+
+import (
+	"context"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type AbandonSessionHandler interface {
+	AbandonSession(context.Context, AbandonSessionCommand) (*model.WorkoutSession, error)
+}
+
+type abandonSessionService struct {
+	repo repository.ProgramsRepository
+}
+
+func NewAbandonSessionService(repo repository.ProgramsRepository) AbandonSessionHandler {
+	return &abandonSessionService{repo: repo}
+}
+
+// AbandonSession closes the session without saving any sets, so it does not
+// count towards progress.
+func (s *abandonSessionService) AbandonSession(ctx context.Context, cmd AbandonSessionCommand) (*model.WorkoutSession, error) {
+	sess, err := ownSession(ctx, s.repo, cmd.SessionID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	return s.repo.CloseSession(ctx, model.WorkoutSession{
+		ID:      sess.ID,
+		UserID:  sess.UserID,
+		Status:  model.SessionAbandoned,
+		EndedAt: &now,
+		Notes:   cmd.Notes,
+	})
+}
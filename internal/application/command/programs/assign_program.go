@@ -0,0 +1,7 @@
+package programs
+
+type AssignProgramCommand struct {
+	ProgramID int
+	CoachID   int
+	ClientIDs []int
+}
@@ -0,0 +1,96 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type AssignProgramHandler interface {
+	AssignProgram(context.Context, AssignProgramCommand) ([]model.TrainingProgram, error)
+}
+
+type assignProgramService struct {
+	repo     repository.ProgramsRepository
+	coaching repository.CoachingRepository
+}
+
+func NewAssignProgramService(repo repository.ProgramsRepository, coaching repository.CoachingRepository) AssignProgramHandler {
+	return &assignProgramService{repo: repo, coaching: coaching}
+}
+
+// AssignProgram copies a coach's program to each client so that every athlete
+// logs progress against their own copy. The program must be the coach's own or
+// one they wrote for an athlete they still actively coach. All clients are
+// checked before anything is written; the copies are created in one
+// transaction.
+func (s *assignProgramService) AssignProgram(ctx context.Context, cmd AssignProgramCommand) ([]model.TrainingProgram, error) {
+	p, err := s.repo.GetProgramByID(ctx, cmd.ProgramID)
+	if err != nil {
+		return nil, err
+	}
+	if p.UserID != cmd.CoachID {
+		ok := false
+		if p.AuthorID == cmd.CoachID {
+			if ok, err = s.coaching.IsActiveCoach(ctx, cmd.CoachID, p.UserID); err != nil {
+				return nil, err
+			}
+		}
+		if !ok {
+			return nil, &utilsErrors.Error{Message: "Program not found", Status: http.StatusNotFound}
+		}
+	}
+
+	seen := make(map[int]bool, len(cmd.ClientIDs))
+	copies := make([]model.TrainingProgram, 0, len(cmd.ClientIDs))
+	for _, clientID := range cmd.ClientIDs {
+		if seen[clientID] {
+			continue
+		}
+		seen[clientID] = true
+
+		ok, err := s.coaching.IsActiveCoach(ctx, cmd.CoachID, clientID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &utilsErrors.Error{Message: "You are not this athlete's coach", Status: http.StatusForbidden}
+		}
+		copies = append(copies, copyProgram(*p, clientID, cmd.CoachID))
+	}
+
+	return s.repo.CreatePrograms(ctx, copies)
+}
+
+func copyProgram(src model.TrainingProgram, userID, authorID int) model.TrainingProgram {
+	from := src.ID
+	if src.AssignedFromID != nil {
+		from = *src.AssignedFromID
+	}
+
+	days := make([]model.ProgramDay, len(src.Days))
+	for i, d := range src.Days {
+		exercises := make([]model.ProgramExercise, len(d.Exercises))
+		for j, e := range d.Exercises {
+			e.ID = 0
+			e.DayID = 0
+			exercises[j] = e
+		}
+		d.ID = 0
+		d.ProgramID = 0
+		d.Exercises = exercises
+		days[i] = d
+	}
+
+	return model.TrainingProgram{
+		UserID:         userID,
+		AuthorID:       authorID,
+		AssignedFromID: &from,
+		Title:          src.Title,
+		Description:    src.Description,
+		Days:           days,
+	}
+}
@@ -0,0 +1,70 @@
+package programs_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	cmdPrograms "github.com/msskobelina/fit-profi/internal/application/command/programs"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+func TestAssignProgram_Access(t *testing.T) {
+	const (
+		athlete  = 20
+		client   = 30
+		coach    = 10
+		exCoach  = 11
+		own      = 1
+		assigned = 2
+		former   = 3
+	)
+	tests := []struct {
+		name       string
+		coachID    int
+		programID  int
+		wantStatus int
+	}{
+		{name: "own program", coachID: coach, programID: own},
+		{name: "written for an active client", coachID: coach, programID: assigned},
+		{name: "written for a former client", coachID: exCoach, programID: former, wantStatus: http.StatusNotFound},
+		{name: "someone else's program", coachID: exCoach, programID: own, wantStatus: http.StatusNotFound},
+		{name: "unknown program", coachID: coach, programID: 99, wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeProgramsRepo{byID: map[int]*model.TrainingProgram{
+				own:      {ID: own, UserID: coach, AuthorID: coach},
+				assigned: {ID: assigned, UserID: athlete, AuthorID: coach},
+				former:   {ID: former, UserID: athlete, AuthorID: exCoach},
+			}}
+			coaching := &fakeCoachingRepo{active: map[[2]int]bool{
+				{coach, athlete}:  true,
+				{coach, client}:   true,
+				{exCoach, client}: true,
+			}}
+			svc := cmdPrograms.NewAssignProgramService(repo, coaching)
+
+			ps, err := svc.AssignProgram(context.Background(), cmdPrograms.AssignProgramCommand{
+				ProgramID: tt.programID,
+				CoachID:   tt.coachID,
+				ClientIDs: []int{client},
+			})
+			if tt.wantStatus == 0 {
+				if err != nil || len(ps) != 1 || ps[0].UserID != client || ps[0].AuthorID != tt.coachID {
+					t.Fatalf("AssignProgram = %+v, %v; want a copy for the client", ps, err)
+				}
+				return
+			}
+			var ue *utilsErrors.Error
+			if !errors.As(err, &ue) || ue.Status != tt.wantStatus {
+				t.Fatalf("err = %v, want status %d", err, tt.wantStatus)
+			}
+			if len(repo.created) != 0 {
+				t.Errorf("created = %+v, want nothing copied", repo.created)
+			}
+		})
+	}
+}
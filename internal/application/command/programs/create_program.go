@@ -4,6 +4,7 @@ import "github.com/msskobelina/fit-profi/internal/domain/model"
 
 type CreateProgramCommand struct {
 	UserID      int
+	AssigneeID  int
 	Title       string
 	Description string
 	Days        []model.ProgramDay
@@ -2,9 +2,13 @@ package programs
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/msskobelina/fit-profi/internal/domain/model"
 	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
 )
 
 type CreateProgramHandler interface {
@@ -12,18 +16,120 @@ type CreateProgramHandler interface {
 }
 
 type createProgramService struct {
-	repo repository.ProgramsRepository
+	repo      repository.ProgramsRepository
+	coaching  repository.CoachingRepository
+	exercises repository.ExercisesRepository
 }
 
-func NewCreateProgramService(repo repository.ProgramsRepository) CreateProgramHandler {
-	return &createProgramService{repo: repo}
+func NewCreateProgramService(repo repository.ProgramsRepository, coaching repository.CoachingRepository, exercises repository.ExercisesRepository) CreateProgramHandler {
+	return &createProgramService{repo: repo, coaching: coaching, exercises: exercises}
 }
 
+// CreateProgram stores a program authored by the caller. When AssigneeID names
+// someone else the caller must be their active coach. Exercises referring to
+// the catalog must name an existing entry and take its name when they have
+// none; the others are linked to the entry their name matches, if any.
+// Exercises grouped into a superset or circuit must be at least two of one day
+// and agree on the kind of group.
 func (s *createProgramService) CreateProgram(ctx context.Context, cmd CreateProgramCommand) (*model.TrainingProgram, error) {
+	ownerID := cmd.UserID
+	if cmd.AssigneeID != 0 && cmd.AssigneeID != cmd.UserID {
+		ok, err := s.coaching.IsActiveCoach(ctx, cmd.UserID, cmd.AssigneeID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &utilsErrors.Error{Message: "You are not this athlete's coach", Status: http.StatusForbidden}
+		}
+		ownerID = cmd.AssigneeID
+	}
+	if err := checkGroups(cmd.Days); err != nil {
+		return nil, err
+	}
+	if err := s.resolveCatalog(ctx, cmd.Days); err != nil {
+		return nil, err
+	}
+
 	return s.repo.CreateProgram(ctx, model.TrainingProgram{
-		UserID:      cmd.UserID,
+		UserID:      ownerID,
+		AuthorID:    cmd.UserID,
 		Title:       cmd.Title,
 		Description: cmd.Description,
 		Days:        cmd.Days,
 	})
 }
+
+func checkGroups(days []model.ProgramDay) error {
+	for _, d := range days {
+		types := map[string]model.ExerciseGroupType{}
+		sizes := map[string]int{}
+		var labels []string
+		for _, e := range d.Exercises {
+			if e.Group == "" {
+				continue
+			}
+			t, ok := types[e.Group]
+			if !ok {
+				types[e.Group] = e.GroupType
+				labels = append(labels, e.Group)
+			} else if t != e.GroupType {
+				return &utilsErrors.Error{Message: fmt.Sprintf("Group %s of day %d mixes %s and %s", e.Group, d.DayNumber, t, e.GroupType)}
+			}
+			sizes[e.Group]++
+		}
+		for _, g := range labels {
+			if sizes[g] < 2 {
+				return &utilsErrors.Error{Message: fmt.Sprintf("Group %s of day %d needs at least two exercises", g, d.DayNumber)}
+			}
+		}
+	}
+	return nil
+}
+
+// resolveCatalog checks the catalog entries exercises refer to, following
+// entries merged away to the one they were merged into, and links exercises
+// without one to the entry their name or an alias matches.
+func (s *createProgramService) resolveCatalog(ctx context.Context, days []model.ProgramDay) error {
+	var ids []int
+	var names []string
+	for _, d := range days {
+		for _, e := range d.Exercises {
+			if e.CatalogID != nil {
+				ids = append(ids, *e.CatalogID)
+			} else if name := strings.TrimSpace(e.Name); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	if len(ids) == 0 && len(names) == 0 {
+		return nil
+	}
+	byID, err := s.exercises.GetExercisesByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	byName, err := s.exercises.FindExercisesByNames(ctx, names)
+	if err != nil {
+		return err
+	}
+	for i := range days {
+		for j := range days[i].Exercises {
+			e := &days[i].Exercises[j]
+			if e.CatalogID == nil {
+				if entry, ok := byName[strings.ToLower(strings.TrimSpace(e.Name))]; ok {
+					e.CatalogID = &entry.ID
+				}
+				continue
+			}
+			entry, ok := byID[*e.CatalogID]
+			if !ok {
+				return &utilsErrors.Error{Message: fmt.Sprintf("Catalog exercise %d not found", *e.CatalogID)}
+			}
+			e.CatalogID = &entry.ID
+			if e.Name == "" {
+				e.Name = entry.Name
+			}
+		}
+	}
+	return nil
+}
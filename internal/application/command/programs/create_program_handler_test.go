@@ -0,0 +1,86 @@
+package programs_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	cmdPrograms "github.com/msskobelina/fit-profi/internal/application/command/programs"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type fakeExercisesRepo struct {
+	repository.ExercisesRepository
+	byID   map[int]model.Exercise
+	byName map[string]model.Exercise
+}
+
+func (f *fakeExercisesRepo) GetExercisesByIDs(_ context.Context, ids []int) (map[int]model.Exercise, error) {
+	res := map[int]model.Exercise{}
+	for _, id := range ids {
+		if e, ok := f.byID[id]; ok {
+			res[id] = e
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeExercisesRepo) FindExercisesByNames(_ context.Context, names []string) (map[string]model.Exercise, error) {
+	res := map[string]model.Exercise{}
+	for _, n := range names {
+		if e, ok := f.byName[strings.ToLower(n)]; ok {
+			res[strings.ToLower(n)] = e
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeProgramsRepo) CreateProgram(_ context.Context, p model.TrainingProgram) (*model.TrainingProgram, error) {
+	f.created = append(f.created, p)
+	return &p, nil
+}
+
+func TestCreateProgram_ResolvesCatalog(t *testing.T) {
+	squat := model.Exercise{ID: 1, Name: "Back squat", Aliases: model.Aliases{"Squat", "High-bar squat"}}
+	exercises := &fakeExercisesRepo{
+		// 7 was merged into 1
+		byID:   map[int]model.Exercise{1: squat, 7: squat},
+		byName: map[string]model.Exercise{"back squat": squat, "squat": squat},
+	}
+	repo := &fakeProgramsRepo{}
+	svc := cmdPrograms.NewCreateProgramService(repo, &fakeCoachingRepo{}, exercises)
+
+	merged, unknown := 7, 99
+	p, err := svc.CreateProgram(context.Background(), cmdPrograms.CreateProgramCommand{
+		UserID: 20,
+		Title:  "Legs",
+		Days: []model.ProgramDay{{DayNumber: 1, Exercises: []model.ProgramExercise{
+			{CatalogID: &merged},
+			{Name: " squat "},
+			{Name: "Nordic curl"},
+		}}},
+	})
+	if err != nil {
+		t.Fatalf("CreateProgram: %v", err)
+	}
+	ex := p.Days[0].Exercises
+	if ex[0].CatalogID == nil || *ex[0].CatalogID != 1 || ex[0].Name != "Back squat" {
+		t.Errorf("merged entry = %+v, want it pointed at the surviving entry", ex[0])
+	}
+	if ex[1].CatalogID == nil || *ex[1].CatalogID != 1 {
+		t.Errorf("alias = %+v, want it linked to the catalog", ex[1])
+	}
+	if ex[2].CatalogID != nil {
+		t.Errorf("unknown name = %+v, want it left unlinked", ex[2])
+	}
+
+	_, err = svc.CreateProgram(context.Background(), cmdPrograms.CreateProgramCommand{
+		UserID: 20,
+		Title:  "Legs",
+		Days:   []model.ProgramDay{{DayNumber: 1, Exercises: []model.ProgramExercise{{CatalogID: &unknown}}}},
+	})
+	if err == nil {
+		t.Error("unknown catalogId accepted")
+	}
+}
@@ -2,8 +2,10 @@ package programs
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
 )
 
 type DeleteProgramHandler interface {
@@ -11,13 +13,32 @@ type DeleteProgramHandler interface {
 }
 
 type deleteProgramService struct {
-	repo repository.ProgramsRepository
+	repo     repository.ProgramsRepository
+	coaching repository.CoachingRepository
 }
 
-func NewDeleteProgramService(repo repository.ProgramsRepository) DeleteProgramHandler {
-	return &deleteProgramService{repo: repo}
+func NewDeleteProgramService(repo repository.ProgramsRepository, coaching repository.CoachingRepository) DeleteProgramHandler {
+	return &deleteProgramService{repo: repo, coaching: coaching}
 }
 
+// DeleteProgram deletes a program of the caller, or one the caller wrote for
+// an athlete they still actively coach.
 func (s *deleteProgramService) DeleteProgram(ctx context.Context, cmd DeleteProgramCommand) error {
-	return s.repo.DeleteProgram(ctx, cmd.ProgramID, cmd.UserID)
+	p, err := s.repo.GetProgramByID(ctx, cmd.ProgramID)
+	if err != nil {
+		return err
+	}
+	if p.UserID != cmd.UserID {
+		ok := false
+		if p.AuthorID == cmd.UserID {
+			if ok, err = s.coaching.IsActiveCoach(ctx, cmd.UserID, p.UserID); err != nil {
+				return err
+			}
+		}
+		if !ok {
+			return &utilsErrors.Error{Message: "Program not found", Status: http.StatusNotFound}
+		}
+	}
+
+	return s.repo.DeleteProgram(ctx, p.ID, p.UserID)
 }
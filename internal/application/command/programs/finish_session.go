@@ -0,0 +1,17 @@
+package programs
+
+import (
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// FinishSessionCommand closes a session in progress with the sets performed.
+// A zero EndedAt means now.
+type FinishSessionCommand struct {
+	SessionID int
+	UserID    int
+	EndedAt   time.Time
+	Notes     string
+	Sets      []model.WorkoutSet
+}
@@ -0,0 +1,113 @@
+package programs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/recordnotify"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type FinishSessionHandler interface {
+	FinishSession(context.Context, FinishSessionCommand) (*model.WorkoutSession, error)
+}
+
+type finishSessionService struct {
+	repo   repository.ProgramsRepository
+	notify recordnotify.Notifier
+}
+
+func NewFinishSessionService(repo repository.ProgramsRepository, notify recordnotify.Notifier) FinishSessionHandler {
+	return &finishSessionService{repo: repo, notify: notify}
+}
+
+// FinishSession saves the sets and closes the session. Every set must be of
+// an exercise of the session's program day; sets without a number are
+// numbered in order per exercise. Completed sets that beat the user's history
+// are saved as personal records.
+func (s *finishSessionService) FinishSession(ctx context.Context, cmd FinishSessionCommand) (*model.WorkoutSession, error) {
+	sess, err := ownSession(ctx, s.repo, cmd.SessionID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	ended := cmd.EndedAt
+	if ended.IsZero() {
+		ended = time.Now()
+	}
+	if ended.Before(sess.StartedAt) {
+		return nil, &utilsErrors.Error{Message: "endedAt must not be before the session start"}
+	}
+
+	p, err := s.repo.GetProgramByID(ctx, sess.ProgramID)
+	if err != nil {
+		return nil, err
+	}
+	day := programDay(p, sess.DayID)
+	if day == nil {
+		return nil, &utilsErrors.Error{Message: "Program day not found", Status: http.StatusNotFound}
+	}
+	exercises := make(map[int]bool, len(day.Exercises))
+	for _, e := range day.Exercises {
+		exercises[e.ID] = true
+	}
+	catalogIDs := catalogIDs(p)
+
+	sets := make([]model.WorkoutSet, len(cmd.Sets))
+	var performed []model.PerformedSet
+	counts := map[int]int{}
+	for i, set := range cmd.Sets {
+		if !exercises[set.ExerciseID] {
+			return nil, &utilsErrors.Error{Message: fmt.Sprintf("Exercise %d is not part of this program day", set.ExerciseID)}
+		}
+		counts[set.ExerciseID]++
+		if set.SetNumber == 0 {
+			set.SetNumber = counts[set.ExerciseID]
+		}
+		sets[i] = set
+		if set.Completed {
+			performed = append(performed, model.PerformedSet{
+				ExerciseID: set.ExerciseID,
+				CatalogID:  catalogIDs[set.ExerciseID],
+				Date:       sess.StartedAt,
+				Reps:       set.Reps,
+				WeightKg:   set.WeightKg,
+			})
+		}
+	}
+	records, err := personalRecords(ctx, s.repo, sess.UserID, performed)
+	if err != nil {
+		return nil, err
+	}
+
+	end := ended.UTC()
+	res, err := s.repo.CloseSession(ctx, model.WorkoutSession{
+		ID:      sess.ID,
+		UserID:  sess.UserID,
+		Status:  model.SessionFinished,
+		EndedAt: &end,
+		Notes:   cmd.Notes,
+		Sets:    sets,
+		Records: records,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.notify.Notify(res.UserID, res.Records)
+	return res, nil
+}
+
+// ownSession loads a session of the user; other users' sessions look missing.
+func ownSession(ctx context.Context, repo repository.ProgramsRepository, id, userID int) (*model.WorkoutSession, error) {
+	sess, err := repo.GetSessionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if sess.UserID != userID {
+		return nil, &utilsErrors.Error{Message: "Workout session not found", Status: http.StatusNotFound}
+	}
+	return sess, nil
+}
@@ -0,0 +1,128 @@
+package programs_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	cmdPrograms "github.com/msskobelina/fit-profi/internal/application/command/programs"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+func newFinishSessionRepo(started time.Time) *fakeProgramsRepo {
+	return &fakeProgramsRepo{
+		byID: map[int]*model.TrainingProgram{1: {ID: 1, UserID: 20, Days: []model.ProgramDay{
+			{ID: 3, Exercises: []model.ProgramExercise{{ID: 5, Name: "Back squat"}, {ID: 6, Name: "Plank"}}},
+		}}},
+		sessions: map[int]*model.WorkoutSession{
+			7: {ID: 7, UserID: 20, ProgramID: 1, DayID: 3, Status: model.SessionInProgress, StartedAt: started},
+		},
+		history: []model.PerformedSet{{ExerciseID: 5, Date: started.AddDate(0, 0, -7), Reps: 5, WeightKg: 100}},
+	}
+}
+
+func TestFinishSession_DetectsRecords(t *testing.T) {
+	started := time.Now().Add(-time.Hour)
+	repo := newFinishSessionRepo(started)
+	n := &fakeNotifier{}
+	svc := cmdPrograms.NewFinishSessionService(repo, n)
+
+	sess, err := svc.FinishSession(context.Background(), cmdPrograms.FinishSessionCommand{
+		SessionID: 7,
+		UserID:    20,
+		Sets: []model.WorkoutSet{
+			{ExerciseID: 5, Reps: 5, WeightKg: 110, Completed: true},
+			{ExerciseID: 5, Reps: 5, WeightKg: 130},
+		},
+	})
+	if err != nil {
+		t.Fatalf("FinishSession: %v", err)
+	}
+	if sess.Sets[0].SetNumber != 1 || sess.Sets[1].SetNumber != 2 {
+		t.Errorf("sets = %+v, want them numbered in order", sess.Sets)
+	}
+
+	var heaviest *model.PersonalRecord
+	for i, r := range sess.Records {
+		if r.UserID != 20 {
+			t.Errorf("record %+v belongs to user %d, want 20", r, r.UserID)
+		}
+		if r.Kind == model.RecordHeaviestWeight {
+			heaviest = &sess.Records[i]
+		}
+	}
+	if heaviest == nil || heaviest.Value != 110 || heaviest.PreviousValue != 100 {
+		t.Errorf("records = %+v, want heaviest weight 110 kg over 100 kg, ignoring the missed set", sess.Records)
+	}
+	if len(n.notified) != 1 {
+		t.Errorf("notified = %d, want the records sent to the notifier", len(n.notified))
+	}
+}
+
+func TestFinishSession_NoRecordsWithoutImprovement(t *testing.T) {
+	started := time.Now().Add(-time.Hour)
+	repo := newFinishSessionRepo(started)
+	svc := cmdPrograms.NewFinishSessionService(repo, &fakeNotifier{})
+
+	sess, err := svc.FinishSession(context.Background(), cmdPrograms.FinishSessionCommand{
+		SessionID: 7,
+		UserID:    20,
+		Sets:      []model.WorkoutSet{{ExerciseID: 5, Reps: 3, WeightKg: 90, Completed: true}},
+	})
+	if err != nil {
+		t.Fatalf("FinishSession: %v", err)
+	}
+	if len(sess.Records) != 0 {
+		t.Errorf("records = %+v, want none", sess.Records)
+	}
+}
+
+func TestFinishSession_Rejects(t *testing.T) {
+	started := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name       string
+		cmd        cmdPrograms.FinishSessionCommand
+		wantStatus int
+	}{
+		{
+			name:       "another athlete's session",
+			cmd:        cmdPrograms.FinishSessionCommand{SessionID: 7, UserID: 21},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "exercise outside the day",
+			cmd:        cmdPrograms.FinishSessionCommand{SessionID: 7, UserID: 20, Sets: []model.WorkoutSet{{ExerciseID: 9, Reps: 5, Completed: true}}},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "ended before it started",
+			cmd:        cmdPrograms.FinishSessionCommand{SessionID: 7, UserID: 20, EndedAt: started.Add(-time.Minute)},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFinishSessionRepo(started)
+			svc := cmdPrograms.NewFinishSessionService(repo, &fakeNotifier{})
+
+			_, err := svc.FinishSession(context.Background(), tt.cmd)
+			var ue *utilsErrors.Error
+			if !errors.As(err, &ue) {
+				t.Fatalf("err = %v, want status %d", err, tt.wantStatus)
+			}
+			status := ue.Status
+			if status == 0 {
+				status = http.StatusBadRequest
+			}
+			if status != tt.wantStatus {
+				t.Errorf("status = %d, want %d", status, tt.wantStatus)
+			}
+			if len(repo.closed) != 0 {
+				t.Errorf("closed = %+v, want the session left open", repo.closed)
+			}
+		})
+	}
+}
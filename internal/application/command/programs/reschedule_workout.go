@@ -0,0 +1,10 @@
+package programs
+
+import "time"
+
+type RescheduleWorkoutCommand struct {
+	UserID          int
+	WorkoutID       int
+	Start           time.Time
+	DurationMinutes int
+}
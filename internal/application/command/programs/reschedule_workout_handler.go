@@ -0,0 +1,46 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/calendarsync"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type RescheduleWorkoutHandler interface {
+	RescheduleWorkout(ctx context.Context, cmd RescheduleWorkoutCommand) (*model.ScheduledWorkout, error)
+}
+
+type rescheduleWorkoutService struct {
+	repo repository.ProgramsRepository
+	sync calendarsync.Syncer
+}
+
+func NewRescheduleWorkoutService(repo repository.ProgramsRepository, sync calendarsync.Syncer) RescheduleWorkoutHandler {
+	return &rescheduleWorkoutService{repo: repo, sync: sync}
+}
+
+func (s *rescheduleWorkoutService) RescheduleWorkout(ctx context.Context, cmd RescheduleWorkoutCommand) (*model.ScheduledWorkout, error) {
+	w, err := s.repo.GetScheduledWorkoutByID(ctx, cmd.WorkoutID)
+	if err != nil {
+		return nil, err
+	}
+	if w.UserID != cmd.UserID {
+		return nil, &utilsErrors.Error{Message: "Scheduled workout not found", Status: http.StatusNotFound}
+	}
+
+	duration := w.EndTime.Sub(w.StartTime)
+	if cmd.DurationMinutes > 0 {
+		duration = time.Duration(cmd.DurationMinutes) * time.Minute
+	}
+	w, err = s.repo.UpdateScheduledWorkout(ctx, w.ID, cmd.Start.UTC(), cmd.Start.Add(duration).UTC())
+	if err != nil {
+		return nil, err
+	}
+	s.sync.SyncWorkout(w.UserID, w.ID)
+	return w, nil
+}
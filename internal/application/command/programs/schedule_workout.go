@@ -0,0 +1,12 @@
+package programs
+
+import "time"
+
+type ScheduleWorkoutCommand struct {
+	UserID          int
+	ProgramID       int
+	DayID           int
+	Start           time.Time
+	DurationMinutes int
+	Notes           string
+}
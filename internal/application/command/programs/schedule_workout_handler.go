@@ -0,0 +1,59 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/calendarsync"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type ScheduleWorkoutHandler interface {
+	ScheduleWorkout(ctx context.Context, cmd ScheduleWorkoutCommand) (*model.ScheduledWorkout, error)
+}
+
+type scheduleWorkoutService struct {
+	repo repository.ProgramsRepository
+	sync calendarsync.Syncer
+}
+
+func NewScheduleWorkoutService(repo repository.ProgramsRepository, sync calendarsync.Syncer) ScheduleWorkoutHandler {
+	return &scheduleWorkoutService{repo: repo, sync: sync}
+}
+
+func (s *scheduleWorkoutService) ScheduleWorkout(ctx context.Context, cmd ScheduleWorkoutCommand) (*model.ScheduledWorkout, error) {
+	p, err := s.repo.GetProgramByID(ctx, cmd.ProgramID)
+	if err != nil {
+		return nil, err
+	}
+	if p.UserID != cmd.UserID {
+		return nil, &utilsErrors.Error{Message: "Program not found", Status: http.StatusNotFound}
+	}
+	found := false
+	for _, d := range p.Days {
+		if d.ID == cmd.DayID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, &utilsErrors.Error{Message: "Program day not found", Status: http.StatusNotFound}
+	}
+
+	w, err := s.repo.CreateScheduledWorkout(ctx, model.ScheduledWorkout{
+		UserID:    cmd.UserID,
+		ProgramID: p.ID,
+		DayID:     cmd.DayID,
+		StartTime: cmd.Start.UTC(),
+		EndTime:   cmd.Start.Add(time.Duration(cmd.DurationMinutes) * time.Minute).UTC(),
+		Notes:     cmd.Notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.sync.SyncWorkout(w.UserID, w.ID)
+	return w, nil
+}
@@ -0,0 +1,12 @@
+package programs
+
+import "time"
+
+// StartSessionCommand begins a workout of a program day. A zero StartedAt
+// means now.
+type StartSessionCommand struct {
+	UserID    int
+	ProgramID int
+	DayID     int
+	StartedAt time.Time
+}
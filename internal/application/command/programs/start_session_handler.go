@@ -0,0 +1,65 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type StartSessionHandler interface {
+	StartSession(context.Context, StartSessionCommand) (*model.WorkoutSession, error)
+}
+
+type startSessionService struct {
+	repo repository.ProgramsRepository
+}
+
+func NewStartSessionService(repo repository.ProgramsRepository) StartSessionHandler {
+	return &startSessionService{repo: repo}
+}
+
+func (s *startSessionService) StartSession(ctx context.Context, cmd StartSessionCommand) (*model.WorkoutSession, error) {
+	p, err := s.repo.GetProgramByID(ctx, cmd.ProgramID)
+	if err != nil {
+		return nil, err
+	}
+	if p.UserID != cmd.UserID {
+		return nil, &utilsErrors.Error{Message: "Program not found", Status: http.StatusNotFound}
+	}
+	if programDay(p, cmd.DayID) == nil {
+		return nil, &utilsErrors.Error{Message: "Program day not found", Status: http.StatusNotFound}
+	}
+
+	active, err := s.repo.GetActiveSession(ctx, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if active != nil {
+		return nil, &utilsErrors.Error{Message: "Finish or abandon the workout session in progress first", Status: http.StatusConflict}
+	}
+
+	started := cmd.StartedAt
+	if started.IsZero() {
+		started = time.Now()
+	}
+	return s.repo.CreateSession(ctx, model.WorkoutSession{
+		UserID:    cmd.UserID,
+		ProgramID: p.ID,
+		DayID:     cmd.DayID,
+		Status:    model.SessionInProgress,
+		StartedAt: started.UTC(),
+	})
+}
+
+func programDay(p *model.TrainingProgram, dayID int) *model.ProgramDay {
+	for i := range p.Days {
+		if p.Days[i].ID == dayID {
+			return &p.Days[i]
+		}
+	}
+	return nil
+}
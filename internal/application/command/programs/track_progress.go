@@ -5,6 +5,6 @@ type TrackProgressCommand struct {
 	ExerciseID int
 	Sets       int
 	Reps       int
-	WeightKg   int
+	WeightKg   float64
 	Notes      string
 }
@@ -2,9 +2,13 @@ package programs
 
 import (
 	"context"
+	"net/http"
+	"time"
 
 	"github.com/msskobelina/fit-profi/internal/domain/model"
 	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/recordnotify"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
 )
 
 type TrackProgressHandler interface {
@@ -12,20 +16,98 @@ type TrackProgressHandler interface {
 }
 
 type trackProgressService struct {
-	repo repository.ProgramsRepository
+	repo   repository.ProgramsRepository
+	notify recordnotify.Notifier
 }
 
-func NewTrackProgressService(repo repository.ProgramsRepository) TrackProgressHandler {
-	return &trackProgressService{repo: repo}
+func NewTrackProgressService(repo repository.ProgramsRepository, notify recordnotify.Notifier) TrackProgressHandler {
+	return &trackProgressService{repo: repo, notify: notify}
 }
 
+// TrackProgress saves the sets, along with the personal records they set.
 func (s *trackProgressService) TrackProgress(ctx context.Context, cmd TrackProgressCommand) (*model.ExerciseProgress, error) {
-	return s.repo.TrackProgress(ctx, model.ExerciseProgress{
+	p, err := s.repo.GetProgramByExerciseID(ctx, cmd.ExerciseID)
+	if err != nil {
+		return nil, err
+	}
+	// Only the athlete a program belongs to logs progress against it; anyone
+	// else gets the same answer as for a missing exercise.
+	if p.UserID != cmd.UserID {
+		return nil, &utilsErrors.Error{Message: "Exercise not found", Status: http.StatusNotFound}
+	}
+
+	now := time.Now().UTC()
+	catalogIDs := catalogIDs(p)
+	performed := make([]model.PerformedSet, cmd.Sets)
+	for i := range performed {
+		performed[i] = model.PerformedSet{
+			ExerciseID: cmd.ExerciseID,
+			CatalogID:  catalogIDs[cmd.ExerciseID],
+			Date:       now,
+			Reps:       cmd.Reps,
+			WeightKg:   cmd.WeightKg,
+		}
+	}
+	records, err := personalRecords(ctx, s.repo, cmd.UserID, performed)
+	if err != nil {
+		return nil, err
+	}
+
+	prog, err := s.repo.TrackProgress(ctx, model.ExerciseProgress{
 		UserID:     cmd.UserID,
 		ExerciseID: cmd.ExerciseID,
 		Sets:       cmd.Sets,
 		Reps:       cmd.Reps,
 		WeightKg:   cmd.WeightKg,
 		Notes:      cmd.Notes,
+		Records:    records,
 	})
+	if err != nil {
+		return nil, err
+	}
+	s.notify.Notify(prog.UserID, prog.Records)
+	return prog, nil
+}
+
+// personalRecords compares the sets of a workout with everything the user
+// performed of the same exercises before, in any program for exercises of the
+// catalog.
+//
+// The history is read outside the transaction that saves the workout, so two
+// workouts of the same user saved at the same moment can both be stored as a
+// record over the same previous best. That is accepted: each was a real best
+// when performed, and an athlete logging two workouts at once is rare enough
+// not to be worth serialising every save on a per-user lock.
+func personalRecords(ctx context.Context, repo repository.ProgramsRepository, userID int, sets []model.PerformedSet) ([]model.PersonalRecord, error) {
+	var history []model.PerformedSet
+	seen := map[model.SeriesKey]bool{}
+	for _, set := range sets {
+		if seen[set.Series()] {
+			continue
+		}
+		seen[set.Series()] = true
+		h, err := repo.ListPerformedSets(ctx, userID, set.ExerciseID, time.Time{}, time.Time{})
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, h...)
+	}
+	records := model.DetectRecords(history, sets)
+	for i := range records {
+		records[i].UserID = userID
+	}
+	return records, nil
+}
+
+// catalogIDs maps the exercises of a program to their catalog entries.
+func catalogIDs(p *model.TrainingProgram) map[int]int {
+	res := map[int]int{}
+	for _, d := range p.Days {
+		for _, e := range d.Exercises {
+			if e.CatalogID != nil {
+				res[e.ID] = *e.CatalogID
+			}
+		}
+	}
+	return res
 }
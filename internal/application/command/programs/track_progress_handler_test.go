@@ -0,0 +1,163 @@
+package programs_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	cmdPrograms "github.com/msskobelina/fit-profi/internal/application/command/programs"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type fakeProgramsRepo struct {
+	repository.ProgramsRepository
+	programs map[int]*model.TrainingProgram // by exercise ID
+	byID     map[int]*model.TrainingProgram
+	history  []model.PerformedSet
+	tracked  []model.ExerciseProgress
+	created  []model.TrainingProgram
+	sessions map[int]*model.WorkoutSession
+	closed   []model.WorkoutSession
+}
+
+func (f *fakeProgramsRepo) GetProgramByID(_ context.Context, id int) (*model.TrainingProgram, error) {
+	p, ok := f.byID[id]
+	if !ok {
+		return nil, &utilsErrors.Error{Message: "Program not found", Status: http.StatusNotFound}
+	}
+	return p, nil
+}
+
+func (f *fakeProgramsRepo) CreatePrograms(_ context.Context, ps []model.TrainingProgram) ([]model.TrainingProgram, error) {
+	f.created = append(f.created, ps...)
+	return ps, nil
+}
+
+func (f *fakeProgramsRepo) GetProgramByExerciseID(_ context.Context, exerciseID int) (*model.TrainingProgram, error) {
+	p, ok := f.programs[exerciseID]
+	if !ok {
+		return nil, &utilsErrors.Error{Message: "Exercise not found", Status: http.StatusNotFound}
+	}
+	return p, nil
+}
+
+func (f *fakeProgramsRepo) ListPerformedSets(_ context.Context, _, exerciseID int, _, _ time.Time) ([]model.PerformedSet, error) {
+	var res []model.PerformedSet
+	for _, s := range f.history {
+		if s.ExerciseID == exerciseID {
+			res = append(res, s)
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeProgramsRepo) TrackProgress(_ context.Context, prog model.ExerciseProgress) (*model.ExerciseProgress, error) {
+	f.tracked = append(f.tracked, prog)
+	return &prog, nil
+}
+
+func (f *fakeProgramsRepo) GetSessionByID(_ context.Context, id int) (*model.WorkoutSession, error) {
+	sess, ok := f.sessions[id]
+	if !ok {
+		return nil, &utilsErrors.Error{Message: "Workout session not found", Status: http.StatusNotFound}
+	}
+	return sess, nil
+}
+
+func (f *fakeProgramsRepo) CloseSession(_ context.Context, sess model.WorkoutSession) (*model.WorkoutSession, error) {
+	f.closed = append(f.closed, sess)
+	return &sess, nil
+}
+
+type fakeCoachingRepo struct {
+	repository.CoachingRepository
+	active map[[2]int]bool
+}
+
+func (f *fakeCoachingRepo) IsActiveCoach(_ context.Context, coachID, clientID int) (bool, error) {
+	return f.active[[2]int{coachID, clientID}], nil
+}
+
+type fakeNotifier struct {
+	notified [][]model.PersonalRecord
+}
+
+func (f *fakeNotifier) Notify(_ int, records []model.PersonalRecord) {
+	f.notified = append(f.notified, records)
+}
+
+func (f *fakeNotifier) Run(context.Context) {}
+
+func TestTrackProgress_OnlyTheOwnerLogs(t *testing.T) {
+	const (
+		athlete  = 20
+		other    = 21
+		coach    = 10
+		exCoach  = 11
+		exercise = 5
+	)
+	tests := []struct {
+		name       string
+		userID     int
+		exerciseID int
+		wantStatus int
+	}{
+		{name: "owner", userID: athlete, exerciseID: exercise},
+		{name: "another athlete", userID: other, exerciseID: exercise, wantStatus: http.StatusNotFound},
+		{name: "active coach", userID: coach, exerciseID: exercise, wantStatus: http.StatusNotFound},
+		{name: "former coach who wrote it", userID: exCoach, exerciseID: exercise, wantStatus: http.StatusNotFound},
+		{name: "unknown exercise", userID: athlete, exerciseID: 99, wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeProgramsRepo{programs: map[int]*model.TrainingProgram{
+				exercise: {ID: 1, UserID: athlete, AuthorID: exCoach},
+			}}
+			svc := cmdPrograms.NewTrackProgressService(repo, &fakeNotifier{})
+
+			prog, err := svc.TrackProgress(context.Background(), cmdPrograms.TrackProgressCommand{
+				UserID:     tt.userID,
+				ExerciseID: tt.exerciseID,
+				Sets:       3,
+				Reps:       5,
+				WeightKg:   60,
+			})
+			if tt.wantStatus == 0 {
+				if err != nil || prog == nil || prog.UserID != tt.userID || len(repo.tracked) != 1 {
+					t.Fatalf("TrackProgress = %+v, %v; want it saved", prog, err)
+				}
+				return
+			}
+			var ue *utilsErrors.Error
+			if !errors.As(err, &ue) || ue.Status != tt.wantStatus {
+				t.Fatalf("err = %v, want status %d", err, tt.wantStatus)
+			}
+			if len(repo.tracked) != 0 {
+				t.Errorf("tracked = %+v, want nothing saved", repo.tracked)
+			}
+		})
+	}
+}
+
+func TestTrackProgress_NotifiesRecords(t *testing.T) {
+	repo := &fakeProgramsRepo{
+		programs: map[int]*model.TrainingProgram{5: {ID: 1, UserID: 20}},
+		history:  []model.PerformedSet{{ExerciseID: 5, Date: time.Now().AddDate(0, 0, -7), Reps: 5, WeightKg: 55}},
+	}
+	n := &fakeNotifier{}
+	svc := cmdPrograms.NewTrackProgressService(repo, n)
+
+	prog, err := svc.TrackProgress(context.Background(), cmdPrograms.TrackProgressCommand{
+		UserID: 20, ExerciseID: 5, Sets: 1, Reps: 5, WeightKg: 60,
+	})
+	if err != nil {
+		t.Fatalf("TrackProgress: %v", err)
+	}
+	if len(prog.Records) == 0 || len(n.notified) != 1 {
+		t.Errorf("records = %+v, notified = %d; want records sent to the notifier", prog.Records, len(n.notified))
+	}
+}
@@ -0,0 +1,6 @@
+package programs
+
+type UnscheduleWorkoutCommand struct {
+	UserID    int
+	WorkoutID int
+}
@@ -0,0 +1,29 @@
+package programs
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/calendarsync"
+)
+
+type UnscheduleWorkoutHandler interface {
+	UnscheduleWorkout(ctx context.Context, cmd UnscheduleWorkoutCommand) error
+}
+
+type unscheduleWorkoutService struct {
+	repo repository.ProgramsRepository
+	sync calendarsync.Syncer
+}
+
+func NewUnscheduleWorkoutService(repo repository.ProgramsRepository, sync calendarsync.Syncer) UnscheduleWorkoutHandler {
+	return &unscheduleWorkoutService{repo: repo, sync: sync}
+}
+
+func (s *unscheduleWorkoutService) UnscheduleWorkout(ctx context.Context, cmd UnscheduleWorkoutCommand) error {
+	if err := s.repo.DeleteScheduledWorkout(ctx, cmd.WorkoutID, cmd.UserID); err != nil {
+		return err
+	}
+	s.sync.SyncWorkout(cmd.UserID, cmd.WorkoutID)
+	return nil
+}
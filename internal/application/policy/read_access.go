@@ -0,0 +1,46 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+// ReadAccess decides whether a caller may read data owned by another user.
+// Owners can always read their own data; coaches can read the data of their
+// active clients.
+type ReadAccess interface {
+	CanRead(ctx context.Context, viewerID, ownerID int) error
+}
+
+type readAccess struct {
+	coaching repository.CoachingRepository
+}
+
+func NewReadAccess(coaching repository.CoachingRepository) ReadAccess {
+	return &readAccess{coaching: coaching}
+}
+
+func (a *readAccess) CanRead(ctx context.Context, viewerID, ownerID int) error {
+	if viewerID == ownerID {
+		return nil
+	}
+	ok, err := a.coaching.IsActiveCoach(ctx, viewerID, ownerID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &utilsErrors.Error{Message: "Access denied", Status: http.StatusForbidden}
+	}
+	return nil
+}
+
+// IsDenied reports whether err is the error CanRead returns when access is
+// refused, as opposed to a lookup failure.
+func IsDenied(err error) bool {
+	var e *utilsErrors.Error
+	return errors.As(err, &e) && e.Status == http.StatusForbidden
+}
@@ -0,0 +1,28 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type ListFoodSubmissionsHandler interface {
+	ListFoodSubmissions(ctx context.Context, q ListFoodSubmissionsQuery) (*ListFoodSubmissionsResult, error)
+}
+
+type listFoodSubmissionsService struct {
+	foods repository.FoodsRepository
+}
+
+func NewListFoodSubmissionsService(foods repository.FoodsRepository) ListFoodSubmissionsHandler {
+	return &listFoodSubmissionsService{foods: foods}
+}
+
+func (s *listFoodSubmissionsService) ListFoodSubmissions(ctx context.Context, q ListFoodSubmissionsQuery) (*ListFoodSubmissionsResult, error) {
+	subs, total, err := s.foods.ListFoodSubmissions(ctx, model.FoodSubmissionStatus(q.Status), q.Limit, q.Offset)
+	if err != nil {
+		return nil, err
+	}
+	return &ListFoodSubmissionsResult{Submissions: subs, Total: total}, nil
+}
@@ -0,0 +1,14 @@
+package admin
+
+import "github.com/msskobelina/fit-profi/internal/domain/model"
+
+type ListFoodSubmissionsQuery struct {
+	Status string
+	Limit  int
+	Offset int
+}
+
+type ListFoodSubmissionsResult struct {
+	Submissions []model.FoodSubmission
+	Total       int64
+}
@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type ListUsersHandler interface {
+	ListUsers(ctx context.Context, q ListUsersQuery) (*ListUsersResult, error)
+}
+
+type listUsersService struct {
+	repo repository.UsersRepository
+}
+
+func NewListUsersService(repo repository.UsersRepository) ListUsersHandler {
+	return &listUsersService{repo: repo}
+}
+
+func (s *listUsersService) ListUsers(ctx context.Context, q ListUsersQuery) (*ListUsersResult, error) {
+	users, total, err := s.repo.ListUsers(ctx, q.Search, model.Role(q.Role), q.Limit, q.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]UserInfo, 0, len(users))
+	for _, u := range users {
+		out = append(out, UserInfo{
+			ID:        u.ID,
+			FullName:  u.FullName,
+			Email:     u.Email,
+			Role:      string(u.Role),
+			Disabled:  u.Disabled,
+			CreatedAt: u.CreatedAt,
+		})
+	}
+
+	return &ListUsersResult{Users: out, Total: total}, nil
+}
@@ -0,0 +1,24 @@
+package admin
+
+import "time"
+
+type ListUsersQuery struct {
+	Search string
+	Role   string
+	Limit  int
+	Offset int
+}
+
+type ListUsersResult struct {
+	Users []UserInfo
+	Total int64
+}
+
+type UserInfo struct {
+	ID        int
+	FullName  string
+	Email     string
+	Role      string
+	Disabled  bool
+	CreatedAt time.Time
+}
@@ -20,6 +20,10 @@ func NewVerifyTokenService(repo repository.UsersRepository, hmacSecret string) V
 	return &verifyTokenService{repo: repo, hmacSecret: hmacSecret}
 }
 
+// VerifyToken accepts a token that is validly signed and not revoked, for a
+// user who still exists and is not disabled. The role is read from the user
+// rather than the token, so disabling or demoting someone takes effect on
+// their next request instead of when their access token expires.
 func (s *verifyTokenService) VerifyToken(ctx context.Context, q VerifyTokenQuery) (*VerifyTokenResult, error) {
 	t, err := access.DecodeToken(q.Token, s.hmacSecret)
 	if err != nil {
@@ -32,9 +36,16 @@ func (s *verifyTokenService) VerifyToken(ctx context.Context, q VerifyTokenQuery
 	if revoked {
 		return nil, nil
 	}
+	u, err := s.repo.GetUserByID(ctx, t.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if u.Disabled {
+		return nil, nil
+	}
 
 	return &VerifyTokenResult{
-		UserID: t.UserID,
-		Role:   string(t.UserRole),
+		UserID: u.ID,
+		Role:   string(u.Role),
 	}, nil
 }
@@ -0,0 +1,24 @@
+package calendar
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type ListAvailabilityExceptionsHandler interface {
+	ListAvailabilityExceptions(ctx context.Context, q ListAvailabilityExceptionsQuery) ([]model.AvailabilityException, error)
+}
+
+type listAvailabilityExceptionsService struct {
+	repo repository.CalendarRepository
+}
+
+func NewListAvailabilityExceptionsService(repo repository.CalendarRepository) ListAvailabilityExceptionsHandler {
+	return &listAvailabilityExceptionsService{repo: repo}
+}
+
+func (s *listAvailabilityExceptionsService) ListAvailabilityExceptions(ctx context.Context, q ListAvailabilityExceptionsQuery) ([]model.AvailabilityException, error) {
+	return s.repo.ListAvailabilityExceptions(ctx, q.CoachID)
+}
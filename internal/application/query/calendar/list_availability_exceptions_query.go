@@ -0,0 +1,5 @@
+package calendar
+
+type ListAvailabilityExceptionsQuery struct {
+	CoachID int
+}
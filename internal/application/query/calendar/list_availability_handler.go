@@ -0,0 +1,133 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+// defaultAvailabilityWindow bounds the listing when no end date is given.
+const defaultAvailabilityWindow = 30 * 24 * time.Hour
+
+type ListAvailabilityHandler interface {
+	ListAvailability(ctx context.Context, q ListAvailabilityQuery) ([]model.CoachAvailability, error)
+}
+
+type listAvailabilityService struct {
+	repo     repository.CalendarRepository
+	coaching repository.CoachingRepository
+}
+
+func NewListAvailabilityService(repo repository.CalendarRepository, coaching repository.CoachingRepository) ListAvailabilityHandler {
+	return &listAvailabilityService{repo: repo, coaching: coaching}
+}
+
+// ListAvailability returns all of a coach's own slots, or only the unbooked
+// ones when an active client asks for their coach's availability. Recurring
+// rules are expanded into slots for the requested window; occurrences that
+// overlap a booked session are left out.
+func (s *listAvailabilityService) ListAvailability(ctx context.Context, q ListAvailabilityQuery) ([]model.CoachAvailability, error) {
+	from := q.From
+	if from.IsZero() {
+		from = time.Now()
+	}
+	to := q.To
+	if to.IsZero() {
+		to = from.Add(defaultAvailabilityWindow)
+	}
+	if !to.After(from) {
+		return nil, &utilsErrors.Error{Message: "to must be after from"}
+	}
+
+	var (
+		coachID = q.CoachID
+		slots   []model.CoachAvailability
+		err     error
+	)
+	if coachID == 0 || coachID == q.UserID {
+		coachID = q.UserID
+		slots, err = s.repo.ListAvailability(ctx, coachID, from, to)
+	} else {
+		var ok bool
+		ok, err = s.coaching.IsActiveCoach(ctx, coachID, q.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, &utilsErrors.Error{Message: "Access denied", Status: http.StatusForbidden}
+		}
+		slots, err = s.repo.ListOpenAvailability(ctx, coachID, from, to)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	recurring, err := s.expandRules(ctx, coachID, from, to, slots)
+	if err != nil {
+		return nil, err
+	}
+	if len(recurring) == 0 {
+		return slots, nil
+	}
+
+	slots = append(slots, recurring...)
+	sort.SliceStable(slots, func(i, j int) bool { return slots[i].StartTime.Before(slots[j].StartTime) })
+	return slots, nil
+}
+
+// expandRules returns the coach's rule occurrences in the window that aren't
+// already stored as slots and don't overlap a booked session.
+func (s *listAvailabilityService) expandRules(ctx context.Context, coachID int, from, to time.Time, stored []model.CoachAvailability) ([]model.CoachAvailability, error) {
+	rules, err := s.repo.ListAvailabilityRules(ctx, coachID)
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+	exceptions, err := s.repo.ListAvailabilityExceptions(ctx, coachID)
+	if err != nil {
+		return nil, err
+	}
+	booked, err := s.repo.ListCoachBookings(ctx, coachID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	materialised := make(map[int]map[int64]bool)
+	for _, sl := range stored {
+		if sl.RuleID == nil {
+			continue
+		}
+		if materialised[*sl.RuleID] == nil {
+			materialised[*sl.RuleID] = make(map[int64]bool)
+		}
+		materialised[*sl.RuleID][sl.StartTime.Unix()] = true
+	}
+
+	var res []model.CoachAvailability
+	for _, rule := range rules {
+		occ, err := rule.Expand(from, to, exceptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range occ {
+			if materialised[rule.ID][o.StartTime.Unix()] || overlapsBooking(o, booked) {
+				continue
+			}
+			res = append(res, o)
+		}
+	}
+	return res, nil
+}
+
+func overlapsBooking(slot model.CoachAvailability, booked []model.Booking) bool {
+	for _, b := range booked {
+		if slot.StartTime.Before(b.EndTime) && slot.EndTime.After(b.StartTime) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,10 @@
+package calendar
+
+import "time"
+
+type ListAvailabilityQuery struct {
+	UserID  int
+	CoachID int
+	From    time.Time
+	To      time.Time
+}
@@ -0,0 +1,24 @@
+package calendar
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type ListAvailabilityRulesHandler interface {
+	ListAvailabilityRules(ctx context.Context, q ListAvailabilityRulesQuery) ([]model.AvailabilityRule, error)
+}
+
+type listAvailabilityRulesService struct {
+	repo repository.CalendarRepository
+}
+
+func NewListAvailabilityRulesService(repo repository.CalendarRepository) ListAvailabilityRulesHandler {
+	return &listAvailabilityRulesService{repo: repo}
+}
+
+func (s *listAvailabilityRulesService) ListAvailabilityRules(ctx context.Context, q ListAvailabilityRulesQuery) ([]model.AvailabilityRule, error) {
+	return s.repo.ListAvailabilityRules(ctx, q.CoachID)
+}
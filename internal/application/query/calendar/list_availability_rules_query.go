@@ -0,0 +1,5 @@
+package calendar
+
+type ListAvailabilityRulesQuery struct {
+	CoachID int
+}
@@ -2,14 +2,13 @@ package calendar
 
 import (
 	"context"
-	"time"
 
-	"golang.org/x/oauth2"
 	googlecalendar "google.golang.org/api/calendar/v3"
 	"google.golang.org/api/option"
 
 	"github.com/msskobelina/fit-profi/internal/domain/model"
 	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/providers"
 )
 
 type ListCalendarsHandler interface {
@@ -18,11 +17,11 @@ type ListCalendarsHandler interface {
 
 type listCalendarsService struct {
 	integRepo repository.IntegrationsRepository
-	oauthCfg  *oauth2.Config
+	providers *providers.Registry
 }
 
-func NewListCalendarsService(integRepo repository.IntegrationsRepository, oauthCfg *oauth2.Config) ListCalendarsHandler {
-	return &listCalendarsService{integRepo: integRepo, oauthCfg: oauthCfg}
+func NewListCalendarsService(integRepo repository.IntegrationsRepository, registry *providers.Registry) ListCalendarsHandler {
+	return &listCalendarsService{integRepo: integRepo, providers: registry}
 }
 
 func (s *listCalendarsService) ListCalendars(ctx context.Context, q ListCalendarsQuery) ([]CalendarInfo, error) {
@@ -31,12 +30,11 @@ func (s *listCalendarsService) ListCalendars(ctx context.Context, q ListCalendar
 		return nil, err
 	}
 
-	tok := &oauth2.Token{
-		AccessToken:  integ.AccessToken,
-		RefreshToken: integ.RefreshToken,
-		Expiry:       time.Unix(integ.ExpiryUnix, 0),
+	ts, err := s.providers.TokenSource(ctx, s.integRepo, integ)
+	if err != nil {
+		return nil, err
 	}
-	svc, err := googlecalendar.NewService(ctx, option.WithTokenSource(s.oauthCfg.TokenSource(ctx, tok)))
+	svc, err := googlecalendar.NewService(ctx, option.WithTokenSource(ts))
 	if err != nil {
 		return nil, err
 	}
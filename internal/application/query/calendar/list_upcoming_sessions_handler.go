@@ -0,0 +1,25 @@
+package calendar
+
+import (
+	"context"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type ListUpcomingSessionsHandler interface {
+	ListUpcomingSessions(ctx context.Context, q ListUpcomingSessionsQuery) ([]model.Booking, error)
+}
+
+type listUpcomingSessionsService struct {
+	repo repository.CalendarRepository
+}
+
+func NewListUpcomingSessionsService(repo repository.CalendarRepository) ListUpcomingSessionsHandler {
+	return &listUpcomingSessionsService{repo: repo}
+}
+
+func (s *listUpcomingSessionsService) ListUpcomingSessions(ctx context.Context, q ListUpcomingSessionsQuery) ([]model.Booking, error) {
+	return s.repo.ListUpcomingBookings(ctx, q.UserID, time.Now())
+}
@@ -0,0 +1,5 @@
+package calendar
+
+type ListUpcomingSessionsQuery struct {
+	UserID int
+}
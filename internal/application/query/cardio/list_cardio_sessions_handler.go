@@ -0,0 +1,51 @@
+package cardio
+
+import (
+	"context"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+// defaultSessionsWindow bounds the listing when no start date is given.
+const defaultSessionsWindow = 30 * 24 * time.Hour
+
+type ListCardioSessionsHandler interface {
+	ListCardioSessions(ctx context.Context, q ListCardioSessionsQuery) ([]model.CardioSession, error)
+}
+
+type listCardioSessionsService struct {
+	repo   repository.CardioRepository
+	access policy.ReadAccess
+}
+
+func NewListCardioSessionsService(repo repository.CardioRepository, access policy.ReadAccess) ListCardioSessionsHandler {
+	return &listCardioSessionsService{repo: repo, access: access}
+}
+
+func (s *listCardioSessionsService) ListCardioSessions(ctx context.Context, q ListCardioSessionsQuery) ([]model.CardioSession, error) {
+	ownerID := q.OwnerID
+	if ownerID == 0 {
+		ownerID = q.UserID
+	}
+	if err := s.access.CanRead(ctx, q.UserID, ownerID); err != nil {
+		return nil, err
+	}
+
+	to := q.To
+	if to.IsZero() {
+		to = time.Now()
+	}
+	from := q.From
+	if from.IsZero() {
+		from = to.Add(-defaultSessionsWindow)
+	}
+	if !to.After(from) {
+		return nil, &utilsErrors.Error{Message: "to must be after from"}
+	}
+
+	return s.repo.ListSessions(ctx, ownerID, from, to)
+}
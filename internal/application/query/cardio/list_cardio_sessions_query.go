@@ -0,0 +1,10 @@
+package cardio
+
+import "time"
+
+type ListCardioSessionsQuery struct {
+	UserID  int
+	OwnerID int
+	From    time.Time
+	To      time.Time
+}
@@ -0,0 +1,24 @@
+package coaching
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type GetCoachHandler interface {
+	GetCoach(ctx context.Context, q GetCoachQuery) (*model.CoachingRelationship, error)
+}
+
+type getCoachService struct {
+	repo repository.CoachingRepository
+}
+
+func NewGetCoachService(repo repository.CoachingRepository) GetCoachHandler {
+	return &getCoachService{repo: repo}
+}
+
+func (s *getCoachService) GetCoach(ctx context.Context, q GetCoachQuery) (*model.CoachingRelationship, error) {
+	return s.repo.GetActiveByClient(ctx, q.ClientID)
+}
@@ -0,0 +1,5 @@
+package coaching
+
+type GetCoachQuery struct {
+	ClientID int
+}
@@ -0,0 +1,24 @@
+package coaching
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type ListClientsHandler interface {
+	ListClients(ctx context.Context, q ListClientsQuery) ([]model.CoachingRelationship, error)
+}
+
+type listClientsService struct {
+	repo repository.CoachingRepository
+}
+
+func NewListClientsService(repo repository.CoachingRepository) ListClientsHandler {
+	return &listClientsService{repo: repo}
+}
+
+func (s *listClientsService) ListClients(ctx context.Context, q ListClientsQuery) ([]model.CoachingRelationship, error) {
+	return s.repo.ListByCoach(ctx, q.CoachID, model.CoachingStatus(q.Status))
+}
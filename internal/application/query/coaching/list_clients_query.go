@@ -0,0 +1,6 @@
+package coaching
+
+type ListClientsQuery struct {
+	CoachID int
+	Status  string
+}
@@ -0,0 +1,30 @@
+package coaching
+
+import (
+	"context"
+	"strings"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type ListInvitationsHandler interface {
+	ListInvitations(ctx context.Context, q ListInvitationsQuery) ([]model.CoachingRelationship, error)
+}
+
+type listInvitationsService struct {
+	repo  repository.CoachingRepository
+	users repository.UsersRepository
+}
+
+func NewListInvitationsService(repo repository.CoachingRepository, users repository.UsersRepository) ListInvitationsHandler {
+	return &listInvitationsService{repo: repo, users: users}
+}
+
+func (s *listInvitationsService) ListInvitations(ctx context.Context, q ListInvitationsQuery) ([]model.CoachingRelationship, error) {
+	u, err := s.users.GetUserByID(ctx, q.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListPendingByEmail(ctx, strings.ToLower(u.Email))
+}
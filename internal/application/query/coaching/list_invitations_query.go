@@ -0,0 +1,5 @@
+package coaching
+
+type ListInvitationsQuery struct {
+	UserID int
+}
@@ -0,0 +1,48 @@
+package integrations
+
+import (
+	"context"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/providers"
+)
+
+type ListIntegrationsHandler interface {
+	ListIntegrations(ctx context.Context, q ListIntegrationsQuery) ([]IntegrationInfo, error)
+}
+
+type listIntegrationsService struct {
+	repo      repository.IntegrationsRepository
+	providers *providers.Registry
+}
+
+func NewListIntegrationsService(repo repository.IntegrationsRepository, registry *providers.Registry) ListIntegrationsHandler {
+	return &listIntegrationsService{repo: repo, providers: registry}
+}
+
+func (s *listIntegrationsService) ListIntegrations(ctx context.Context, q ListIntegrationsQuery) ([]IntegrationInfo, error) {
+	rows, err := s.repo.ListByUser(ctx, q.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]IntegrationInfo, 0, len(rows))
+	for _, it := range rows {
+		var caps []providers.Capability
+		if p, err := s.providers.Get(it.Provider); err == nil {
+			caps = p.Capabilities()
+		}
+		out = append(out, IntegrationInfo{
+			Provider:     it.Provider,
+			Status:       it.Status,
+			CalendarID:   it.CalendarID,
+			Timezone:     it.Timezone,
+			LastError:    it.LastError,
+			ExpiresAt:    time.Unix(it.ExpiryUnix, 0).UTC(),
+			Capabilities: caps,
+		})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,23 @@
+package integrations
+
+import (
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/providers"
+)
+
+type ListIntegrationsQuery struct {
+	UserID int
+}
+
+type IntegrationInfo struct {
+	Provider   model.Provider
+	Status     model.IntegrationStatus
+	CalendarID string
+	Timezone   string
+	LastError  string
+	ExpiresAt  time.Time
+	// Capabilities lists what the provider is used for, e.g. calendar sync.
+	Capabilities []providers.Capability
+}
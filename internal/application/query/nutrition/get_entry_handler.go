@@ -3,6 +3,7 @@ package nutrition
 import (
 	"context"
 
+	"github.com/msskobelina/fit-profi/internal/application/policy"
 	"github.com/msskobelina/fit-profi/internal/domain/model"
 	"github.com/msskobelina/fit-profi/internal/domain/repository"
 )
@@ -12,13 +13,21 @@ type GetEntryHandler interface {
 }
 
 type getEntryService struct {
-	repo repository.NutritionRepository
+	repo   repository.NutritionRepository
+	access policy.ReadAccess
 }
 
-func NewGetEntryService(repo repository.NutritionRepository) GetEntryHandler {
-	return &getEntryService{repo: repo}
+func NewGetEntryService(repo repository.NutritionRepository, access policy.ReadAccess) GetEntryHandler {
+	return &getEntryService{repo: repo, access: access}
 }
 
 func (s *getEntryService) GetEntry(ctx context.Context, q GetEntryQuery) (*model.DiaryEntry, error) {
-	return s.repo.GetEntryByID(ctx, q.EntryID, q.UserID)
+	ownerID := q.OwnerID
+	if ownerID == 0 {
+		ownerID = q.UserID
+	}
+	if err := s.access.CanRead(ctx, q.UserID, ownerID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetEntryByID(ctx, q.EntryID, ownerID)
 }
@@ -3,4 +3,5 @@ package nutrition
 type GetEntryQuery struct {
 	EntryID int
 	UserID  int
+	OwnerID int
 }
@@ -0,0 +1,33 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type GetLimitsHandler interface {
+	GetLimits(context.Context, GetLimitsQuery) (*model.NutritionLimits, error)
+}
+
+type getLimitsService struct {
+	repo   repository.NutritionRepository
+	access policy.ReadAccess
+}
+
+func NewGetLimitsService(repo repository.NutritionRepository, access policy.ReadAccess) GetLimitsHandler {
+	return &getLimitsService{repo: repo, access: access}
+}
+
+func (s *getLimitsService) GetLimits(ctx context.Context, q GetLimitsQuery) (*model.NutritionLimits, error) {
+	ownerID := q.OwnerID
+	if ownerID == 0 {
+		ownerID = q.UserID
+	}
+	if err := s.access.CanRead(ctx, q.UserID, ownerID); err != nil {
+		return nil, err
+	}
+	return resolveLimits(ctx, s.repo, ownerID)
+}
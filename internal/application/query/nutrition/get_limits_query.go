@@ -0,0 +1,6 @@
+package nutrition
+
+type GetLimitsQuery struct {
+	UserID  int
+	OwnerID int
+}
@@ -0,0 +1,33 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type GetRecipeHandler interface {
+	GetRecipe(context.Context, GetRecipeQuery) (*model.Recipe, error)
+}
+
+type getRecipeService struct {
+	repo   repository.RecipesRepository
+	access policy.ReadAccess
+}
+
+func NewGetRecipeService(repo repository.RecipesRepository, access policy.ReadAccess) GetRecipeHandler {
+	return &getRecipeService{repo: repo, access: access}
+}
+
+func (s *getRecipeService) GetRecipe(ctx context.Context, q GetRecipeQuery) (*model.Recipe, error) {
+	ownerID := q.OwnerID
+	if ownerID == 0 {
+		ownerID = q.UserID
+	}
+	if err := s.access.CanRead(ctx, q.UserID, ownerID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetRecipeByID(ctx, q.RecipeID, ownerID)
+}
@@ -0,0 +1,7 @@
+package nutrition
+
+type GetRecipeQuery struct {
+	RecipeID int
+	UserID   int
+	OwnerID  int
+}
@@ -0,0 +1,136 @@
+package nutrition
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+const (
+	defaultSummaryDays = 7
+	maxSummaryDays     = 366
+)
+
+type GetSummaryHandler interface {
+	GetSummary(context.Context, GetSummaryQuery) (*model.NutritionSummary, error)
+}
+
+type getSummaryService struct {
+	repo     repository.NutritionRepository
+	profiles repository.ProfilesRepository
+	access   policy.ReadAccess
+}
+
+func NewGetSummaryService(repo repository.NutritionRepository, profiles repository.ProfilesRepository, access policy.ReadAccess) GetSummaryHandler {
+	return &getSummaryService{repo: repo, profiles: profiles, access: access}
+}
+
+func (s *getSummaryService) GetSummary(ctx context.Context, q GetSummaryQuery) (*model.NutritionSummary, error) {
+	ownerID := q.OwnerID
+	if ownerID == 0 {
+		ownerID = q.UserID
+	}
+	if err := s.access.CanRead(ctx, q.UserID, ownerID); err != nil {
+		return nil, err
+	}
+
+	profile, err := s.profiles.GetUserProfileByUserID(ctx, ownerID)
+	if err != nil && !utilsErrors.IsNotFound(err) {
+		return nil, err
+	}
+	loc := profile.Location()
+
+	// Diary dates carry no time of day, so only "today" depends on the zone.
+	to := dateOf(q.To)
+	if q.To.IsZero() {
+		to = dateOf(time.Now().In(loc))
+	}
+	from := dateOf(q.From)
+	if q.From.IsZero() {
+		from = to.AddDate(0, 0, -(defaultSummaryDays - 1))
+	}
+	if from.After(to) {
+		return nil, &utilsErrors.Error{Message: "from must not be after to"}
+	}
+	if to.Sub(from) >= maxSummaryDays*24*time.Hour {
+		return nil, &utilsErrors.Error{Message: "Summary period must not exceed 366 days"}
+	}
+
+	summary, err := s.repo.SummarizeEntries(ctx, ownerID, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	hydration, err := s.repo.SummarizeHydration(ctx, ownerID, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	addHydration(summary, hydration)
+	summary.From = from.Format("2006-01-02")
+	summary.To = to.Format("2006-01-02")
+	summary.Timezone = loc.String()
+
+	target, err := resolveTargets(ctx, s.repo, s.access, profile, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if target != nil {
+		summary.Target = target
+		goal := target.Totals()
+		for i := range summary.Days {
+			remaining, exceeded := summary.Days[i].Totals.Against(goal)
+			summary.Days[i].Remaining = &remaining
+			summary.Days[i].Exceeded = &exceeded
+		}
+	}
+	limits, err := resolveLimits(ctx, s.repo, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	summary.Limits = limits
+	for i := range summary.Days {
+		summary.Days[i].Warnings = limits.Check(summary.Days[i].Totals)
+	}
+	if n := float64(summary.LoggedDays); n > 0 {
+		summary.Average = summary.Totals.Per(n)
+	}
+	return summary, nil
+}
+
+// addHydration counts drinks from the hydration log towards the day and
+// period totals. Days with drinks but no diary entries are added without
+// changing LoggedDays.
+func addHydration(summary *model.NutritionSummary, hydration []model.HydrationDay) {
+	if len(hydration) == 0 {
+		return
+	}
+	days := make(map[string]int, len(summary.Days))
+	for i, d := range summary.Days {
+		days[d.Date] = i
+	}
+	for _, h := range hydration {
+		key := h.Date.UTC().Format("2006-01-02")
+		i, ok := days[key]
+		if !ok {
+			summary.Days = append(summary.Days, model.NutritionDay{Date: key, Meals: map[string]model.NutritionTotals{}})
+			i = len(summary.Days) - 1
+			days[key] = i
+		}
+		day := &summary.Days[i]
+		day.HydrationMl = h.AmountMl
+		day.Totals.WaterMl += h.AmountMl
+		day.Totals.CaffeineMg += h.CaffeineMg
+		summary.Totals.WaterMl += h.AmountMl
+		summary.Totals.CaffeineMg += h.CaffeineMg
+	}
+	sort.Slice(summary.Days, func(i, j int) bool { return summary.Days[i].Date < summary.Days[j].Date })
+}
+
+// dateOf drops the time of day and zone, matching how diary dates are stored.
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,13 @@
+package nutrition
+
+import "time"
+
+// GetSummaryQuery asks for macro totals between two diary dates, both
+// inclusive. Zero dates default to the last seven days in the owner's time
+// zone.
+type GetSummaryQuery struct {
+	UserID  int
+	OwnerID int
+	From    time.Time
+	To      time.Time
+}
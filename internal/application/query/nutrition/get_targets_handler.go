@@ -0,0 +1,47 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type GetTargetsHandler interface {
+	GetTargets(context.Context, GetTargetsQuery) (*model.NutritionTargets, error)
+}
+
+type getTargetsService struct {
+	repo     repository.NutritionRepository
+	profiles repository.ProfilesRepository
+	access   policy.ReadAccess
+}
+
+func NewGetTargetsService(repo repository.NutritionRepository, profiles repository.ProfilesRepository, access policy.ReadAccess) GetTargetsHandler {
+	return &getTargetsService{repo: repo, profiles: profiles, access: access}
+}
+
+func (s *getTargetsService) GetTargets(ctx context.Context, q GetTargetsQuery) (*model.NutritionTargets, error) {
+	ownerID := q.OwnerID
+	if ownerID == 0 {
+		ownerID = q.UserID
+	}
+	if err := s.access.CanRead(ctx, q.UserID, ownerID); err != nil {
+		return nil, err
+	}
+
+	profile, err := s.profiles.GetUserProfileByUserID(ctx, ownerID)
+	if err != nil && !utilsErrors.IsNotFound(err) {
+		return nil, err
+	}
+	t, err := resolveTargets(ctx, s.repo, s.access, profile, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, &utilsErrors.Error{Message: "Profile needs age, weight, height, sex and activity level to compute nutrition targets"}
+	}
+	return t, nil
+}
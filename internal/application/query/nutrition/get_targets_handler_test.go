@@ -0,0 +1,125 @@
+package nutrition_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	qryNutrition "github.com/msskobelina/fit-profi/internal/application/query/nutrition"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type fakeNutritionRepo struct {
+	repository.NutritionRepository
+	override *model.NutritionTargetOverride
+}
+
+func (f *fakeNutritionRepo) GetTargetOverride(context.Context, int) (*model.NutritionTargetOverride, error) {
+	return f.override, nil
+}
+
+type fakeProfilesRepo struct {
+	repository.ProfilesRepository
+	profiles map[int]*model.UserProfile
+}
+
+func (f *fakeProfilesRepo) GetUserProfileByUserID(_ context.Context, userID int) (*model.UserProfile, error) {
+	p, ok := f.profiles[userID]
+	if !ok {
+		return nil, &utilsErrors.Error{Message: "Profile not found", Status: http.StatusNotFound}
+	}
+	return p, nil
+}
+
+type fakeCoachingRepo struct {
+	repository.CoachingRepository
+	active map[[2]int]bool
+}
+
+func (f *fakeCoachingRepo) IsActiveCoach(_ context.Context, coachID, clientID int) (bool, error) {
+	return f.active[[2]int{coachID, clientID}], nil
+}
+
+func TestGetTargets(t *testing.T) {
+	const (
+		athlete = 20
+		other   = 21
+		coach   = 10
+		exCoach = 11
+	)
+	profile := &model.UserProfile{
+		UserID:        athlete,
+		Age:           30,
+		WeightKg:      80,
+		HeightCm:      180,
+		Sex:           model.SexMale,
+		ActivityLevel: model.ActivityModerate,
+		Goal:          model.GoalKeepFit,
+	}
+	tests := []struct {
+		name       string
+		userID     int
+		override   *model.NutritionTargetOverride
+		profile    *model.UserProfile
+		wantSource model.TargetsSource
+		wantStatus int
+	}{
+		{name: "computed from profile", userID: athlete, profile: profile, wantSource: model.TargetsComputed},
+		{
+			name:       "set by active coach",
+			userID:     athlete,
+			override:   &model.NutritionTargetOverride{UserID: athlete, CoachID: coach, Calories: 2400},
+			profile:    profile,
+			wantSource: model.TargetsCoach,
+		},
+		{
+			name:       "set by former coach",
+			userID:     athlete,
+			override:   &model.NutritionTargetOverride{UserID: athlete, CoachID: exCoach, Calories: 1500},
+			profile:    profile,
+			wantSource: model.TargetsComputed,
+		},
+		{
+			name:       "former coach without a profile to fall back to",
+			userID:     athlete,
+			override:   &model.NutritionTargetOverride{UserID: athlete, CoachID: exCoach, Calories: 1500},
+			wantStatus: http.StatusBadRequest,
+		},
+		{name: "read by active coach", userID: coach, profile: profile, wantSource: model.TargetsComputed},
+		{name: "read by former coach", userID: exCoach, profile: profile, wantStatus: http.StatusForbidden},
+		{name: "read by another athlete", userID: other, profile: profile, wantStatus: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profiles := &fakeProfilesRepo{profiles: map[int]*model.UserProfile{}}
+			if tt.profile != nil {
+				profiles.profiles[athlete] = tt.profile
+			}
+			access := policy.NewReadAccess(&fakeCoachingRepo{active: map[[2]int]bool{{coach, athlete}: true}})
+			svc := qryNutrition.NewGetTargetsService(&fakeNutritionRepo{override: tt.override}, profiles, access)
+
+			got, err := svc.GetTargets(context.Background(), qryNutrition.GetTargetsQuery{UserID: tt.userID, OwnerID: athlete})
+			if tt.wantStatus == 0 {
+				if err != nil || got.Source != tt.wantSource {
+					t.Fatalf("GetTargets = %+v, %v; want %s targets", got, err, tt.wantSource)
+				}
+				return
+			}
+			var ue *utilsErrors.Error
+			if !errors.As(err, &ue) {
+				t.Fatalf("err = %v, want status %d", err, tt.wantStatus)
+			}
+			status := ue.Status
+			if status == 0 {
+				status = http.StatusBadRequest
+			}
+			if status != tt.wantStatus {
+				t.Errorf("status = %d, want %d", status, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package nutrition
+
+type GetTargetsQuery struct {
+	UserID  int
+	OwnerID int
+}
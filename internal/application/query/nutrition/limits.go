@@ -0,0 +1,22 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+// resolveLimits returns the user's own limits, or the defaults when they have
+// not set any.
+func resolveLimits(ctx context.Context, repo repository.NutritionRepository, userID int) (*model.NutritionLimits, error) {
+	l, err := repo.GetLimits(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if l == nil {
+		d := model.DefaultNutritionLimits(userID)
+		return &d, nil
+	}
+	return l, nil
+}
@@ -3,6 +3,7 @@ package nutrition
 import (
 	"context"
 
+	"github.com/msskobelina/fit-profi/internal/application/policy"
 	"github.com/msskobelina/fit-profi/internal/domain/model"
 	"github.com/msskobelina/fit-profi/internal/domain/repository"
 )
@@ -12,13 +13,21 @@ type ListEntriesHandler interface {
 }
 
 type listEntriesService struct {
-	repo repository.NutritionRepository
+	repo   repository.NutritionRepository
+	access policy.ReadAccess
 }
 
-func NewListEntriesService(repo repository.NutritionRepository) ListEntriesHandler {
-	return &listEntriesService{repo: repo}
+func NewListEntriesService(repo repository.NutritionRepository, access policy.ReadAccess) ListEntriesHandler {
+	return &listEntriesService{repo: repo, access: access}
 }
 
 func (s *listEntriesService) ListEntries(ctx context.Context, q ListEntriesQuery) ([]model.DiaryEntry, error) {
-	return s.repo.ListEntriesByDate(ctx, q.UserID, q.Date)
+	ownerID := q.OwnerID
+	if ownerID == 0 {
+		ownerID = q.UserID
+	}
+	if err := s.access.CanRead(ctx, q.UserID, ownerID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListEntriesByDate(ctx, ownerID, q.Date)
 }
@@ -3,6 +3,7 @@ package nutrition
 import "time"
 
 type ListEntriesQuery struct {
-	UserID int
-	Date   time.Time
+	UserID  int
+	OwnerID int
+	Date    time.Time
 }
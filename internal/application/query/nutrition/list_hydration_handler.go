@@ -0,0 +1,33 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type ListHydrationHandler interface {
+	ListHydration(context.Context, ListHydrationQuery) ([]model.HydrationEntry, error)
+}
+
+type listHydrationService struct {
+	repo   repository.NutritionRepository
+	access policy.ReadAccess
+}
+
+func NewListHydrationService(repo repository.NutritionRepository, access policy.ReadAccess) ListHydrationHandler {
+	return &listHydrationService{repo: repo, access: access}
+}
+
+func (s *listHydrationService) ListHydration(ctx context.Context, q ListHydrationQuery) ([]model.HydrationEntry, error) {
+	ownerID := q.OwnerID
+	if ownerID == 0 {
+		ownerID = q.UserID
+	}
+	if err := s.access.CanRead(ctx, q.UserID, ownerID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListHydrationEntries(ctx, ownerID, q.Date)
+}
@@ -0,0 +1,9 @@
+package nutrition
+
+import "time"
+
+type ListHydrationQuery struct {
+	UserID  int
+	OwnerID int
+	Date    time.Time
+}
@@ -0,0 +1,33 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type ListRecipesHandler interface {
+	ListRecipes(context.Context, ListRecipesQuery) ([]model.Recipe, error)
+}
+
+type listRecipesService struct {
+	repo   repository.RecipesRepository
+	access policy.ReadAccess
+}
+
+func NewListRecipesService(repo repository.RecipesRepository, access policy.ReadAccess) ListRecipesHandler {
+	return &listRecipesService{repo: repo, access: access}
+}
+
+func (s *listRecipesService) ListRecipes(ctx context.Context, q ListRecipesQuery) ([]model.Recipe, error) {
+	ownerID := q.OwnerID
+	if ownerID == 0 {
+		ownerID = q.UserID
+	}
+	if err := s.access.CanRead(ctx, q.UserID, ownerID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListRecipes(ctx, ownerID)
+}
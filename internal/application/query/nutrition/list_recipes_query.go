@@ -0,0 +1,6 @@
+package nutrition
+
+type ListRecipesQuery struct {
+	UserID  int
+	OwnerID int
+}
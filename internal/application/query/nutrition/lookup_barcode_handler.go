@@ -0,0 +1,33 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/pkg/barcode"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type LookupBarcodeHandler interface {
+	LookupBarcode(context.Context, LookupBarcodeQuery) (*LookupBarcodeResult, error)
+}
+
+type lookupBarcodeService struct {
+	foods repository.FoodsRepository
+}
+
+func NewLookupBarcodeService(foods repository.FoodsRepository) LookupBarcodeHandler {
+	return &lookupBarcodeService{foods: foods}
+}
+
+func (s *lookupBarcodeService) LookupBarcode(ctx context.Context, q LookupBarcodeQuery) (*LookupBarcodeResult, error) {
+	code, err := barcode.Normalize(q.Barcode)
+	if err != nil {
+		return nil, &utilsErrors.Error{Message: "Invalid barcode"}
+	}
+	f, err := s.foods.GetFoodByBarcode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	return &LookupBarcodeResult{Food: *f, Serving: f.Serving()}, nil
+}
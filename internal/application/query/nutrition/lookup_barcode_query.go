@@ -0,0 +1,14 @@
+package nutrition
+
+import "github.com/msskobelina/fit-profi/internal/domain/model"
+
+type LookupBarcodeQuery struct {
+	Barcode string
+}
+
+// LookupBarcodeResult is the catalog food and one serving of it, ready to
+// send back as a diary item.
+type LookupBarcodeResult struct {
+	Food    model.Food
+	Serving model.DiaryItem
+}
@@ -0,0 +1,30 @@
+package nutrition
+
+import (
+	"context"
+	"strings"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type SearchFoodsHandler interface {
+	SearchFoods(context.Context, SearchFoodsQuery) ([]model.Food, error)
+}
+
+type searchFoodsService struct {
+	foods repository.FoodsRepository
+}
+
+func NewSearchFoodsService(foods repository.FoodsRepository) SearchFoodsHandler {
+	return &searchFoodsService{foods: foods}
+}
+
+func (s *searchFoodsService) SearchFoods(ctx context.Context, q SearchFoodsQuery) ([]model.Food, error) {
+	query := strings.TrimSpace(q.Query)
+	if query == "" {
+		return nil, &utilsErrors.Error{Message: "Search query is required"}
+	}
+	return s.foods.SearchFoods(ctx, query, q.Limit)
+}
@@ -0,0 +1,6 @@
+package nutrition
+
+type SearchFoodsQuery struct {
+	Query string
+	Limit int
+}
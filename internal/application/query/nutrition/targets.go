@@ -0,0 +1,37 @@
+package nutrition
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+// resolveTargets prefers targets set by the user's coach and falls back to
+// computing them from the profile. Targets of a coach who no longer coaches
+// the user are ignored. It returns nil when neither is available.
+func resolveTargets(ctx context.Context, repo repository.NutritionRepository, access policy.ReadAccess, profile *model.UserProfile, userID int) (*model.NutritionTargets, error) {
+	o, err := repo.GetTargetOverride(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if o != nil {
+		err = access.CanRead(ctx, o.CoachID, userID)
+		if err == nil {
+			t := o.Targets()
+			return &t, nil
+		}
+		if !policy.IsDenied(err) {
+			return nil, err
+		}
+	}
+	if profile == nil {
+		return nil, nil
+	}
+	t, ok := model.ComputeTargets(*profile)
+	if !ok {
+		return nil, nil
+	}
+	return &t, nil
+}
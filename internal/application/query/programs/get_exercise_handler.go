@@ -0,0 +1,24 @@
+package programs
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type GetExerciseHandler interface {
+	GetExercise(context.Context, GetExerciseQuery) (*model.Exercise, error)
+}
+
+type getExerciseService struct {
+	exercises repository.ExercisesRepository
+}
+
+func NewGetExerciseService(exercises repository.ExercisesRepository) GetExerciseHandler {
+	return &getExerciseService{exercises: exercises}
+}
+
+func (s *getExerciseService) GetExercise(ctx context.Context, q GetExerciseQuery) (*model.Exercise, error) {
+	return s.exercises.GetExerciseByID(ctx, q.ExerciseID)
+}
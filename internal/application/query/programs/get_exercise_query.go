@@ -0,0 +1,5 @@
+package programs
+
+type GetExerciseQuery struct {
+	ExerciseID int
+}
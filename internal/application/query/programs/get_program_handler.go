@@ -2,9 +2,12 @@ package programs
 
 import (
 	"context"
+	"net/http"
 
+	"github.com/msskobelina/fit-profi/internal/application/policy"
 	"github.com/msskobelina/fit-profi/internal/domain/model"
 	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
 )
 
 type GetProgramHandler interface {
@@ -12,13 +15,27 @@ type GetProgramHandler interface {
 }
 
 type getProgramService struct {
-	repo repository.ProgramsRepository
+	repo   repository.ProgramsRepository
+	access policy.ReadAccess
 }
 
-func NewGetProgramService(repo repository.ProgramsRepository) GetProgramHandler {
-	return &getProgramService{repo: repo}
+func NewGetProgramService(repo repository.ProgramsRepository, access policy.ReadAccess) GetProgramHandler {
+	return &getProgramService{repo: repo, access: access}
 }
 
+// GetProgram returns a program its owner or the owner's active coach may read.
+// Having written the program gives no access of its own, so a coach loses it
+// with the relationship.
 func (s *getProgramService) GetProgram(ctx context.Context, q GetProgramQuery) (*model.TrainingProgram, error) {
-	return s.repo.GetProgramByID(ctx, q.ProgramID)
+	p, err := s.repo.GetProgramByID(ctx, q.ProgramID)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.access.CanRead(ctx, q.UserID, p.UserID); err != nil {
+		if policy.IsDenied(err) {
+			return nil, &utilsErrors.Error{Message: "Program not found", Status: http.StatusNotFound}
+		}
+		return nil, err
+	}
+	return p, nil
 }
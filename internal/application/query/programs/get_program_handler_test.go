@@ -0,0 +1,93 @@
+package programs_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	qryPrograms "github.com/msskobelina/fit-profi/internal/application/query/programs"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type fakeProgramsRepo struct {
+	repository.ProgramsRepository
+	programs map[int]*model.TrainingProgram
+	records  []model.PersonalRecord
+}
+
+func (f *fakeProgramsRepo) GetProgramByID(_ context.Context, id int) (*model.TrainingProgram, error) {
+	p, ok := f.programs[id]
+	if !ok {
+		return nil, &utilsErrors.Error{Message: "Program not found", Status: http.StatusNotFound}
+	}
+	return p, nil
+}
+
+func (f *fakeProgramsRepo) ListRecords(_ context.Context, userID, _ int, _ model.RecordKind, _, _ time.Time) ([]model.PersonalRecord, error) {
+	var res []model.PersonalRecord
+	for _, r := range f.records {
+		if r.UserID == userID {
+			res = append(res, r)
+		}
+	}
+	return res, nil
+}
+
+type fakeCoachingRepo struct {
+	repository.CoachingRepository
+	active map[[2]int]bool
+}
+
+func (f *fakeCoachingRepo) IsActiveCoach(_ context.Context, coachID, clientID int) (bool, error) {
+	return f.active[[2]int{coachID, clientID}], nil
+}
+
+func TestGetProgram_Access(t *testing.T) {
+	const (
+		athlete  = 20
+		other    = 21
+		coach    = 10
+		exCoach  = 11
+		program  = 1
+		assigned = 2
+	)
+	repo := &fakeProgramsRepo{programs: map[int]*model.TrainingProgram{
+		program:  {ID: program, UserID: athlete, AuthorID: athlete},
+		assigned: {ID: assigned, UserID: athlete, AuthorID: exCoach},
+	}}
+	access := policy.NewReadAccess(&fakeCoachingRepo{active: map[[2]int]bool{{coach, athlete}: true}})
+	svc := qryPrograms.NewGetProgramService(repo, access)
+
+	tests := []struct {
+		name       string
+		userID     int
+		programID  int
+		wantStatus int
+	}{
+		{name: "owner", userID: athlete, programID: program},
+		{name: "another athlete", userID: other, programID: program, wantStatus: http.StatusNotFound},
+		{name: "active coach", userID: coach, programID: assigned},
+		{name: "former coach who wrote it", userID: exCoach, programID: assigned, wantStatus: http.StatusNotFound},
+		{name: "unknown program", userID: athlete, programID: 99, wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := svc.GetProgram(context.Background(), qryPrograms.GetProgramQuery{UserID: tt.userID, ProgramID: tt.programID})
+			if tt.wantStatus == 0 {
+				if err != nil || p == nil || p.ID != tt.programID {
+					t.Fatalf("GetProgram = %+v, %v; want program %d", p, err, tt.programID)
+				}
+				return
+			}
+			var ue *utilsErrors.Error
+			if !errors.As(err, &ue) || ue.Status != tt.wantStatus {
+				t.Fatalf("err = %v, want status %d", err, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -2,4 +2,5 @@ package programs
 
 type GetProgramQuery struct {
 	ProgramID int
+	UserID    int
 }
@@ -0,0 +1,89 @@
+package programs
+
+import (
+	"context"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+const (
+	defaultAnalyticsPeriods = 12
+	maxAnalyticsRange       = 5 * 366 * 24 * time.Hour
+)
+
+type GetProgressAnalyticsHandler interface {
+	GetProgressAnalytics(context.Context, GetProgressAnalyticsQuery) (*model.ProgressAnalytics, error)
+}
+
+type getProgressAnalyticsService struct {
+	repo   repository.ProgramsRepository
+	access policy.ReadAccess
+}
+
+func NewGetProgressAnalyticsService(repo repository.ProgramsRepository, access policy.ReadAccess) GetProgressAnalyticsHandler {
+	return &getProgressAnalyticsService{repo: repo, access: access}
+}
+
+// GetProgressAnalytics widens From to the start of its period so the first
+// point covers a whole week or month.
+func (s *getProgressAnalyticsService) GetProgressAnalytics(ctx context.Context, q GetProgressAnalyticsQuery) (*model.ProgressAnalytics, error) {
+	ownerID := q.OwnerID
+	if ownerID == 0 {
+		ownerID = q.UserID
+	}
+	if err := s.access.CanRead(ctx, q.UserID, ownerID); err != nil {
+		return nil, err
+	}
+
+	period := q.Period
+	switch period {
+	case "":
+		period = model.PeriodWeek
+	case model.PeriodWeek, model.PeriodMonth:
+	default:
+		return nil, &utilsErrors.Error{Message: "period must be one of: week, month"}
+	}
+	formula := q.Formula
+	switch formula {
+	case "":
+		formula = model.FormulaEpley
+	case model.FormulaEpley, model.FormulaBrzycki:
+	default:
+		return nil, &utilsErrors.Error{Message: "formula must be one of: epley, brzycki"}
+	}
+	to := q.To
+	if to.IsZero() {
+		to = time.Now()
+	}
+	from := q.From
+	if from.IsZero() {
+		if period == model.PeriodMonth {
+			from = to.AddDate(0, -(defaultAnalyticsPeriods - 1), 0)
+		} else {
+			from = to.AddDate(0, 0, -7*(defaultAnalyticsPeriods-1))
+		}
+	}
+	from = period.Start(from)
+	if !from.Before(to) {
+		return nil, &utilsErrors.Error{Message: "from must be before to"}
+	}
+	if to.Sub(from) > maxAnalyticsRange {
+		return nil, &utilsErrors.Error{Message: "Analytics period must not exceed 5 years"}
+	}
+
+	sets, err := s.repo.ListPerformedSets(ctx, ownerID, q.ExerciseID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &model.ProgressAnalytics{
+		Period:    period,
+		Formula:   formula,
+		From:      from.Format("2006-01-02"),
+		To:        to.UTC().Format("2006-01-02"),
+		Exercises: model.BuildExerciseSeries(sets, period, formula),
+	}, nil
+}
@@ -0,0 +1,21 @@
+package programs
+
+import (
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// GetProgressAnalyticsQuery asks for per-exercise time series of OwnerID, or
+// of UserID when OwnerID is zero. Zero values default to weekly points with
+// the Epley formula over the last 12 periods; ExerciseID zero means every
+// exercise.
+type GetProgressAnalyticsQuery struct {
+	UserID     int
+	OwnerID    int
+	ExerciseID int
+	From       time.Time
+	To         time.Time
+	Period     model.AnalyticsPeriod
+	Formula    model.OneRMFormula
+}
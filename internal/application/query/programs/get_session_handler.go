@@ -0,0 +1,38 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type GetSessionHandler interface {
+	GetSession(context.Context, GetSessionQuery) (*model.WorkoutSession, error)
+}
+
+type getSessionService struct {
+	repo   repository.ProgramsRepository
+	access policy.ReadAccess
+}
+
+func NewGetSessionService(repo repository.ProgramsRepository, access policy.ReadAccess) GetSessionHandler {
+	return &getSessionService{repo: repo, access: access}
+}
+
+func (s *getSessionService) GetSession(ctx context.Context, q GetSessionQuery) (*model.WorkoutSession, error) {
+	sess, err := s.repo.GetSessionByID(ctx, q.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.access.CanRead(ctx, q.UserID, sess.UserID); err != nil {
+		if policy.IsDenied(err) {
+			return nil, &utilsErrors.Error{Message: "Workout session not found", Status: http.StatusNotFound}
+		}
+		return nil, err
+	}
+	return sess, nil
+}
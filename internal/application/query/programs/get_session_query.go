@@ -0,0 +1,6 @@
+package programs
+
+type GetSessionQuery struct {
+	SessionID int
+	UserID    int
+}
@@ -0,0 +1,47 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type ListProgramProgressHandler interface {
+	ListProgramProgress(context.Context, ListProgramProgressQuery) ([]model.ExerciseProgress, error)
+}
+
+type listProgramProgressService struct {
+	repo   repository.ProgramsRepository
+	access policy.ReadAccess
+}
+
+func NewListProgramProgressService(repo repository.ProgramsRepository, access policy.ReadAccess) ListProgramProgressHandler {
+	return &listProgramProgressService{repo: repo, access: access}
+}
+
+// ListProgramProgress returns the progress logged against a program. The owner
+// of a program they wrote also sees the progress of the athletes it was
+// assigned to, for as long as they coach them. Anyone else needs read access
+// to the program's owner.
+func (s *listProgramProgressService) ListProgramProgress(ctx context.Context, q ListProgramProgressQuery) ([]model.ExerciseProgress, error) {
+	p, err := s.repo.GetProgramByID(ctx, q.ProgramID)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.UserID == q.UserID && p.AuthorID == q.UserID && p.AssignedFromID == nil {
+		return s.repo.ListProgressByProgram(ctx, p.ID, q.UserID)
+	}
+	if err = s.access.CanRead(ctx, q.UserID, p.UserID); err != nil {
+		if policy.IsDenied(err) {
+			return nil, &utilsErrors.Error{Message: "Program not found", Status: http.StatusNotFound}
+		}
+		return nil, err
+	}
+
+	return s.repo.ListProgressByProgram(ctx, p.ID, 0)
+}
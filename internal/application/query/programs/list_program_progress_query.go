@@ -0,0 +1,6 @@
+package programs
+
+type ListProgramProgressQuery struct {
+	ProgramID int
+	UserID    int
+}
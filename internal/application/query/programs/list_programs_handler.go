@@ -3,6 +3,7 @@ package programs
 import (
 	"context"
 
+	"github.com/msskobelina/fit-profi/internal/application/policy"
 	"github.com/msskobelina/fit-profi/internal/domain/model"
 	"github.com/msskobelina/fit-profi/internal/domain/repository"
 )
@@ -12,13 +13,21 @@ type ListProgramsHandler interface {
 }
 
 type listProgramsService struct {
-	repo repository.ProgramsRepository
+	repo   repository.ProgramsRepository
+	access policy.ReadAccess
 }
 
-func NewListProgramsService(repo repository.ProgramsRepository) ListProgramsHandler {
-	return &listProgramsService{repo: repo}
+func NewListProgramsService(repo repository.ProgramsRepository, access policy.ReadAccess) ListProgramsHandler {
+	return &listProgramsService{repo: repo, access: access}
 }
 
 func (s *listProgramsService) ListPrograms(ctx context.Context, q ListProgramsQuery) ([]model.TrainingProgram, error) {
-	return s.repo.ListProgramsByUserID(ctx, q.UserID)
+	ownerID := q.OwnerID
+	if ownerID == 0 {
+		ownerID = q.UserID
+	}
+	if err := s.access.CanRead(ctx, q.UserID, ownerID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListProgramsByUserID(ctx, ownerID)
 }
@@ -1,5 +1,6 @@
 package programs
 
 type ListProgramsQuery struct {
-	UserID int
+	UserID  int
+	OwnerID int
 }
@@ -0,0 +1,37 @@
+package programs
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type ListProgressHandler interface {
+	ListProgress(context.Context, ListProgressQuery) ([]model.ExerciseProgress, error)
+}
+
+type listProgressService struct {
+	repo   repository.ProgramsRepository
+	access policy.ReadAccess
+}
+
+func NewListProgressService(repo repository.ProgramsRepository, access policy.ReadAccess) ListProgressHandler {
+	return &listProgressService{repo: repo, access: access}
+}
+
+func (s *listProgressService) ListProgress(ctx context.Context, q ListProgressQuery) ([]model.ExerciseProgress, error) {
+	ownerID := q.OwnerID
+	if ownerID == 0 {
+		ownerID = q.UserID
+	}
+	if err := s.access.CanRead(ctx, q.UserID, ownerID); err != nil {
+		return nil, err
+	}
+	if !q.From.IsZero() && !q.To.IsZero() && !q.From.Before(q.To) {
+		return nil, &utilsErrors.Error{Message: "from must be before to"}
+	}
+	return s.repo.ListProgress(ctx, ownerID, q.ExerciseID, q.From, q.To)
+}
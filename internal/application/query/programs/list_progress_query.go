@@ -0,0 +1,13 @@
+package programs
+
+import "time"
+
+// ListProgressQuery filters the progress of OwnerID, or of UserID when
+// OwnerID is zero. Zero filters match everything.
+type ListProgressQuery struct {
+	UserID     int
+	OwnerID    int
+	ExerciseID int
+	From       time.Time
+	To         time.Time
+}
@@ -0,0 +1,42 @@
+package programs
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type ListRecordsHandler interface {
+	ListRecords(context.Context, ListRecordsQuery) ([]model.PersonalRecord, error)
+}
+
+type listRecordsService struct {
+	repo   repository.ProgramsRepository
+	access policy.ReadAccess
+}
+
+func NewListRecordsService(repo repository.ProgramsRepository, access policy.ReadAccess) ListRecordsHandler {
+	return &listRecordsService{repo: repo, access: access}
+}
+
+func (s *listRecordsService) ListRecords(ctx context.Context, q ListRecordsQuery) ([]model.PersonalRecord, error) {
+	ownerID := q.OwnerID
+	if ownerID == 0 {
+		ownerID = q.UserID
+	}
+	if err := s.access.CanRead(ctx, q.UserID, ownerID); err != nil {
+		return nil, err
+	}
+	switch q.Kind {
+	case "", model.RecordHeaviestWeight, model.RecordMostReps, model.RecordBestOneRM, model.RecordSessionVolume:
+	default:
+		return nil, &utilsErrors.Error{Message: "kind must be one of: heaviest_weight, most_reps, best_e1rm, session_volume"}
+	}
+	if !q.From.IsZero() && !q.To.IsZero() && !q.From.Before(q.To) {
+		return nil, &utilsErrors.Error{Message: "from must be before to"}
+	}
+	return s.repo.ListRecords(ctx, ownerID, q.ExerciseID, q.Kind, q.From, q.To)
+}
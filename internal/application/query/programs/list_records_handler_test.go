@@ -0,0 +1,69 @@
+package programs_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	qryPrograms "github.com/msskobelina/fit-profi/internal/application/query/programs"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+func TestListRecords(t *testing.T) {
+	const (
+		athlete = 20
+		other   = 21
+		coach   = 10
+		exCoach = 11
+	)
+	repo := &fakeProgramsRepo{records: []model.PersonalRecord{
+		{UserID: athlete, ExerciseID: 5, Kind: model.RecordHeaviestWeight, Value: 100},
+		{UserID: other, ExerciseID: 6, Kind: model.RecordMostReps, Value: 12},
+	}}
+	access := policy.NewReadAccess(&fakeCoachingRepo{active: map[[2]int]bool{{coach, athlete}: true}})
+	svc := qryPrograms.NewListRecordsService(repo, access)
+
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		q          qryPrograms.ListRecordsQuery
+		wantStatus int
+	}{
+		{name: "own records", q: qryPrograms.ListRecordsQuery{UserID: athlete}},
+		{name: "own records by owner", q: qryPrograms.ListRecordsQuery{UserID: athlete, OwnerID: athlete}},
+		{name: "active coach", q: qryPrograms.ListRecordsQuery{UserID: coach, OwnerID: athlete}},
+		{name: "former coach", q: qryPrograms.ListRecordsQuery{UserID: exCoach, OwnerID: athlete}, wantStatus: http.StatusForbidden},
+		{name: "another athlete", q: qryPrograms.ListRecordsQuery{UserID: other, OwnerID: athlete}, wantStatus: http.StatusForbidden},
+		{name: "known kind", q: qryPrograms.ListRecordsQuery{UserID: athlete, Kind: model.RecordBestOneRM}},
+		{name: "unknown kind", q: qryPrograms.ListRecordsQuery{UserID: athlete, Kind: "fastest"}, wantStatus: http.StatusBadRequest},
+		{name: "range", q: qryPrograms.ListRecordsQuery{UserID: athlete, From: day, To: day.AddDate(0, 1, 0)}},
+		{name: "empty range", q: qryPrograms.ListRecordsQuery{UserID: athlete, From: day, To: day}, wantStatus: http.StatusBadRequest},
+		{name: "reversed range", q: qryPrograms.ListRecordsQuery{UserID: athlete, From: day, To: day.AddDate(0, 0, -1)}, wantStatus: http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, err := svc.ListRecords(context.Background(), tt.q)
+			if tt.wantStatus == 0 {
+				if err != nil || len(records) != 1 || records[0].UserID != athlete {
+					t.Fatalf("ListRecords = %+v, %v; want the athlete's record", records, err)
+				}
+				return
+			}
+			var ue *utilsErrors.Error
+			if !errors.As(err, &ue) {
+				t.Fatalf("err = %v, want status %d", err, tt.wantStatus)
+			}
+			status := ue.Status
+			if status == 0 {
+				status = http.StatusBadRequest
+			}
+			if status != tt.wantStatus {
+				t.Errorf("status = %d, want %d", status, tt.wantStatus)
+			}
+		})
+	}
+}
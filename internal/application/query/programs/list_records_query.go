@@ -0,0 +1,18 @@
+package programs
+
+import (
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// ListRecordsQuery filters the personal records of OwnerID, or of UserID when
+// OwnerID is zero. Zero filters match everything.
+type ListRecordsQuery struct {
+	UserID     int
+	OwnerID    int
+	ExerciseID int
+	Kind       model.RecordKind
+	From       time.Time
+	To         time.Time
+}
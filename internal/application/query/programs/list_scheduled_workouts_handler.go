@@ -0,0 +1,36 @@
+package programs
+
+import (
+	"context"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+// defaultScheduleWindow bounds the listing when no end date is given.
+const defaultScheduleWindow = 30 * 24 * time.Hour
+
+type ListScheduledWorkoutsHandler interface {
+	ListScheduledWorkouts(ctx context.Context, q ListScheduledWorkoutsQuery) ([]model.ScheduledWorkout, error)
+}
+
+type listScheduledWorkoutsService struct {
+	repo repository.ProgramsRepository
+}
+
+func NewListScheduledWorkoutsService(repo repository.ProgramsRepository) ListScheduledWorkoutsHandler {
+	return &listScheduledWorkoutsService{repo: repo}
+}
+
+func (s *listScheduledWorkoutsService) ListScheduledWorkouts(ctx context.Context, q ListScheduledWorkoutsQuery) ([]model.ScheduledWorkout, error) {
+	from := q.From
+	if from.IsZero() {
+		from = time.Now()
+	}
+	to := q.To
+	if to.IsZero() {
+		to = from.Add(defaultScheduleWindow)
+	}
+	return s.repo.ListScheduledWorkouts(ctx, q.UserID, from, to)
+}
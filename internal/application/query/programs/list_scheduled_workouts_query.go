@@ -0,0 +1,9 @@
+package programs
+
+import "time"
+
+type ListScheduledWorkoutsQuery struct {
+	UserID int
+	From   time.Time
+	To     time.Time
+}
@@ -0,0 +1,42 @@
+package programs
+
+import (
+	"context"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+type ListSessionsHandler interface {
+	ListSessions(context.Context, ListSessionsQuery) ([]model.WorkoutSession, error)
+}
+
+type listSessionsService struct {
+	repo   repository.ProgramsRepository
+	access policy.ReadAccess
+}
+
+func NewListSessionsService(repo repository.ProgramsRepository, access policy.ReadAccess) ListSessionsHandler {
+	return &listSessionsService{repo: repo, access: access}
+}
+
+func (s *listSessionsService) ListSessions(ctx context.Context, q ListSessionsQuery) ([]model.WorkoutSession, error) {
+	ownerID := q.OwnerID
+	if ownerID == 0 {
+		ownerID = q.UserID
+	}
+	if err := s.access.CanRead(ctx, q.UserID, ownerID); err != nil {
+		return nil, err
+	}
+	to := q.To
+	if to.IsZero() {
+		to = time.Now()
+	}
+	from := q.From
+	if from.IsZero() {
+		from = to.Add(-defaultScheduleWindow)
+	}
+	return s.repo.ListSessions(ctx, ownerID, from, to)
+}
@@ -0,0 +1,12 @@
+package programs
+
+import "time"
+
+// ListSessionsQuery lists the sessions of OwnerID, or of UserID when OwnerID
+// is zero, started in [From, To). Zero bounds default to the last 30 days.
+type ListSessionsQuery struct {
+	UserID  int
+	OwnerID int
+	From    time.Time
+	To      time.Time
+}
@@ -0,0 +1,40 @@
+package programs
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type SearchExercisesHandler interface {
+	SearchExercises(context.Context, SearchExercisesQuery) ([]model.Exercise, error)
+}
+
+type searchExercisesService struct {
+	exercises repository.ExercisesRepository
+}
+
+func NewSearchExercisesService(exercises repository.ExercisesRepository) SearchExercisesHandler {
+	return &searchExercisesService{exercises: exercises}
+}
+
+func (s *searchExercisesService) SearchExercises(ctx context.Context, q SearchExercisesQuery) ([]model.Exercise, error) {
+	if q.Muscle != "" && !q.Muscle.Valid() {
+		return nil, &utilsErrors.Error{Message: "Unknown muscle group"}
+	}
+	if q.Equipment != "" && !q.Equipment.Valid() {
+		return nil, &utilsErrors.Error{Message: "Unknown equipment"}
+	}
+	if q.Pattern != "" && !q.Pattern.Valid() {
+		return nil, &utilsErrors.Error{Message: "Unknown movement pattern"}
+	}
+	return s.exercises.SearchExercises(ctx, repository.ExerciseFilter{
+		Query:     q.Query,
+		Muscle:    q.Muscle,
+		Equipment: q.Equipment,
+		Pattern:   q.Pattern,
+		Limit:     q.Limit,
+	})
+}
@@ -0,0 +1,13 @@
+package programs
+
+import "github.com/msskobelina/fit-profi/internal/domain/model"
+
+// SearchExercisesQuery searches the exercise catalog. Zero fields do not
+// filter.
+type SearchExercisesQuery struct {
+	Query     string
+	Muscle    model.MuscleGroup
+	Equipment model.Equipment
+	Pattern   model.MovementPattern
+	Limit     int
+}
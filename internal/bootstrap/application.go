@@ -3,14 +3,20 @@ package bootstrap
 import (
 	"context"
 
+	cmdAdmin "github.com/msskobelina/fit-profi/internal/application/command/admin"
 	cmdAuthorize "github.com/msskobelina/fit-profi/internal/application/command/authorize"
 	cmdCalendar "github.com/msskobelina/fit-profi/internal/application/command/calendar"
+	cmdCoaching "github.com/msskobelina/fit-profi/internal/application/command/coaching"
 	cmdIntegrations "github.com/msskobelina/fit-profi/internal/application/command/integrations"
 	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
 	cmdProfiles "github.com/msskobelina/fit-profi/internal/application/command/profiles"
 	cmdPrograms "github.com/msskobelina/fit-profi/internal/application/command/programs"
+	qryAdmin "github.com/msskobelina/fit-profi/internal/application/query/admin"
 	qryAuthorize "github.com/msskobelina/fit-profi/internal/application/query/authorize"
 	qryCalendar "github.com/msskobelina/fit-profi/internal/application/query/calendar"
+	qryCardio "github.com/msskobelina/fit-profi/internal/application/query/cardio"
+	qryCoaching "github.com/msskobelina/fit-profi/internal/application/query/coaching"
+	qryIntegrations "github.com/msskobelina/fit-profi/internal/application/query/integrations"
 	qryNutrition "github.com/msskobelina/fit-profi/internal/application/query/nutrition"
 	qryProfiles "github.com/msskobelina/fit-profi/internal/application/query/profiles"
 	qryPrograms "github.com/msskobelina/fit-profi/internal/application/query/programs"
@@ -24,7 +30,17 @@ type application struct {
 	logoutUser     cmdAuthorize.LogoutUserHandler
 	sendResetEmail cmdAuthorize.SendResetEmailHandler
 	resetPassword  cmdAuthorize.ResetPasswordHandler
+	refreshToken   cmdAuthorize.RefreshTokenHandler
 	verifyToken    qryAuthorize.VerifyTokenHandler
+	// admin
+	listUsers             qryAdmin.ListUsersHandler
+	changeUserRole        cmdAdmin.ChangeUserRoleHandler
+	setUserStatus         cmdAdmin.SetUserStatusHandler
+	deleteUser            cmdAdmin.DeleteUserHandler
+	listFoodSubmissions   qryAdmin.ListFoodSubmissionsHandler
+	approveFoodSubmission cmdAdmin.ApproveFoodSubmissionHandler
+	rejectFoodSubmission  cmdAdmin.RejectFoodSubmissionHandler
+	mergeExercises        cmdAdmin.MergeExercisesHandler
 	// profiles
 	createUserProfile  cmdProfiles.CreateUserProfileHandler
 	updateUserProfile  cmdProfiles.UpdateUserProfileHandler
@@ -32,24 +48,88 @@ type application struct {
 	createCoachProfile cmdProfiles.CreateCoachProfileHandler
 	updateCoachProfile cmdProfiles.UpdateCoachProfileHandler
 	getCoachProfile    qryProfiles.GetCoachProfileHandler
+	// coaching
+	inviteClient          cmdCoaching.InviteClientHandler
+	respondInvitation     cmdCoaching.RespondInvitationHandler
+	terminateRelationship cmdCoaching.TerminateRelationshipHandler
+	listInvitations       qryCoaching.ListInvitationsHandler
+	listClients           qryCoaching.ListClientsHandler
+	getCoach              qryCoaching.GetCoachHandler
 	// programs
-	createProgram cmdPrograms.CreateProgramHandler
-	deleteProgram cmdPrograms.DeleteProgramHandler
-	trackProgress cmdPrograms.TrackProgressHandler
-	getProgram    qryPrograms.GetProgramHandler
-	listPrograms  qryPrograms.ListProgramsHandler
+	createProgram         cmdPrograms.CreateProgramHandler
+	deleteProgram         cmdPrograms.DeleteProgramHandler
+	trackProgress         cmdPrograms.TrackProgressHandler
+	assignProgram         cmdPrograms.AssignProgramHandler
+	getProgram            qryPrograms.GetProgramHandler
+	listPrograms          qryPrograms.ListProgramsHandler
+	listProgramProgress   qryPrograms.ListProgramProgressHandler
+	scheduleWorkout       cmdPrograms.ScheduleWorkoutHandler
+	rescheduleWorkout     cmdPrograms.RescheduleWorkoutHandler
+	unscheduleWorkout     cmdPrograms.UnscheduleWorkoutHandler
+	listScheduledWorkouts qryPrograms.ListScheduledWorkoutsHandler
+	startSession          cmdPrograms.StartSessionHandler
+	finishSession         cmdPrograms.FinishSessionHandler
+	abandonSession        cmdPrograms.AbandonSessionHandler
+	getSession            qryPrograms.GetSessionHandler
+	listSessions          qryPrograms.ListSessionsHandler
+	listProgress          qryPrograms.ListProgressHandler
+	getProgressAnalytics  qryPrograms.GetProgressAnalyticsHandler
+	listRecords           qryPrograms.ListRecordsHandler
+	searchExercises       qryPrograms.SearchExercisesHandler
+	getExercise           qryPrograms.GetExerciseHandler
 	// nutrition
-	createEntry cmdNutrition.CreateEntryHandler
-	updateEntry cmdNutrition.UpdateEntryHandler
-	deleteEntry cmdNutrition.DeleteEntryHandler
-	listEntries qryNutrition.ListEntriesHandler
-	getEntry    qryNutrition.GetEntryHandler
+	createEntry     cmdNutrition.CreateEntryHandler
+	updateEntry     cmdNutrition.UpdateEntryHandler
+	deleteEntry     cmdNutrition.DeleteEntryHandler
+	addItem         cmdNutrition.AddItemHandler
+	updateItem      cmdNutrition.UpdateItemHandler
+	deleteItem      cmdNutrition.DeleteItemHandler
+	copyEntries     cmdNutrition.CopyEntriesHandler
+	listEntries     qryNutrition.ListEntriesHandler
+	getEntry        qryNutrition.GetEntryHandler
+	getSummary      qryNutrition.GetSummaryHandler
+	getTargets      qryNutrition.GetTargetsHandler
+	setTargets      cmdNutrition.SetTargetsHandler
+	clearTargets    cmdNutrition.ClearTargetsHandler
+	getLimits       qryNutrition.GetLimitsHandler
+	setLimits       cmdNutrition.SetLimitsHandler
+	logHydration    cmdNutrition.LogHydrationHandler
+	listHydration   qryNutrition.ListHydrationHandler
+	deleteHydration cmdNutrition.DeleteHydrationHandler
+	searchFoods     qryNutrition.SearchFoodsHandler
+	lookupBarcode   qryNutrition.LookupBarcodeHandler
+	submitFood      cmdNutrition.SubmitFoodHandler
+	createRecipe    cmdNutrition.CreateRecipeHandler
+	updateRecipe    cmdNutrition.UpdateRecipeHandler
+	deleteRecipe    cmdNutrition.DeleteRecipeHandler
+	shareRecipe     cmdNutrition.ShareRecipeHandler
+	logRecipe       cmdNutrition.LogRecipeHandler
+	listRecipes     qryNutrition.ListRecipesHandler
+	getRecipe       qryNutrition.GetRecipeHandler
 	// integrations
-	connectGoogle    cmdIntegrations.ConnectGoogleHandler
-	exchangeCallback cmdIntegrations.ExchangeCallbackHandler
+	connect              cmdIntegrations.ConnectHandler
+	exchangeCallback     cmdIntegrations.ExchangeCallbackHandler
+	listIntegrations     qryIntegrations.ListIntegrationsHandler
+	disconnect           cmdIntegrations.DisconnectHandler
+	selectGoogleCalendar cmdIntegrations.SelectGoogleCalendarHandler
+	importStrava         cmdIntegrations.ImportStravaHandler
+	// cardio
+	listCardioSessions qryCardio.ListCardioSessionsHandler
 	// calendar
-	listCalendars qryCalendar.ListCalendarsHandler
-	createEvent   cmdCalendar.CreateEventHandler
+	listCalendars               qryCalendar.ListCalendarsHandler
+	createEvent                 cmdCalendar.CreateEventHandler
+	addAvailability             cmdCalendar.AddAvailabilityHandler
+	listAvailability            qryCalendar.ListAvailabilityHandler
+	bookSession                 cmdCalendar.BookSessionHandler
+	cancelBooking               cmdCalendar.CancelBookingHandler
+	rescheduleBooking           cmdCalendar.RescheduleBookingHandler
+	listUpcomingSessions        qryCalendar.ListUpcomingSessionsHandler
+	createAvailabilityRule      cmdCalendar.CreateAvailabilityRuleHandler
+	listAvailabilityRules       qryCalendar.ListAvailabilityRulesHandler
+	deleteAvailabilityRule      cmdCalendar.DeleteAvailabilityRuleHandler
+	addAvailabilityException    cmdCalendar.AddAvailabilityExceptionHandler
+	listAvailabilityExceptions  qryCalendar.ListAvailabilityExceptionsHandler
+	deleteAvailabilityException cmdCalendar.DeleteAvailabilityExceptionHandler
 }
 
 // authorize
@@ -74,10 +154,48 @@ func (a *application) ResetPassword(ctx context.Context, cmd cmdAuthorize.ResetP
 	return a.resetPassword.ResetPassword(ctx, cmd)
 }
 
+func (a *application) Refresh(ctx context.Context, cmd cmdAuthorize.RefreshTokenCommand) (*cmdAuthorize.RefreshTokenResult, error) {
+	return a.refreshToken.Refresh(ctx, cmd)
+}
+
 func (a *application) VerifyToken(ctx context.Context, q qryAuthorize.VerifyTokenQuery) (*qryAuthorize.VerifyTokenResult, error) {
 	return a.verifyToken.VerifyToken(ctx, q)
 }
 
+// admin
+
+func (a *application) ListUsers(ctx context.Context, q qryAdmin.ListUsersQuery) (*qryAdmin.ListUsersResult, error) {
+	return a.listUsers.ListUsers(ctx, q)
+}
+
+func (a *application) ChangeUserRole(ctx context.Context, cmd cmdAdmin.ChangeUserRoleCommand) error {
+	return a.changeUserRole.ChangeUserRole(ctx, cmd)
+}
+
+func (a *application) SetUserStatus(ctx context.Context, cmd cmdAdmin.SetUserStatusCommand) error {
+	return a.setUserStatus.SetUserStatus(ctx, cmd)
+}
+
+func (a *application) DeleteUser(ctx context.Context, cmd cmdAdmin.DeleteUserCommand) error {
+	return a.deleteUser.DeleteUser(ctx, cmd)
+}
+
+func (a *application) ListFoodSubmissions(ctx context.Context, q qryAdmin.ListFoodSubmissionsQuery) (*qryAdmin.ListFoodSubmissionsResult, error) {
+	return a.listFoodSubmissions.ListFoodSubmissions(ctx, q)
+}
+
+func (a *application) ApproveFoodSubmission(ctx context.Context, cmd cmdAdmin.ApproveFoodSubmissionCommand) (*model.FoodSubmission, error) {
+	return a.approveFoodSubmission.ApproveFoodSubmission(ctx, cmd)
+}
+
+func (a *application) RejectFoodSubmission(ctx context.Context, cmd cmdAdmin.RejectFoodSubmissionCommand) (*model.FoodSubmission, error) {
+	return a.rejectFoodSubmission.RejectFoodSubmission(ctx, cmd)
+}
+
+func (a *application) MergeExercises(ctx context.Context, cmd cmdAdmin.MergeExercisesCommand) (*model.Exercise, error) {
+	return a.mergeExercises.MergeExercises(ctx, cmd)
+}
+
 // profiles
 
 func (a *application) CreateUserProfile(ctx context.Context, cmd cmdProfiles.CreateUserProfileCommand) (*model.UserProfile, error) {
@@ -104,6 +222,32 @@ func (a *application) GetCoachProfile(ctx context.Context, q qryProfiles.GetCoac
 	return a.getCoachProfile.GetCoachProfile(ctx, q)
 }
 
+// coaching
+
+func (a *application) InviteClient(ctx context.Context, cmd cmdCoaching.InviteClientCommand) (*model.CoachingRelationship, error) {
+	return a.inviteClient.InviteClient(ctx, cmd)
+}
+
+func (a *application) RespondInvitation(ctx context.Context, cmd cmdCoaching.RespondInvitationCommand) (*model.CoachingRelationship, error) {
+	return a.respondInvitation.RespondInvitation(ctx, cmd)
+}
+
+func (a *application) TerminateRelationship(ctx context.Context, cmd cmdCoaching.TerminateRelationshipCommand) (*model.CoachingRelationship, error) {
+	return a.terminateRelationship.TerminateRelationship(ctx, cmd)
+}
+
+func (a *application) ListInvitations(ctx context.Context, q qryCoaching.ListInvitationsQuery) ([]model.CoachingRelationship, error) {
+	return a.listInvitations.ListInvitations(ctx, q)
+}
+
+func (a *application) ListClients(ctx context.Context, q qryCoaching.ListClientsQuery) ([]model.CoachingRelationship, error) {
+	return a.listClients.ListClients(ctx, q)
+}
+
+func (a *application) GetCoach(ctx context.Context, q qryCoaching.GetCoachQuery) (*model.CoachingRelationship, error) {
+	return a.getCoach.GetCoach(ctx, q)
+}
+
 // programs
 
 func (a *application) CreateProgram(ctx context.Context, cmd cmdPrograms.CreateProgramCommand) (*model.TrainingProgram, error) {
@@ -118,6 +262,10 @@ func (a *application) TrackProgress(ctx context.Context, cmd cmdPrograms.TrackPr
 	return a.trackProgress.TrackProgress(ctx, cmd)
 }
 
+func (a *application) AssignProgram(ctx context.Context, cmd cmdPrograms.AssignProgramCommand) ([]model.TrainingProgram, error) {
+	return a.assignProgram.AssignProgram(ctx, cmd)
+}
+
 func (a *application) GetProgram(ctx context.Context, q qryPrograms.GetProgramQuery) (*model.TrainingProgram, error) {
 	return a.getProgram.GetProgram(ctx, q)
 }
@@ -126,6 +274,66 @@ func (a *application) ListPrograms(ctx context.Context, q qryPrograms.ListProgra
 	return a.listPrograms.ListPrograms(ctx, q)
 }
 
+func (a *application) ListProgramProgress(ctx context.Context, q qryPrograms.ListProgramProgressQuery) ([]model.ExerciseProgress, error) {
+	return a.listProgramProgress.ListProgramProgress(ctx, q)
+}
+
+func (a *application) ScheduleWorkout(ctx context.Context, cmd cmdPrograms.ScheduleWorkoutCommand) (*model.ScheduledWorkout, error) {
+	return a.scheduleWorkout.ScheduleWorkout(ctx, cmd)
+}
+
+func (a *application) RescheduleWorkout(ctx context.Context, cmd cmdPrograms.RescheduleWorkoutCommand) (*model.ScheduledWorkout, error) {
+	return a.rescheduleWorkout.RescheduleWorkout(ctx, cmd)
+}
+
+func (a *application) UnscheduleWorkout(ctx context.Context, cmd cmdPrograms.UnscheduleWorkoutCommand) error {
+	return a.unscheduleWorkout.UnscheduleWorkout(ctx, cmd)
+}
+
+func (a *application) ListScheduledWorkouts(ctx context.Context, q qryPrograms.ListScheduledWorkoutsQuery) ([]model.ScheduledWorkout, error) {
+	return a.listScheduledWorkouts.ListScheduledWorkouts(ctx, q)
+}
+
+func (a *application) StartSession(ctx context.Context, cmd cmdPrograms.StartSessionCommand) (*model.WorkoutSession, error) {
+	return a.startSession.StartSession(ctx, cmd)
+}
+
+func (a *application) FinishSession(ctx context.Context, cmd cmdPrograms.FinishSessionCommand) (*model.WorkoutSession, error) {
+	return a.finishSession.FinishSession(ctx, cmd)
+}
+
+func (a *application) AbandonSession(ctx context.Context, cmd cmdPrograms.AbandonSessionCommand) (*model.WorkoutSession, error) {
+	return a.abandonSession.AbandonSession(ctx, cmd)
+}
+
+func (a *application) GetSession(ctx context.Context, q qryPrograms.GetSessionQuery) (*model.WorkoutSession, error) {
+	return a.getSession.GetSession(ctx, q)
+}
+
+func (a *application) ListSessions(ctx context.Context, q qryPrograms.ListSessionsQuery) ([]model.WorkoutSession, error) {
+	return a.listSessions.ListSessions(ctx, q)
+}
+
+func (a *application) ListProgress(ctx context.Context, q qryPrograms.ListProgressQuery) ([]model.ExerciseProgress, error) {
+	return a.listProgress.ListProgress(ctx, q)
+}
+
+func (a *application) GetProgressAnalytics(ctx context.Context, q qryPrograms.GetProgressAnalyticsQuery) (*model.ProgressAnalytics, error) {
+	return a.getProgressAnalytics.GetProgressAnalytics(ctx, q)
+}
+
+func (a *application) ListRecords(ctx context.Context, q qryPrograms.ListRecordsQuery) ([]model.PersonalRecord, error) {
+	return a.listRecords.ListRecords(ctx, q)
+}
+
+func (a *application) SearchExercises(ctx context.Context, q qryPrograms.SearchExercisesQuery) ([]model.Exercise, error) {
+	return a.searchExercises.SearchExercises(ctx, q)
+}
+
+func (a *application) GetExercise(ctx context.Context, q qryPrograms.GetExerciseQuery) (*model.Exercise, error) {
+	return a.getExercise.GetExercise(ctx, q)
+}
+
 // nutrition
 
 func (a *application) CreateEntry(ctx context.Context, cmd cmdNutrition.CreateEntryCommand) (*model.DiaryEntry, error) {
@@ -140,6 +348,22 @@ func (a *application) DeleteEntry(ctx context.Context, cmd cmdNutrition.DeleteEn
 	return a.deleteEntry.DeleteEntry(ctx, cmd)
 }
 
+func (a *application) AddItem(ctx context.Context, cmd cmdNutrition.AddItemCommand) (*model.DiaryEntry, error) {
+	return a.addItem.AddItem(ctx, cmd)
+}
+
+func (a *application) UpdateItem(ctx context.Context, cmd cmdNutrition.UpdateItemCommand) (*model.DiaryEntry, error) {
+	return a.updateItem.UpdateItem(ctx, cmd)
+}
+
+func (a *application) DeleteItem(ctx context.Context, cmd cmdNutrition.DeleteItemCommand) (*model.DiaryEntry, error) {
+	return a.deleteItem.DeleteItem(ctx, cmd)
+}
+
+func (a *application) CopyEntries(ctx context.Context, cmd cmdNutrition.CopyEntriesCommand) ([]model.DiaryEntry, error) {
+	return a.copyEntries.CopyEntries(ctx, cmd)
+}
+
 func (a *application) ListEntries(ctx context.Context, q qryNutrition.ListEntriesQuery) ([]model.DiaryEntry, error) {
 	return a.listEntries.ListEntries(ctx, q)
 }
@@ -148,16 +372,114 @@ func (a *application) GetEntry(ctx context.Context, q qryNutrition.GetEntryQuery
 	return a.getEntry.GetEntry(ctx, q)
 }
 
+func (a *application) GetSummary(ctx context.Context, q qryNutrition.GetSummaryQuery) (*model.NutritionSummary, error) {
+	return a.getSummary.GetSummary(ctx, q)
+}
+
+func (a *application) GetTargets(ctx context.Context, q qryNutrition.GetTargetsQuery) (*model.NutritionTargets, error) {
+	return a.getTargets.GetTargets(ctx, q)
+}
+
+func (a *application) SetTargets(ctx context.Context, cmd cmdNutrition.SetTargetsCommand) (*model.NutritionTargets, error) {
+	return a.setTargets.SetTargets(ctx, cmd)
+}
+
+func (a *application) GetLimits(ctx context.Context, q qryNutrition.GetLimitsQuery) (*model.NutritionLimits, error) {
+	return a.getLimits.GetLimits(ctx, q)
+}
+
+func (a *application) SetLimits(ctx context.Context, cmd cmdNutrition.SetLimitsCommand) (*model.NutritionLimits, error) {
+	return a.setLimits.SetLimits(ctx, cmd)
+}
+
+func (a *application) LogHydration(ctx context.Context, cmd cmdNutrition.LogHydrationCommand) (*model.HydrationEntry, error) {
+	return a.logHydration.LogHydration(ctx, cmd)
+}
+
+func (a *application) ListHydration(ctx context.Context, q qryNutrition.ListHydrationQuery) ([]model.HydrationEntry, error) {
+	return a.listHydration.ListHydration(ctx, q)
+}
+
+func (a *application) DeleteHydration(ctx context.Context, cmd cmdNutrition.DeleteHydrationCommand) error {
+	return a.deleteHydration.DeleteHydration(ctx, cmd)
+}
+
+func (a *application) ClearTargets(ctx context.Context, cmd cmdNutrition.ClearTargetsCommand) error {
+	return a.clearTargets.ClearTargets(ctx, cmd)
+}
+
+func (a *application) SearchFoods(ctx context.Context, q qryNutrition.SearchFoodsQuery) ([]model.Food, error) {
+	return a.searchFoods.SearchFoods(ctx, q)
+}
+
+func (a *application) LookupBarcode(ctx context.Context, q qryNutrition.LookupBarcodeQuery) (*qryNutrition.LookupBarcodeResult, error) {
+	return a.lookupBarcode.LookupBarcode(ctx, q)
+}
+
+func (a *application) SubmitFood(ctx context.Context, cmd cmdNutrition.SubmitFoodCommand) (*model.FoodSubmission, error) {
+	return a.submitFood.SubmitFood(ctx, cmd)
+}
+
+func (a *application) CreateRecipe(ctx context.Context, cmd cmdNutrition.CreateRecipeCommand) (*model.Recipe, error) {
+	return a.createRecipe.CreateRecipe(ctx, cmd)
+}
+
+func (a *application) UpdateRecipe(ctx context.Context, cmd cmdNutrition.UpdateRecipeCommand) (*model.Recipe, error) {
+	return a.updateRecipe.UpdateRecipe(ctx, cmd)
+}
+
+func (a *application) DeleteRecipe(ctx context.Context, cmd cmdNutrition.DeleteRecipeCommand) error {
+	return a.deleteRecipe.DeleteRecipe(ctx, cmd)
+}
+
+func (a *application) ShareRecipe(ctx context.Context, cmd cmdNutrition.ShareRecipeCommand) ([]model.Recipe, error) {
+	return a.shareRecipe.ShareRecipe(ctx, cmd)
+}
+
+func (a *application) LogRecipe(ctx context.Context, cmd cmdNutrition.LogRecipeCommand) (*model.DiaryEntry, error) {
+	return a.logRecipe.LogRecipe(ctx, cmd)
+}
+
+func (a *application) ListRecipes(ctx context.Context, q qryNutrition.ListRecipesQuery) ([]model.Recipe, error) {
+	return a.listRecipes.ListRecipes(ctx, q)
+}
+
+func (a *application) GetRecipe(ctx context.Context, q qryNutrition.GetRecipeQuery) (*model.Recipe, error) {
+	return a.getRecipe.GetRecipe(ctx, q)
+}
+
 // integrations
 
-func (a *application) ConnectGoogle(ctx context.Context, cmd cmdIntegrations.ConnectGoogleCommand) (*cmdIntegrations.ConnectGoogleResult, error) {
-	return a.connectGoogle.ConnectGoogle(ctx, cmd)
+func (a *application) Connect(ctx context.Context, cmd cmdIntegrations.ConnectCommand) (*cmdIntegrations.ConnectResult, error) {
+	return a.connect.Connect(ctx, cmd)
 }
 
 func (a *application) ExchangeCallback(ctx context.Context, cmd cmdIntegrations.ExchangeCallbackCommand) error {
 	return a.exchangeCallback.ExchangeCallback(ctx, cmd)
 }
 
+func (a *application) Disconnect(ctx context.Context, cmd cmdIntegrations.DisconnectCommand) error {
+	return a.disconnect.Disconnect(ctx, cmd)
+}
+
+func (a *application) SelectGoogleCalendar(ctx context.Context, cmd cmdIntegrations.SelectGoogleCalendarCommand) (*cmdIntegrations.SelectGoogleCalendarResult, error) {
+	return a.selectGoogleCalendar.SelectGoogleCalendar(ctx, cmd)
+}
+
+func (a *application) ImportStrava(ctx context.Context, cmd cmdIntegrations.ImportStravaCommand) (*cmdIntegrations.ImportStravaResult, error) {
+	return a.importStrava.ImportStrava(ctx, cmd)
+}
+
+func (a *application) ListIntegrations(ctx context.Context, q qryIntegrations.ListIntegrationsQuery) ([]qryIntegrations.IntegrationInfo, error) {
+	return a.listIntegrations.ListIntegrations(ctx, q)
+}
+
+// cardio
+
+func (a *application) ListCardioSessions(ctx context.Context, q qryCardio.ListCardioSessionsQuery) ([]model.CardioSession, error) {
+	return a.listCardioSessions.ListCardioSessions(ctx, q)
+}
+
 // calendar
 
 func (a *application) ListCalendars(ctx context.Context, q qryCalendar.ListCalendarsQuery) ([]qryCalendar.CalendarInfo, error) {
@@ -167,3 +489,51 @@ func (a *application) ListCalendars(ctx context.Context, q qryCalendar.ListCalen
 func (a *application) CreateEvent(ctx context.Context, cmd cmdCalendar.CreateEventCommand) (*cmdCalendar.CreateEventResult, error) {
 	return a.createEvent.CreateEvent(ctx, cmd)
 }
+
+func (a *application) AddAvailability(ctx context.Context, cmd cmdCalendar.AddAvailabilityCommand) ([]model.CoachAvailability, error) {
+	return a.addAvailability.AddAvailability(ctx, cmd)
+}
+
+func (a *application) ListAvailability(ctx context.Context, q qryCalendar.ListAvailabilityQuery) ([]model.CoachAvailability, error) {
+	return a.listAvailability.ListAvailability(ctx, q)
+}
+
+func (a *application) BookSession(ctx context.Context, cmd cmdCalendar.BookSessionCommand) (*model.Booking, error) {
+	return a.bookSession.BookSession(ctx, cmd)
+}
+
+func (a *application) CancelBooking(ctx context.Context, cmd cmdCalendar.CancelBookingCommand) (*model.Booking, error) {
+	return a.cancelBooking.CancelBooking(ctx, cmd)
+}
+
+func (a *application) RescheduleBooking(ctx context.Context, cmd cmdCalendar.RescheduleBookingCommand) (*model.Booking, error) {
+	return a.rescheduleBooking.RescheduleBooking(ctx, cmd)
+}
+
+func (a *application) ListUpcomingSessions(ctx context.Context, q qryCalendar.ListUpcomingSessionsQuery) ([]model.Booking, error) {
+	return a.listUpcomingSessions.ListUpcomingSessions(ctx, q)
+}
+
+func (a *application) CreateAvailabilityRule(ctx context.Context, cmd cmdCalendar.CreateAvailabilityRuleCommand) (*model.AvailabilityRule, error) {
+	return a.createAvailabilityRule.CreateAvailabilityRule(ctx, cmd)
+}
+
+func (a *application) ListAvailabilityRules(ctx context.Context, q qryCalendar.ListAvailabilityRulesQuery) ([]model.AvailabilityRule, error) {
+	return a.listAvailabilityRules.ListAvailabilityRules(ctx, q)
+}
+
+func (a *application) DeleteAvailabilityRule(ctx context.Context, cmd cmdCalendar.DeleteAvailabilityRuleCommand) error {
+	return a.deleteAvailabilityRule.DeleteAvailabilityRule(ctx, cmd)
+}
+
+func (a *application) AddAvailabilityException(ctx context.Context, cmd cmdCalendar.AddAvailabilityExceptionCommand) (*model.AvailabilityException, error) {
+	return a.addAvailabilityException.AddAvailabilityException(ctx, cmd)
+}
+
+func (a *application) ListAvailabilityExceptions(ctx context.Context, q qryCalendar.ListAvailabilityExceptionsQuery) ([]model.AvailabilityException, error) {
+	return a.listAvailabilityExceptions.ListAvailabilityExceptions(ctx, q)
+}
+
+func (a *application) DeleteAvailabilityException(ctx context.Context, cmd cmdCalendar.DeleteAvailabilityExceptionCommand) error {
+	return a.deleteAvailabilityException.DeleteAvailabilityException(ctx, cmd)
+}
@@ -1,41 +1,58 @@
 package bootstrap
 
 import (
+	"context"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	echoSwagger "github.com/swaggo/echo-swagger"
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
-	googlecalendar "google.golang.org/api/calendar/v3"
 
+	cmdAdmin "github.com/msskobelina/fit-profi/internal/application/command/admin"
 	cmdAuthorize "github.com/msskobelina/fit-profi/internal/application/command/authorize"
 	cmdCalendar "github.com/msskobelina/fit-profi/internal/application/command/calendar"
+	cmdCoaching "github.com/msskobelina/fit-profi/internal/application/command/coaching"
 	cmdIntegrations "github.com/msskobelina/fit-profi/internal/application/command/integrations"
 	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
 	cmdProfiles "github.com/msskobelina/fit-profi/internal/application/command/profiles"
 	cmdPrograms "github.com/msskobelina/fit-profi/internal/application/command/programs"
+	"github.com/msskobelina/fit-profi/internal/application/policy"
+	qryAdmin "github.com/msskobelina/fit-profi/internal/application/query/admin"
 	qryAuthorize "github.com/msskobelina/fit-profi/internal/application/query/authorize"
 	qryCalendar "github.com/msskobelina/fit-profi/internal/application/query/calendar"
+	qryCardio "github.com/msskobelina/fit-profi/internal/application/query/cardio"
+	qryCoaching "github.com/msskobelina/fit-profi/internal/application/query/coaching"
+	qryIntegrations "github.com/msskobelina/fit-profi/internal/application/query/integrations"
 	qryNutrition "github.com/msskobelina/fit-profi/internal/application/query/nutrition"
 	qryProfiles "github.com/msskobelina/fit-profi/internal/application/query/profiles"
 	qryPrograms "github.com/msskobelina/fit-profi/internal/application/query/programs"
 	"github.com/msskobelina/fit-profi/internal/delivery"
 	"github.com/msskobelina/fit-profi/internal/delivery/boundary"
 	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/calendarsync"
 	"github.com/msskobelina/fit-profi/internal/infrastructure/email"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/exercisecatalog"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/providers"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/recordnotify"
 	repoAuthorize "github.com/msskobelina/fit-profi/internal/infrastructure/repository/authorize"
 	repoCalendar "github.com/msskobelina/fit-profi/internal/infrastructure/repository/calendar"
+	repoCardio "github.com/msskobelina/fit-profi/internal/infrastructure/repository/cardio"
+	repoCoaching "github.com/msskobelina/fit-profi/internal/infrastructure/repository/coaching"
+	repoExercises "github.com/msskobelina/fit-profi/internal/infrastructure/repository/exercises"
+	repoFoods "github.com/msskobelina/fit-profi/internal/infrastructure/repository/foods"
 	repoIntegrations "github.com/msskobelina/fit-profi/internal/infrastructure/repository/integrations"
 	repoNutrition "github.com/msskobelina/fit-profi/internal/infrastructure/repository/nutrition"
 	repoProfiles "github.com/msskobelina/fit-profi/internal/infrastructure/repository/profiles"
 	repoPrograms "github.com/msskobelina/fit-profi/internal/infrastructure/repository/programs"
+	repoRecipes "github.com/msskobelina/fit-profi/internal/infrastructure/repository/recipes"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/strava"
 	"github.com/msskobelina/fit-profi/pkg/analytics"
+	"github.com/msskobelina/fit-profi/pkg/envelope"
 	"github.com/msskobelina/fit-profi/pkg/httpserver"
 	"github.com/msskobelina/fit-profi/pkg/logger"
 	metricPkg "github.com/msskobelina/fit-profi/pkg/metric"
@@ -71,17 +88,37 @@ func Run() {
 		&model.User{},
 		&model.UserToken{},
 		&model.RevokedToken{},
+		&model.RefreshToken{},
 		&model.UserProfile{},
 		&model.CoachProfile{},
 		&model.CoachAchievement{},
 		&model.CoachEducation{},
+		&model.CoachingRelationship{},
 		&model.TrainingProgram{},
 		&model.ProgramDay{},
+		&model.Exercise{},
 		&model.ProgramExercise{},
 		&model.ExerciseProgress{},
 		&model.DiaryEntry{},
 		&model.DiaryItem{},
 		&model.UserIntegration{},
+		&model.CoachAvailability{},
+		&model.Booking{},
+		&model.AvailabilityRule{},
+		&model.AvailabilityException{},
+		&model.CalendarEventLink{},
+		&model.ScheduledWorkout{},
+		&model.WorkoutSession{},
+		&model.WorkoutSet{},
+		&model.PersonalRecord{},
+		&model.CardioSession{},
+		&model.NutritionTargetOverride{},
+		&model.NutritionLimits{},
+		&model.HydrationEntry{},
+		&model.Food{},
+		&model.FoodSubmission{},
+		&model.Recipe{},
+		&model.RecipeIngredient{},
 	); err != nil {
 		l.Fatal("automigration failed", "err", err)
 	}
@@ -94,15 +131,28 @@ func Run() {
 	)
 
 	hmacSecret := os.Getenv("HMAC_SECRET")
-	adminName := os.Getenv("ADMIN_USER_FULLNAME")
-	adminEmail := os.Getenv("ADMIN_USER_EMAIL")
-
-	oauthCfg := &oauth2.Config{
-		ClientID:     os.Getenv("GOOGLE_CLIENT_ID"),
-		ClientSecret: os.Getenv("GOOGLE_CLIENT_SECRET"),
-		RedirectURL:  os.Getenv("GOOGLE_REDIRECT_URL"),
-		Scopes:       []string{googlecalendar.CalendarScope},
-		Endpoint:     google.Endpoint,
+
+	tokenKeys, err := envelope.Parse(os.Getenv("TOKEN_ENCRYPTION_KEY_ID"), os.Getenv("TOKEN_ENCRYPTION_KEYS"))
+	if err != nil {
+		l.Fatal("invalid token encryption keys", "err", err)
+	}
+
+	// integration providers; a provider is only offered once its OAuth
+	// client is configured
+	integrationProviders := providers.NewRegistry()
+	if id := os.Getenv("GOOGLE_CLIENT_ID"); id != "" {
+		integrationProviders.Register(providers.NewGoogle(providers.Config{
+			ClientID:     id,
+			ClientSecret: os.Getenv("GOOGLE_CLIENT_SECRET"),
+			RedirectURL:  os.Getenv("GOOGLE_REDIRECT_URL"),
+		}))
+	}
+	if id := os.Getenv("STRAVA_CLIENT_ID"); id != "" {
+		integrationProviders.Register(providers.NewStrava(providers.Config{
+			ClientID:     id,
+			ClientSecret: os.Getenv("STRAVA_CLIENT_SECRET"),
+			RedirectURL:  os.Getenv("STRAVA_REDIRECT_URL"),
+		}))
 	}
 
 	// repositories
@@ -110,18 +160,78 @@ func Run() {
 	profilesRepo := repoProfiles.NewRepository(sql)
 	programsRepo := repoPrograms.NewRepository(sql)
 	nutritionRepo := repoNutrition.NewRepository(sql)
-	integrationsRepo := repoIntegrations.NewRepository(sql)
-	_ = repoCalendar.NewRepository(sql)
+	foodsRepo := repoFoods.NewRepository(sql)
+	exercisesRepo := repoExercises.NewRepository(sql)
+	recipesRepo := repoRecipes.NewRepository(sql)
+	integrationsRepo := repoIntegrations.NewRepository(sql, tokenKeys)
+	coachingRepo := repoCoaching.NewRepository(sql)
+	calendarRepo := repoCalendar.NewRepository(sql)
+	cardioRepo := repoCardio.NewRepository(sql)
+
+	// background workers run until the server shuts down
+	workers, stopWorkers := context.WithCancel(context.Background())
+	var workersDone sync.WaitGroup
+
+	// google calendar sync
+	calendarSyncer := calendarsync.NewSyncer(
+		calendarRepo,
+		programsRepo,
+		integrationsRepo,
+		calendarsync.Config{Providers: integrationProviders},
+		l.Named("calendarsync"),
+	)
+	workersDone.Go(func() { calendarSyncer.Run(workers) })
+
+	// personal record notifications
+	recordNotifier := recordnotify.NewNotifier(usersRepo, coachingRepo, programsRepo, emailSender, mixpanel, l.Named("recordnotify"))
+	workersDone.Go(func() { recordNotifier.Run(workers) })
+
+	stravaImporter := strava.NewImporter(integrationsRepo, cardioRepo, strava.Config{Providers: integrationProviders})
+
+	// seed the first admin; there is deliberately no default password
+	if os.Getenv("ADMIN_USER_PASSWORD") == "" {
+		l.Warn("ADMIN_USER_PASSWORD is not set, not seeding an admin user")
+	} else if err = cmdAuthorize.NewSeedAdminService(usersRepo).SeedAdmin(context.Background(), cmdAuthorize.SeedAdminCommand{
+		FullName: os.Getenv("ADMIN_USER_FULLNAME"),
+		Email:    os.Getenv("ADMIN_USER_EMAIL"),
+		Password: os.Getenv("ADMIN_USER_PASSWORD"),
+	}); err != nil {
+		l.Error("failed to seed admin user", "err", err)
+	}
+
+	// seed the exercise catalog
+	if err = exercisecatalog.Seed(context.Background(), exercisesRepo); err != nil {
+		l.Error("failed to seed exercise catalog", "err", err)
+	}
+	// link program exercises written without a catalog entry
+	if n, err := exercisesRepo.LinkProgramExercises(context.Background()); err != nil {
+		l.Error("failed to link program exercises to the catalog", "err", err)
+	} else if n > 0 {
+		l.Info("linked program exercises to the catalog", "count", n)
+	}
+
+	// policies
+	readAccess := policy.NewReadAccess(coachingRepo)
 
 	// application
 	app := &application{
 		// authorize
-		registerUser:   cmdAuthorize.NewRegisterUserService(usersRepo, mixpanel, metricService, hmacSecret, adminName, adminEmail),
-		loginUser:      cmdAuthorize.NewLoginUserService(usersRepo, mixpanel, metricService, hmacSecret, adminName, adminEmail),
+		registerUser:   cmdAuthorize.NewRegisterUserService(usersRepo, mixpanel, metricService, hmacSecret),
+		loginUser:      cmdAuthorize.NewLoginUserService(usersRepo, mixpanel, metricService, hmacSecret),
 		logoutUser:     cmdAuthorize.NewLogoutUserService(usersRepo, hmacSecret),
-		sendResetEmail: cmdAuthorize.NewSendResetEmailService(usersRepo, emailSender, hmacSecret, adminName, adminEmail),
+		sendResetEmail: cmdAuthorize.NewSendResetEmailService(usersRepo, emailSender, hmacSecret),
 		resetPassword:  cmdAuthorize.NewResetPasswordService(usersRepo, hmacSecret),
+		refreshToken:   cmdAuthorize.NewRefreshTokenService(usersRepo, hmacSecret),
 		verifyToken:    qryAuthorize.NewVerifyTokenService(usersRepo, hmacSecret),
+		// admin
+		listUsers:             qryAdmin.NewListUsersService(usersRepo),
+		changeUserRole:        cmdAdmin.NewChangeUserRoleService(usersRepo),
+		setUserStatus:         cmdAdmin.NewSetUserStatusService(usersRepo),
+		deleteUser:            cmdAdmin.NewDeleteUserService(usersRepo),
+		listFoodSubmissions:   qryAdmin.NewListFoodSubmissionsService(foodsRepo),
+		approveFoodSubmission: cmdAdmin.NewApproveFoodSubmissionService(foodsRepo),
+		rejectFoodSubmission:  cmdAdmin.NewRejectFoodSubmissionService(foodsRepo),
+		mergeExercises:        cmdAdmin.NewMergeExercisesService(exercisesRepo),
 		// profiles
 		createUserProfile:  cmdProfiles.NewCreateUserProfileService(profilesRepo),
 		updateUserProfile:  cmdProfiles.NewUpdateUserProfileService(profilesRepo),
@@ -129,24 +239,88 @@ func Run() {
 		createCoachProfile: cmdProfiles.NewCreateCoachProfileService(profilesRepo),
 		updateCoachProfile: cmdProfiles.NewUpdateCoachProfileService(profilesRepo),
 		getCoachProfile:    qryProfiles.NewGetCoachProfileService(profilesRepo),
+		// coaching
+		inviteClient:          cmdCoaching.NewInviteClientService(coachingRepo, usersRepo),
+		respondInvitation:     cmdCoaching.NewRespondInvitationService(coachingRepo, usersRepo),
+		terminateRelationship: cmdCoaching.NewTerminateRelationshipService(coachingRepo),
+		listInvitations:       qryCoaching.NewListInvitationsService(coachingRepo, usersRepo),
+		listClients:           qryCoaching.NewListClientsService(coachingRepo),
+		getCoach:              qryCoaching.NewGetCoachService(coachingRepo),
 		// programs
-		createProgram: cmdPrograms.NewCreateProgramService(programsRepo),
-		deleteProgram: cmdPrograms.NewDeleteProgramService(programsRepo),
-		trackProgress: cmdPrograms.NewTrackProgressService(programsRepo),
-		getProgram:    qryPrograms.NewGetProgramService(programsRepo),
-		listPrograms:  qryPrograms.NewListProgramsService(programsRepo),
+		createProgram:         cmdPrograms.NewCreateProgramService(programsRepo, coachingRepo, exercisesRepo),
+		deleteProgram:         cmdPrograms.NewDeleteProgramService(programsRepo, coachingRepo),
+		trackProgress:         cmdPrograms.NewTrackProgressService(programsRepo, recordNotifier),
+		assignProgram:         cmdPrograms.NewAssignProgramService(programsRepo, coachingRepo),
+		getProgram:            qryPrograms.NewGetProgramService(programsRepo, readAccess),
+		listPrograms:          qryPrograms.NewListProgramsService(programsRepo, readAccess),
+		listProgramProgress:   qryPrograms.NewListProgramProgressService(programsRepo, readAccess),
+		scheduleWorkout:       cmdPrograms.NewScheduleWorkoutService(programsRepo, calendarSyncer),
+		rescheduleWorkout:     cmdPrograms.NewRescheduleWorkoutService(programsRepo, calendarSyncer),
+		unscheduleWorkout:     cmdPrograms.NewUnscheduleWorkoutService(programsRepo, calendarSyncer),
+		listScheduledWorkouts: qryPrograms.NewListScheduledWorkoutsService(programsRepo),
+		startSession:          cmdPrograms.NewStartSessionService(programsRepo),
+		finishSession:         cmdPrograms.NewFinishSessionService(programsRepo, recordNotifier),
+		abandonSession:        cmdPrograms.NewAbandonSessionService(programsRepo),
+		getSession:            qryPrograms.NewGetSessionService(programsRepo, readAccess),
+		listSessions:          qryPrograms.NewListSessionsService(programsRepo, readAccess),
+		listProgress:          qryPrograms.NewListProgressService(programsRepo, readAccess),
+		getProgressAnalytics:  qryPrograms.NewGetProgressAnalyticsService(programsRepo, readAccess),
+		listRecords:           qryPrograms.NewListRecordsService(programsRepo, readAccess),
+		searchExercises:       qryPrograms.NewSearchExercisesService(exercisesRepo),
+		getExercise:           qryPrograms.NewGetExerciseService(exercisesRepo),
 		// nutrition
-		createEntry: cmdNutrition.NewCreateEntryService(nutritionRepo),
-		updateEntry: cmdNutrition.NewUpdateEntryService(nutritionRepo),
-		deleteEntry: cmdNutrition.NewDeleteEntryService(nutritionRepo),
-		listEntries: qryNutrition.NewListEntriesService(nutritionRepo),
-		getEntry:    qryNutrition.NewGetEntryService(nutritionRepo),
+		createEntry:     cmdNutrition.NewCreateEntryService(nutritionRepo, foodsRepo),
+		updateEntry:     cmdNutrition.NewUpdateEntryService(nutritionRepo, foodsRepo),
+		deleteEntry:     cmdNutrition.NewDeleteEntryService(nutritionRepo),
+		addItem:         cmdNutrition.NewAddItemService(nutritionRepo, foodsRepo),
+		updateItem:      cmdNutrition.NewUpdateItemService(nutritionRepo, foodsRepo),
+		deleteItem:      cmdNutrition.NewDeleteItemService(nutritionRepo),
+		copyEntries:     cmdNutrition.NewCopyEntriesService(nutritionRepo),
+		listEntries:     qryNutrition.NewListEntriesService(nutritionRepo, readAccess),
+		getEntry:        qryNutrition.NewGetEntryService(nutritionRepo, readAccess),
+		getSummary:      qryNutrition.NewGetSummaryService(nutritionRepo, profilesRepo, readAccess),
+		getTargets:      qryNutrition.NewGetTargetsService(nutritionRepo, profilesRepo, readAccess),
+		setTargets:      cmdNutrition.NewSetTargetsService(nutritionRepo, coachingRepo),
+		clearTargets:    cmdNutrition.NewClearTargetsService(nutritionRepo, coachingRepo),
+		getLimits:       qryNutrition.NewGetLimitsService(nutritionRepo, readAccess),
+		setLimits:       cmdNutrition.NewSetLimitsService(nutritionRepo, coachingRepo),
+		logHydration:    cmdNutrition.NewLogHydrationService(nutritionRepo),
+		listHydration:   qryNutrition.NewListHydrationService(nutritionRepo, readAccess),
+		deleteHydration: cmdNutrition.NewDeleteHydrationService(nutritionRepo),
+		searchFoods:     qryNutrition.NewSearchFoodsService(foodsRepo),
+		lookupBarcode:   qryNutrition.NewLookupBarcodeService(foodsRepo),
+		submitFood:      cmdNutrition.NewSubmitFoodService(foodsRepo),
+		createRecipe:    cmdNutrition.NewCreateRecipeService(recipesRepo, foodsRepo),
+		updateRecipe:    cmdNutrition.NewUpdateRecipeService(recipesRepo, foodsRepo),
+		deleteRecipe:    cmdNutrition.NewDeleteRecipeService(recipesRepo),
+		shareRecipe:     cmdNutrition.NewShareRecipeService(recipesRepo, coachingRepo),
+		logRecipe:       cmdNutrition.NewLogRecipeService(nutritionRepo, recipesRepo),
+		listRecipes:     qryNutrition.NewListRecipesService(recipesRepo, readAccess),
+		getRecipe:       qryNutrition.NewGetRecipeService(recipesRepo, readAccess),
 		// integrations
-		connectGoogle:    cmdIntegrations.NewConnectGoogleService(oauthCfg, hmacSecret),
-		exchangeCallback: cmdIntegrations.NewExchangeCallbackService(integrationsRepo, oauthCfg, hmacSecret),
+		connect:              cmdIntegrations.NewConnectService(integrationProviders, hmacSecret),
+		exchangeCallback:     cmdIntegrations.NewExchangeCallbackService(integrationsRepo, integrationProviders, hmacSecret),
+		disconnect:           cmdIntegrations.NewDisconnectService(integrationsRepo, integrationProviders),
+		listIntegrations:     qryIntegrations.NewListIntegrationsService(integrationsRepo, integrationProviders),
+		selectGoogleCalendar: cmdIntegrations.NewSelectGoogleCalendarService(integrationsRepo, integrationProviders),
+		importStrava:         cmdIntegrations.NewImportStravaService(stravaImporter),
+		// cardio
+		listCardioSessions: qryCardio.NewListCardioSessionsService(cardioRepo, readAccess),
 		// calendar
-		listCalendars: qryCalendar.NewListCalendarsService(integrationsRepo, oauthCfg),
-		createEvent:   cmdCalendar.NewCreateEventService(integrationsRepo, oauthCfg),
+		listCalendars:               qryCalendar.NewListCalendarsService(integrationsRepo, integrationProviders),
+		createEvent:                 cmdCalendar.NewCreateEventService(integrationsRepo, integrationProviders),
+		addAvailability:             cmdCalendar.NewAddAvailabilityService(calendarRepo),
+		listAvailability:            qryCalendar.NewListAvailabilityService(calendarRepo, coachingRepo),
+		bookSession:                 cmdCalendar.NewBookSessionService(calendarRepo, coachingRepo, calendarSyncer),
+		cancelBooking:               cmdCalendar.NewCancelBookingService(calendarRepo, calendarSyncer),
+		rescheduleBooking:           cmdCalendar.NewRescheduleBookingService(calendarRepo, calendarSyncer),
+		listUpcomingSessions:        qryCalendar.NewListUpcomingSessionsService(calendarRepo),
+		createAvailabilityRule:      cmdCalendar.NewCreateAvailabilityRuleService(calendarRepo),
+		listAvailabilityRules:       qryCalendar.NewListAvailabilityRulesService(calendarRepo),
+		deleteAvailabilityRule:      cmdCalendar.NewDeleteAvailabilityRuleService(calendarRepo),
+		addAvailabilityException:    cmdCalendar.NewAddAvailabilityExceptionService(calendarRepo),
+		listAvailabilityExceptions:  qryCalendar.NewListAvailabilityExceptionsService(calendarRepo),
+		deleteAvailabilityException: cmdCalendar.NewDeleteAvailabilityExceptionService(calendarRepo),
 	}
 
 	// delivery
@@ -193,4 +367,6 @@ func Run() {
 	if err = httpServer.Shutdown(); err != nil {
 		l.Error("bootstrap - Run - httpServer.Shutdown", "err", err)
 	}
+	stopWorkers()
+	workersDone.Wait()
 }
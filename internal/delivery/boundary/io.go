@@ -7,10 +7,12 @@ import (
 	"io"
 	"net/http"
 	"reflect"
+	"regexp"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
 )
 
 type jsonIO struct {
@@ -26,9 +28,16 @@ func New() controller.IO {
 		}
 		return name
 	})
+	_ = v.RegisterValidation("tempo", func(fl validator.FieldLevel) bool {
+		return tempoPattern.MatchString(fl.Field().String())
+	})
 	return &jsonIO{validator: v}
 }
 
+// tempoPattern matches a lifting tempo: eccentric, pause, concentric and pause
+// seconds, where X means as fast as possible, e.g. 3-1-X-0.
+var tempoPattern = regexp.MustCompile(`^[0-9X]-[0-9X]-[0-9X]-[0-9X]$`)
+
 func (c *jsonIO) Read(request interface{}, reader io.Reader) error {
 	if err := json.NewDecoder(reader).Decode(request); err != nil {
 		return errors.New("invalid request body")
@@ -51,7 +60,7 @@ func (c *jsonIO) Read(request interface{}, reader io.Reader) error {
 
 func (c *jsonIO) Error(err error, r *http.Request, w http.ResponseWriter) {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusBadRequest)
+	w.WriteHeader(errorStatus(err))
 
 	_ = json.NewEncoder(w).Encode(map[string]string{
 		"error": err.Error(),
@@ -74,9 +83,19 @@ func (c *jsonIO) Result(response interface{}, w http.ResponseWriter) {
 	_ = json.NewEncoder(w).Encode(response)
 }
 
+// errorStatus maps service errors carrying an explicit 4xx status (not found,
+// forbidden) to that status; everything else is reported as a bad request.
+func errorStatus(err error) int {
+	var se *utilsErrors.Error
+	if errors.As(err, &se) && se.Status >= 400 && se.Status < 500 {
+		return se.Status
+	}
+	return http.StatusBadRequest
+}
+
 func fieldError(e validator.FieldError) string {
 	switch e.Tag() {
-	case "required":
+	case "required", "required_with", "required_without":
 		return fmt.Sprintf("%s is required", e.Field())
 	case "email":
 		return fmt.Sprintf("%s must be a valid email address", e.Field())
@@ -92,7 +111,24 @@ func fieldError(e validator.FieldError) string {
 		return fmt.Sprintf("%s must be at least %s", e.Field(), e.Param())
 	case "lt":
 		return fmt.Sprintf("%s must be less than %s", e.Field(), e.Param())
+	case "lte":
+		return fmt.Sprintf("%s must be at most %s", e.Field(), e.Param())
+	case "gtfield":
+		return fmt.Sprintf("%s must be greater than %s", e.Field(), paramField(e.Param()))
+	case "tempo":
+		return fmt.Sprintf("%s must be four dash-separated digits or X, e.g. 3-1-X-0", e.Field())
+	case "excluded_with":
+		return fmt.Sprintf("%s cannot be combined with %s", e.Field(), paramField(e.Param()))
 	default:
 		return fmt.Sprintf("%s is invalid", e.Field())
 	}
 }
+
+// paramField turns the Go name of a field in a tag parameter into its
+// camelCase JSON name: WeightKg becomes weightKg and RIR rir.
+func paramField(s string) string {
+	if s == "" || strings.ToUpper(s) == s {
+		return strings.ToLower(s)
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
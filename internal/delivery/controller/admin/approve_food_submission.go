@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdAdmin "github.com/msskobelina/fit-profi/internal/application/command/admin"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type ApproveFoodSubmissionHandler interface {
+	ApproveFoodSubmission(ctx context.Context, cmd cmdAdmin.ApproveFoodSubmissionCommand) (*model.FoodSubmission, error)
+}
+
+// ApproveFoodSubmissionController godoc
+//
+//	@Summary		Approve food submission
+//	@Description	Adds a pending barcode submission to the food catalog. Admin only.
+//	@Tags			Admin
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"Submission ID"
+//	@Success		200	{object}	model.FoodSubmission
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		403	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
+//	@Failure		409	{object}	controller.ErrorResponse
+//	@Router			/admin/food-submissions/{id}/approve [post]
+func ApproveFoodSubmissionController(io controller.IO, h ApproveFoodSubmissionHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actorID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.ApproveFoodSubmission(r.Context(), cmdAdmin.ApproveFoodSubmissionCommand{
+			ActorID:      actorID,
+			SubmissionID: id,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdAdmin "github.com/msskobelina/fit-profi/internal/application/command/admin"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+// ChangeUserRoleRequest is the body for PATCH /admin/users/:id/role.
+type ChangeUserRoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=user coach admin" example:"coach"`
+}
+
+type ChangeUserRoleHandler interface {
+	ChangeUserRole(ctx context.Context, cmd cmdAdmin.ChangeUserRoleCommand) error
+}
+
+// ChangeUserRoleController godoc
+//
+//	@Summary		Change user role
+//	@Description	Promotes or demotes a user. Admins cannot change their own role. Admin only.
+//	@Tags			Admin
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	int						true	"User ID"
+//	@Param			body	body	ChangeUserRoleRequest	true	"New role"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Router			/admin/users/{id}/role [patch]
+func ChangeUserRoleController(io controller.IO, h ChangeUserRoleHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actorID, _ := r.Context().Value("userID").(int)
+		idStr := controller.PathParam(r, "id")
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req ChangeUserRoleRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		if err := h.ChangeUserRole(r.Context(), cmdAdmin.ChangeUserRoleCommand{
+			ActorID: actorID,
+			UserID:  id,
+			Role:    req.Role,
+		}); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
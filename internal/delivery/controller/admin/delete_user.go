@@ -0,0 +1,47 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdAdmin "github.com/msskobelina/fit-profi/internal/application/command/admin"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+type DeleteUserHandler interface {
+	DeleteUser(ctx context.Context, cmd cmdAdmin.DeleteUserCommand) error
+}
+
+// DeleteUserController godoc
+//
+//	@Summary		Delete user
+//	@Description	Soft-deletes a user account and revokes its refresh tokens. Admin only.
+//	@Tags			Admin
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path	int	true	"User ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		403	{object}	controller.ErrorResponse
+//	@Router			/admin/users/{id} [delete]
+func DeleteUserController(io controller.IO, h DeleteUserHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actorID, _ := r.Context().Value("userID").(int)
+		idStr := controller.PathParam(r, "id")
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		if err := h.DeleteUser(r.Context(), cmdAdmin.DeleteUserCommand{
+			ActorID: actorID,
+			UserID:  id,
+		}); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,63 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	qryAdmin "github.com/msskobelina/fit-profi/internal/application/query/admin"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+type ListFoodSubmissionsHandler interface {
+	ListFoodSubmissions(ctx context.Context, q qryAdmin.ListFoodSubmissionsQuery) (*qryAdmin.ListFoodSubmissionsResult, error)
+}
+
+// ListFoodSubmissionsController godoc
+//
+//	@Summary		List food submissions
+//	@Description	Returns a page of barcode submissions, oldest first. Defaults to the pending ones. Admin only.
+//	@Tags			Admin
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			status	query		string	false	"Filter by status (default pending)"	Enums(pending, approved, rejected, all)
+//	@Param			limit	query		int		false	"Page size (default 50, max 200)"
+//	@Param			offset	query		int		false	"Page offset"
+//	@Success		200		{object}	qryAdmin.ListFoodSubmissionsResult
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Router			/admin/food-submissions [get]
+func ListFoodSubmissionsController(io controller.IO, h ListFoodSubmissionsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		limit, err := strconv.Atoi(query.Get("limit"))
+		if err != nil || limit <= 0 {
+			limit = defaultUsersLimit
+		}
+		if limit > maxUsersLimit {
+			limit = maxUsersLimit
+		}
+		offset, err := strconv.Atoi(query.Get("offset"))
+		if err != nil || offset < 0 {
+			offset = 0
+		}
+		status := query.Get("status")
+		switch status {
+		case "":
+			status = "pending"
+		case "all":
+			status = ""
+		}
+		res, err := h.ListFoodSubmissions(r.Context(), qryAdmin.ListFoodSubmissionsQuery{
+			Status: status,
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,63 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	qryAdmin "github.com/msskobelina/fit-profi/internal/application/query/admin"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+const (
+	defaultUsersLimit = 50
+	maxUsersLimit     = 200
+)
+
+type ListUsersHandler interface {
+	ListUsers(ctx context.Context, q qryAdmin.ListUsersQuery) (*qryAdmin.ListUsersResult, error)
+}
+
+// ListUsersController godoc
+//
+//	@Summary		List users
+//	@Description	Returns a page of users, optionally filtered by a name/email search string and role. Admin only.
+//	@Tags			Admin
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			q		query		string	false	"Search by full name or email"
+//	@Param			role	query		string	false	"Filter by role"	Enums(user, coach, admin)
+//	@Param			limit	query		int		false	"Page size (default 50, max 200)"
+//	@Param			offset	query		int		false	"Page offset"
+//	@Success		200		{object}	qryAdmin.ListUsersResult
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Router			/admin/users [get]
+func ListUsersController(io controller.IO, h ListUsersHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		limit, err := strconv.Atoi(query.Get("limit"))
+		if err != nil || limit <= 0 {
+			limit = defaultUsersLimit
+		}
+		if limit > maxUsersLimit {
+			limit = maxUsersLimit
+		}
+		offset, err := strconv.Atoi(query.Get("offset"))
+		if err != nil || offset < 0 {
+			offset = 0
+		}
+		res, err := h.ListUsers(r.Context(), qryAdmin.ListUsersQuery{
+			Search: query.Get("q"),
+			Role:   query.Get("role"),
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,85 @@
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	qryAdmin "github.com/msskobelina/fit-profi/internal/application/query/admin"
+	"github.com/msskobelina/fit-profi/internal/delivery/boundary"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller/admin"
+)
+
+type mockListUsersHandler struct {
+	result *qryAdmin.ListUsersResult
+	err    error
+	gotQ   qryAdmin.ListUsersQuery
+}
+
+func (m *mockListUsersHandler) ListUsers(_ context.Context, q qryAdmin.ListUsersQuery) (*qryAdmin.ListUsersResult, error) {
+	m.gotQ = q
+	return m.result, m.err
+}
+
+func TestListUsersController_Pagination(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantLimit  int
+		wantOffset int
+		wantSearch string
+		wantRole   string
+	}{
+		{
+			name:      "defaults",
+			query:     "",
+			wantLimit: 50,
+		},
+		{
+			name:       "explicit values",
+			query:      "?q=john&role=coach&limit=10&offset=20",
+			wantLimit:  10,
+			wantOffset: 20,
+			wantSearch: "john",
+			wantRole:   "coach",
+		},
+		{
+			name:      "limit capped",
+			query:     "?limit=1000",
+			wantLimit: 200,
+		},
+		{
+			name:      "invalid numbers fall back to defaults",
+			query:     "?limit=abc&offset=-5",
+			wantLimit: 50,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := &mockListUsersHandler{result: &qryAdmin.ListUsersResult{}}
+			h := admin.ListUsersController(boundary.New(), handler)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/users"+tt.query, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d; body: %s", w.Code, w.Body.String())
+			}
+			if handler.gotQ.Limit != tt.wantLimit {
+				t.Errorf("Limit = %d, want %d", handler.gotQ.Limit, tt.wantLimit)
+			}
+			if handler.gotQ.Offset != tt.wantOffset {
+				t.Errorf("Offset = %d, want %d", handler.gotQ.Offset, tt.wantOffset)
+			}
+			if handler.gotQ.Search != tt.wantSearch {
+				t.Errorf("Search = %q, want %q", handler.gotQ.Search, tt.wantSearch)
+			}
+			if handler.gotQ.Role != tt.wantRole {
+				t.Errorf("Role = %q, want %q", handler.gotQ.Role, tt.wantRole)
+			}
+		})
+	}
+}
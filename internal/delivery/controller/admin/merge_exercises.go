@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdAdmin "github.com/msskobelina/fit-profi/internal/application/command/admin"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// MergeExercisesRequest is the body for POST /admin/exercises/:id/merge.
+type MergeExercisesRequest struct {
+	IntoID int `json:"intoId" validate:"required,gt=0" example:"1"`
+}
+
+type MergeExercisesHandler interface {
+	MergeExercises(ctx context.Context, cmd cmdAdmin.MergeExercisesCommand) (*model.Exercise, error)
+}
+
+// MergeExercisesController godoc
+//
+//	@Summary		Merge duplicate exercises
+//	@Description	Folds a duplicate catalog entry into another: its name and aliases become aliases of the remaining entry, program exercises referring to it are repointed and the duplicate is deleted. Admin only.
+//	@Tags			Admin
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int						true	"Duplicate catalog exercise ID"
+//	@Param			body	body		MergeExercisesRequest	true	"Entry to keep"
+//	@Success		200		{object}	model.Exercise
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Router			/admin/exercises/{id}/merge [post]
+func MergeExercisesController(io controller.IO, h MergeExercisesHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req MergeExercisesRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.MergeExercises(r.Context(), cmdAdmin.MergeExercisesCommand{
+			ExerciseID: id,
+			IntoID:     req.IntoID,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,87 @@
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	cmdAdmin "github.com/msskobelina/fit-profi/internal/application/command/admin"
+	"github.com/msskobelina/fit-profi/internal/delivery/boundary"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller/admin"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type mockMergeExercisesHandler struct {
+	result *model.Exercise
+	err    error
+	gotCmd cmdAdmin.MergeExercisesCommand
+}
+
+func (m *mockMergeExercisesHandler) MergeExercises(_ context.Context, cmd cmdAdmin.MergeExercisesCommand) (*model.Exercise, error) {
+	m.gotCmd = cmd
+	return m.result, m.err
+}
+
+func TestMergeExercisesController(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		body       string
+		handler    *mockMergeExercisesHandler
+		wantStatus int
+	}{
+		{
+			name:       "valid request",
+			id:         "7",
+			body:       `{"intoId":1}`,
+			handler:    &mockMergeExercisesHandler{result: &model.Exercise{ID: 1, Name: "Barbell bench press"}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing intoId",
+			id:         "7",
+			body:       `{}`,
+			handler:    &mockMergeExercisesHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid id",
+			id:         "bench",
+			body:       `{"intoId":1}`,
+			handler:    &mockMergeExercisesHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "unknown exercise",
+			id:   "7",
+			body: `{"intoId":999}`,
+			handler: &mockMergeExercisesHandler{
+				err: &utilsErrors.Error{Message: "Exercise not found", Status: http.StatusNotFound},
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := admin.MergeExercisesController(boundary.New(), tt.handler)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/admin/exercises/"+tt.id+"/merge", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = req.WithContext(context.WithValue(req.Context(), controller.PathParamKey("id"), tt.id))
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusOK && (tt.handler.gotCmd.ExerciseID != 7 || tt.handler.gotCmd.IntoID != 1) {
+				t.Errorf("cmd = %+v, want 7 into 1", tt.handler.gotCmd)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdAdmin "github.com/msskobelina/fit-profi/internal/application/command/admin"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// RejectFoodSubmissionRequest is the body for POST /admin/food-submissions/:id/reject.
+type RejectFoodSubmissionRequest struct {
+	Reason string `json:"reason" validate:"max=255" example:"Nutrition values do not match the label"`
+}
+
+type RejectFoodSubmissionHandler interface {
+	RejectFoodSubmission(ctx context.Context, cmd cmdAdmin.RejectFoodSubmissionCommand) (*model.FoodSubmission, error)
+}
+
+// RejectFoodSubmissionController godoc
+//
+//	@Summary		Reject food submission
+//	@Description	Rejects a pending barcode submission with an optional reason. Admin only.
+//	@Tags			Admin
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"Submission ID"
+//	@Param			body	body		RejectFoodSubmissionRequest	true	"Rejection reason"
+//	@Success		200		{object}	model.FoodSubmission
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Failure		409		{object}	controller.ErrorResponse
+//	@Router			/admin/food-submissions/{id}/reject [post]
+func RejectFoodSubmissionController(io controller.IO, h RejectFoodSubmissionHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actorID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req RejectFoodSubmissionRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.RejectFoodSubmission(r.Context(), cmdAdmin.RejectFoodSubmissionCommand{
+			ActorID:      actorID,
+			SubmissionID: id,
+			Reason:       req.Reason,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdAdmin "github.com/msskobelina/fit-profi/internal/application/command/admin"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+// SetUserStatusRequest is the body for PATCH /admin/users/:id/status.
+type SetUserStatusRequest struct {
+	Disabled *bool `json:"disabled" validate:"required" example:"true"`
+}
+
+type SetUserStatusHandler interface {
+	SetUserStatus(ctx context.Context, cmd cmdAdmin.SetUserStatusCommand) error
+}
+
+// SetUserStatusController godoc
+//
+//	@Summary		Disable or enable user
+//	@Description	Disables or re-enables a user account. Disabling also revokes the user's refresh tokens. Admin only.
+//	@Tags			Admin
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path	int						true	"User ID"
+//	@Param			body	body	SetUserStatusRequest	true	"Account status"
+//	@Success		204		"No Content"
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Router			/admin/users/{id}/status [patch]
+func SetUserStatusController(io controller.IO, h SetUserStatusHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actorID, _ := r.Context().Value("userID").(int)
+		idStr := controller.PathParam(r, "id")
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req SetUserStatusRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		if err := h.SetUserStatus(r.Context(), cmdAdmin.SetUserStatusCommand{
+			ActorID:  actorID,
+			UserID:   id,
+			Disabled: *req.Disabled,
+		}); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -21,7 +21,7 @@ type LoginHandler interface {
 // LoginController godoc
 //
 //	@Summary		Login
-//	@Description	Authenticates the user and returns a JWT token.
+//	@Description	Authenticates the user and returns a short-lived JWT access token and a refresh token.
 //	@Tags			Auth
 //	@Accept			json
 //	@Produce		json
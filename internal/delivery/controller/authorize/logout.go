@@ -16,7 +16,7 @@ type LogoutHandler interface {
 // LogoutController godoc
 //
 //	@Summary		Logout
-//	@Description	Revokes the current JWT token.
+//	@Description	Revokes the current access token and its refresh token.
 //	@Tags			Auth
 //	@Security		BearerAuth
 //	@Produce		json
@@ -0,0 +1,47 @@
+package authorize
+
+import (
+	"context"
+	"net/http"
+
+	cmdAuthorize "github.com/msskobelina/fit-profi/internal/application/command/authorize"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+// RefreshTokenRequest is the body for POST /users/token/refresh.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required" example:"3q2-7wRkT9m..."`
+}
+
+type RefreshTokenHandler interface {
+	Refresh(ctx context.Context, cmd cmdAuthorize.RefreshTokenCommand) (*cmdAuthorize.RefreshTokenResult, error)
+}
+
+// RefreshTokenController godoc
+//
+//	@Summary		Refresh access token
+//	@Description	Exchanges a refresh token for a new access token and rotates the refresh token. Reusing an already rotated refresh token revokes the whole session.
+//	@Tags			Auth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		RefreshTokenRequest				true	"Refresh token"
+//	@Success		200		{object}	cmdAuthorize.RefreshTokenResult
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Router			/users/token/refresh [post]
+func RefreshTokenController(io controller.IO, h RefreshTokenHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req RefreshTokenRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.Refresh(r.Context(), cmdAuthorize.RefreshTokenCommand{
+			RefreshToken: req.RefreshToken,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,121 @@
+package authorize_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	cmdAuthorize "github.com/msskobelina/fit-profi/internal/application/command/authorize"
+	"github.com/msskobelina/fit-profi/internal/delivery/boundary"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller/authorize"
+)
+
+type mockRefreshTokenHandler struct {
+	result *cmdAuthorize.RefreshTokenResult
+	err    error
+	gotCmd cmdAuthorize.RefreshTokenCommand
+}
+
+func (m *mockRefreshTokenHandler) Refresh(_ context.Context, cmd cmdAuthorize.RefreshTokenCommand) (*cmdAuthorize.RefreshTokenResult, error) {
+	m.gotCmd = cmd
+	return m.result, m.err
+}
+
+func TestRefreshTokenController(t *testing.T) {
+	successResult := &cmdAuthorize.RefreshTokenResult{
+		Token:        "new-access",
+		RefreshToken: "new-refresh",
+	}
+
+	tests := []struct {
+		name       string
+		body       string
+		handler    *mockRefreshTokenHandler
+		wantStatus int
+		wantErrKey string
+	}{
+		{
+			name:       "valid refresh",
+			body:       `{"refreshToken":"old-refresh"}`,
+			handler:    &mockRefreshTokenHandler{result: successResult},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing refresh token",
+			body:       `{}`,
+			handler:    &mockRefreshTokenHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name:       "invalid JSON",
+			body:       `{not-json`,
+			handler:    &mockRefreshTokenHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name:       "reused token from handler",
+			body:       `{"refreshToken":"rotated-refresh"}`,
+			handler:    &mockRefreshTokenHandler{err: &testError{"Refresh token reuse detected"}},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io := boundary.New()
+			h := authorize.RefreshTokenController(io, tt.handler)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/users/token/refresh", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+
+			if tt.wantErrKey != "" {
+				var resp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("failed to decode response: %v", err)
+				}
+				if _, ok := resp[tt.wantErrKey]; !ok {
+					t.Errorf("expected key %q in response, got: %v", tt.wantErrKey, resp)
+				}
+			}
+		})
+	}
+}
+
+func TestRefreshTokenController_PassesToken(t *testing.T) {
+	handler := &mockRefreshTokenHandler{result: &cmdAuthorize.RefreshTokenResult{Token: "a", RefreshToken: "b"}}
+	io := boundary.New()
+	h := authorize.RefreshTokenController(io, handler)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/users/token/refresh",
+		strings.NewReader(`{"refreshToken":"opaque-value"}`))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d; body: %s", w.Code, w.Body.String())
+	}
+	if handler.gotCmd.RefreshToken != "opaque-value" {
+		t.Errorf("RefreshToken = %q, want %q", handler.gotCmd.RefreshToken, "opaque-value")
+	}
+
+	var got cmdAuthorize.RefreshTokenResult
+	if err := json.NewDecoder(w.Body).Decode(&got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if got.Token != "a" || got.RefreshToken != "b" {
+		t.Errorf("got %+v, want Token=a RefreshToken=b", got)
+	}
+}
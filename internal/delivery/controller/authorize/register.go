@@ -22,7 +22,7 @@ type RegisterHandler interface {
 // RegisterController godoc
 //
 //	@Summary		Register a new user
-//	@Description	Creates a new account and returns a JWT token.
+//	@Description	Creates a new account and returns a short-lived JWT access token and a refresh token.
 //	@Tags			Auth
 //	@Accept			json
 //	@Produce		json
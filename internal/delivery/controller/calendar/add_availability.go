@@ -0,0 +1,77 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	cmdCalendar "github.com/msskobelina/fit-profi/internal/application/command/calendar"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// AddAvailabilityRequest is the body for POST /calendar/availability.
+type AddAvailabilityRequest struct {
+	Slots []AvailabilitySlotRequest `json:"slots" validate:"required,min=1,max=100,dive"`
+}
+
+type AvailabilitySlotRequest struct {
+	Start string `json:"start" validate:"required" example:"2024-03-15T10:00:00Z"`
+	End   string `json:"end"   validate:"required" example:"2024-03-15T11:00:00Z"`
+	Notes string `json:"notes"                     example:"Gym, 2nd floor"`
+}
+
+type AddAvailabilityHandler interface {
+	AddAvailability(ctx context.Context, cmd cmdCalendar.AddAvailabilityCommand) ([]model.CoachAvailability, error)
+}
+
+// AddAvailabilityController godoc
+//
+//	@Summary		Publish availability
+//	@Description	Publishes bookable time slots for the authenticated coach. Slots must be in the future and must not overlap each other or existing slots.
+//	@Tags			Calendar
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		AddAvailabilityRequest	true	"Availability slots"
+//	@Success		200		{array}		model.CoachAvailability
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Failure		409		{object}	controller.ErrorResponse
+//	@Router			/calendar/availability [post]
+func AddAvailabilityController(io controller.IO, h AddAvailabilityHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		role, _ := r.Context().Value("userRole").(string)
+		var req AddAvailabilityRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		slots := make([]cmdCalendar.AvailabilitySlot, 0, len(req.Slots))
+		for _, s := range req.Slots {
+			start, err := time.Parse(time.RFC3339, s.Start)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			end, err := time.Parse(time.RFC3339, s.End)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			slots = append(slots, cmdCalendar.AvailabilitySlot{Start: start, End: end, Notes: s.Notes})
+		}
+		res, err := h.AddAvailability(r.Context(), cmdCalendar.AddAvailabilityCommand{
+			CoachID:   userID,
+			CoachRole: role,
+			Slots:     slots,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
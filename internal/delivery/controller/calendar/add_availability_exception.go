@@ -0,0 +1,60 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+
+	cmdCalendar "github.com/msskobelina/fit-profi/internal/application/command/calendar"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// AddAvailabilityExceptionRequest is the body for POST /calendar/availability/exceptions.
+type AddAvailabilityExceptionRequest struct {
+	RuleID int    `json:"ruleId,omitempty" validate:"omitempty,gt=0" example:"3"`
+	Date   string `json:"date"             validate:"required"       example:"2024-12-25"`
+	Reason string `json:"reason"                                     example:"Holiday"`
+}
+
+type AddAvailabilityExceptionHandler interface {
+	AddAvailabilityException(ctx context.Context, cmd cmdCalendar.AddAvailabilityExceptionCommand) (*model.AvailabilityException, error)
+}
+
+// AddAvailabilityExceptionController godoc
+//
+//	@Summary		Add availability exception
+//	@Description	Removes a local date from one recurring rule, or from all of the coach's rules when ruleId is omitted.
+//	@Tags			Calendar
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		AddAvailabilityExceptionRequest	true	"Exception"
+//	@Success		200		{object}	model.AvailabilityException
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Router			/calendar/availability/exceptions [post]
+func AddAvailabilityExceptionController(io controller.IO, h AddAvailabilityExceptionHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		role, _ := r.Context().Value("userRole").(string)
+		var req AddAvailabilityExceptionRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.AddAvailabilityException(r.Context(), cmdCalendar.AddAvailabilityExceptionCommand{
+			CoachID:   userID,
+			CoachRole: role,
+			RuleID:    req.RuleID,
+			Date:      req.Date,
+			Reason:    req.Reason,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
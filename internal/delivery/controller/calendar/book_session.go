@@ -0,0 +1,70 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	cmdCalendar "github.com/msskobelina/fit-profi/internal/application/command/calendar"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// BookSessionRequest is the body for POST /calendar/bookings. Either
+// availabilityId or ruleId with start must be set.
+type BookSessionRequest struct {
+	AvailabilityID int    `json:"availabilityId" validate:"required_without=RuleID,omitempty,gt=0" example:"17"`
+	RuleID         int    `json:"ruleId"         validate:"omitempty,gt=0"                         example:"3"`
+	Start          string `json:"start"          validate:"required_with=RuleID"                   example:"2024-03-18T07:00:00Z"`
+	Notes          string `json:"notes"                                                            example:"Focus on squat technique"`
+}
+
+type BookSessionHandler interface {
+	BookSession(ctx context.Context, cmd cmdCalendar.BookSessionCommand) (*model.Booking, error)
+}
+
+// BookSessionController godoc
+//
+//	@Summary		Book session
+//	@Description	Books one of the open availability slots of the authenticated user's coach. Occurrences of recurring rules are booked by ruleId and start.
+//	@Tags			Calendar
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		BookSessionRequest	true	"Slot to book"
+//	@Success		200		{object}	model.Booking
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Failure		409		{object}	controller.ErrorResponse
+//	@Router			/calendar/bookings [post]
+func BookSessionController(io controller.IO, h BookSessionHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		var req BookSessionRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		cmd := cmdCalendar.BookSessionCommand{
+			ClientID:       userID,
+			AvailabilityID: req.AvailabilityID,
+			RuleID:         req.RuleID,
+			Notes:          req.Notes,
+		}
+		if req.RuleID != 0 {
+			start, err := time.Parse(time.RFC3339, req.Start)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			cmd.Start = start
+		}
+		res, err := h.BookSession(r.Context(), cmd)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
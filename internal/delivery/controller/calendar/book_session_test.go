@@ -0,0 +1,130 @@
+package calendar_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	cmdCalendar "github.com/msskobelina/fit-profi/internal/application/command/calendar"
+	"github.com/msskobelina/fit-profi/internal/delivery/boundary"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller/calendar"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type mockBookSessionHandler struct {
+	result *model.Booking
+	err    error
+	gotCmd cmdCalendar.BookSessionCommand
+}
+
+func (m *mockBookSessionHandler) BookSession(_ context.Context, cmd cmdCalendar.BookSessionCommand) (*model.Booking, error) {
+	m.gotCmd = cmd
+	return m.result, m.err
+}
+
+func requestWithUserID(method, path, body string, userID int) *http.Request {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req.WithContext(context.WithValue(req.Context(), "userID", userID))
+}
+
+func TestBookSessionController(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		handler    *mockBookSessionHandler
+		wantStatus int
+		wantErrKey string
+	}{
+		{
+			name:       "valid request",
+			body:       `{"availabilityId":17,"notes":"Squats"}`,
+			handler:    &mockBookSessionHandler{result: &model.Booking{ID: 1, AvailabilityID: 17}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing availability",
+			body:       `{"notes":"Squats"}`,
+			handler:    &mockBookSessionHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name:       "recurring occurrence",
+			body:       `{"ruleId":3,"start":"2030-03-18T07:00:00Z"}`,
+			handler:    &mockBookSessionHandler{result: &model.Booking{ID: 2}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "rule without start",
+			body:       `{"ruleId":3}`,
+			handler:    &mockBookSessionHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name:       "invalid start",
+			body:       `{"ruleId":3,"start":"tomorrow"}`,
+			handler:    &mockBookSessionHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name:       "invalid JSON",
+			body:       `{bad json`,
+			handler:    &mockBookSessionHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name: "slot already booked",
+			body: `{"availabilityId":17}`,
+			handler: &mockBookSessionHandler{
+				err: &utilsErrors.Error{Message: "Slot is already booked", Status: http.StatusConflict},
+			},
+			wantStatus: http.StatusConflict,
+			wantErrKey: "error",
+		},
+		{
+			name: "slot of another coach",
+			body: `{"availabilityId":17}`,
+			handler: &mockBookSessionHandler{
+				err: &utilsErrors.Error{Message: "Availability slot not found", Status: http.StatusNotFound},
+			},
+			wantStatus: http.StatusNotFound,
+			wantErrKey: "error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := calendar.BookSessionController(boundary.New(), tt.handler)
+
+			req := requestWithUserID(http.MethodPost, "/api/v1/calendar/bookings", tt.body, 5)
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+
+			if tt.wantErrKey != "" {
+				var resp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("decode: %v", err)
+				}
+				if _, ok := resp[tt.wantErrKey]; !ok {
+					t.Errorf("expected key %q in response, got: %v", tt.wantErrKey, resp)
+				}
+			}
+			if tt.wantStatus == http.StatusOK && tt.handler.gotCmd.ClientID != 5 {
+				t.Errorf("ClientID = %d, want 5", tt.handler.gotCmd.ClientID)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdCalendar "github.com/msskobelina/fit-profi/internal/application/command/calendar"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type CancelBookingHandler interface {
+	CancelBooking(ctx context.Context, cmd cmdCalendar.CancelBookingCommand) (*model.Booking, error)
+}
+
+// CancelBookingController godoc
+//
+//	@Summary		Cancel booking
+//	@Description	Cancels a booked session. Clients must cancel at least 24 hours in advance; coaches can cancel until the session starts.
+//	@Tags			Calendar
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"Booking ID"
+//	@Success		200	{object}	model.Booking
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
+//	@Failure		409	{object}	controller.ErrorResponse
+//	@Router			/calendar/bookings/{id}/cancel [post]
+func CancelBookingController(io controller.IO, h CancelBookingHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.CancelBooking(r.Context(), cmdCalendar.CancelBookingCommand{
+			UserID:    userID,
+			BookingID: id,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
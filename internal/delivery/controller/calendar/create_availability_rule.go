@@ -0,0 +1,63 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+
+	cmdCalendar "github.com/msskobelina/fit-profi/internal/application/command/calendar"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// CreateAvailabilityRuleRequest is the body for POST /calendar/availability/rules.
+type CreateAvailabilityRuleRequest struct {
+	Start           string `json:"start"           validate:"required"              example:"2024-03-04T09:00:00"`
+	DurationMinutes int    `json:"durationMinutes" validate:"required,gt=0,lte=1440" example:"60"`
+	Timezone        string `json:"timezone"        validate:"required"              example:"Europe/Kyiv"`
+	RRule           string `json:"rrule"           validate:"required"              example:"FREQ=WEEKLY;BYDAY=MO,WE,FR"`
+	Notes           string `json:"notes"                                            example:"Gym, 2nd floor"`
+}
+
+type CreateAvailabilityRuleHandler interface {
+	CreateAvailabilityRule(ctx context.Context, cmd cmdCalendar.CreateAvailabilityRuleCommand) (*model.AvailabilityRule, error)
+}
+
+// CreateAvailabilityRuleController godoc
+//
+//	@Summary		Create recurring availability
+//	@Description	Adds a recurring availability rule for the authenticated coach. start is local time in timezone; rrule supports FREQ=DAILY|WEEKLY with INTERVAL, BYDAY, COUNT and UNTIL.
+//	@Tags			Calendar
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		CreateAvailabilityRuleRequest	true	"Recurring rule"
+//	@Success		200		{object}	model.AvailabilityRule
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Router			/calendar/availability/rules [post]
+func CreateAvailabilityRuleController(io controller.IO, h CreateAvailabilityRuleHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		role, _ := r.Context().Value("userRole").(string)
+		var req CreateAvailabilityRuleRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.CreateAvailabilityRule(r.Context(), cmdCalendar.CreateAvailabilityRuleCommand{
+			CoachID:         userID,
+			CoachRole:       role,
+			Start:           req.Start,
+			DurationMinutes: req.DurationMinutes,
+			Timezone:        req.Timezone,
+			RRule:           req.RRule,
+			Notes:           req.Notes,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
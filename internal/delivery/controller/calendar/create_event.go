@@ -41,6 +41,7 @@ type CreateEventHandler interface {
 //	@Success		200		{object}	CreateEventResult
 //	@Failure		400		{object}	controller.ErrorResponse
 //	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
 //	@Router			/calendar/me/events [post]
 func CreateEventController(io controller.IO, h CreateEventHandler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,46 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdCalendar "github.com/msskobelina/fit-profi/internal/application/command/calendar"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+type DeleteAvailabilityExceptionHandler interface {
+	DeleteAvailabilityException(ctx context.Context, cmd cmdCalendar.DeleteAvailabilityExceptionCommand) error
+}
+
+// DeleteAvailabilityExceptionController godoc
+//
+//	@Summary		Delete availability exception
+//	@Description	Deletes an exception of the authenticated coach, making the date available again.
+//	@Tags			Calendar
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"Exception ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
+//	@Router			/calendar/availability/exceptions/{id} [delete]
+func DeleteAvailabilityExceptionController(io controller.IO, h DeleteAvailabilityExceptionHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		if err := h.DeleteAvailabilityException(r.Context(), cmdCalendar.DeleteAvailabilityExceptionCommand{
+			CoachID:     userID,
+			ExceptionID: id,
+		}); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
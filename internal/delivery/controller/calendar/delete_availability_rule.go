@@ -0,0 +1,46 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdCalendar "github.com/msskobelina/fit-profi/internal/application/command/calendar"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+type DeleteAvailabilityRuleHandler interface {
+	DeleteAvailabilityRule(ctx context.Context, cmd cmdCalendar.DeleteAvailabilityRuleCommand) error
+}
+
+// DeleteAvailabilityRuleController godoc
+//
+//	@Summary		Delete recurring availability
+//	@Description	Deletes a recurring availability rule of the authenticated coach. Sessions already booked from it are kept.
+//	@Tags			Calendar
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"Rule ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
+//	@Router			/calendar/availability/rules/{id} [delete]
+func DeleteAvailabilityRuleController(io controller.IO, h DeleteAvailabilityRuleHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		if err := h.DeleteAvailabilityRule(r.Context(), cmdCalendar.DeleteAvailabilityRuleCommand{
+			CoachID: userID,
+			RuleID:  id,
+		}); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
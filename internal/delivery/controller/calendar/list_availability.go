@@ -0,0 +1,68 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	qryCalendar "github.com/msskobelina/fit-profi/internal/application/query/calendar"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type ListAvailabilityHandler interface {
+	ListAvailability(ctx context.Context, q qryCalendar.ListAvailabilityQuery) ([]model.CoachAvailability, error)
+}
+
+// ListAvailabilityController godoc
+//
+//	@Summary		List availability
+//	@Description	Returns the authenticated coach's slots, or the open slots of the caller's coach when coachId is set. Defaults to the next 30 days.
+//	@Tags			Calendar
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			coachId	query		int		false	"Coach user ID (clients only)"
+//	@Param			from	query		string	false	"Window start (RFC3339)"
+//	@Param			to		query		string	false	"Window end (RFC3339)"
+//	@Success		200		{array}		model.CoachAvailability
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Router			/calendar/availability [get]
+func ListAvailabilityController(io controller.IO, h ListAvailabilityHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		q := qryCalendar.ListAvailabilityQuery{UserID: userID}
+		if v := r.URL.Query().Get("coachId"); v != "" {
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.CoachID = id
+		}
+		if v := r.URL.Query().Get("from"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.From = t
+		}
+		if v := r.URL.Query().Get("to"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.To = t
+		}
+		res, err := h.ListAvailability(r.Context(), q)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
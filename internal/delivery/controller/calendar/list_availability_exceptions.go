@@ -0,0 +1,37 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+
+	qryCalendar "github.com/msskobelina/fit-profi/internal/application/query/calendar"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type ListAvailabilityExceptionsHandler interface {
+	ListAvailabilityExceptions(ctx context.Context, q qryCalendar.ListAvailabilityExceptionsQuery) ([]model.AvailabilityException, error)
+}
+
+// ListAvailabilityExceptionsController godoc
+//
+//	@Summary		List availability exceptions
+//	@Description	Returns the exceptions and holidays of the authenticated coach.
+//	@Tags			Calendar
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Success		200	{array}		model.AvailabilityException
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Router			/calendar/availability/exceptions [get]
+func ListAvailabilityExceptionsController(io controller.IO, h ListAvailabilityExceptionsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		res, err := h.ListAvailabilityExceptions(r.Context(), qryCalendar.ListAvailabilityExceptionsQuery{CoachID: userID})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,37 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+
+	qryCalendar "github.com/msskobelina/fit-profi/internal/application/query/calendar"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type ListAvailabilityRulesHandler interface {
+	ListAvailabilityRules(ctx context.Context, q qryCalendar.ListAvailabilityRulesQuery) ([]model.AvailabilityRule, error)
+}
+
+// ListAvailabilityRulesController godoc
+//
+//	@Summary		List recurring availability
+//	@Description	Returns the recurring availability rules of the authenticated coach.
+//	@Tags			Calendar
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Success		200	{array}		model.AvailabilityRule
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Router			/calendar/availability/rules [get]
+func ListAvailabilityRulesController(io controller.IO, h ListAvailabilityRulesHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		res, err := h.ListAvailabilityRules(r.Context(), qryCalendar.ListAvailabilityRulesQuery{CoachID: userID})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -22,6 +22,7 @@ type ListCalendarsHandler interface {
 //	@Success		200	{array}		qryCalendar.CalendarInfo
 //	@Failure		400	{object}	controller.ErrorResponse
 //	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
 //	@Router			/calendar/list [get]
 func ListCalendarsController(io controller.IO, h ListCalendarsHandler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,37 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+
+	qryCalendar "github.com/msskobelina/fit-profi/internal/application/query/calendar"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type ListUpcomingSessionsHandler interface {
+	ListUpcomingSessions(ctx context.Context, q qryCalendar.ListUpcomingSessionsQuery) ([]model.Booking, error)
+}
+
+// ListUpcomingSessionsController godoc
+//
+//	@Summary		List my upcoming sessions
+//	@Description	Returns booked sessions that haven't ended yet where the authenticated user is the coach or the client.
+//	@Tags			Calendar
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Success		200	{array}		model.Booking
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Router			/calendar/bookings/upcoming [get]
+func ListUpcomingSessionsController(io controller.IO, h ListUpcomingSessionsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		res, err := h.ListUpcomingSessions(r.Context(), qryCalendar.ListUpcomingSessionsQuery{UserID: userID})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,76 @@
+package calendar
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	cmdCalendar "github.com/msskobelina/fit-profi/internal/application/command/calendar"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// RescheduleBookingRequest is the body for POST /calendar/bookings/{id}/reschedule.
+// Either availabilityId or ruleId with start must be set.
+type RescheduleBookingRequest struct {
+	AvailabilityID int    `json:"availabilityId" validate:"required_without=RuleID,omitempty,gt=0" example:"18"`
+	RuleID         int    `json:"ruleId"         validate:"omitempty,gt=0"                         example:"3"`
+	Start          string `json:"start"          validate:"required_with=RuleID"                   example:"2024-03-20T07:00:00Z"`
+}
+
+type RescheduleBookingHandler interface {
+	RescheduleBooking(ctx context.Context, cmd cmdCalendar.RescheduleBookingCommand) (*model.Booking, error)
+}
+
+// RescheduleBookingController godoc
+//
+//	@Summary		Reschedule booking
+//	@Description	Moves a booked session to another open slot of the same coach. Clients must reschedule at least 24 hours in advance.
+//	@Tags			Calendar
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"Booking ID"
+//	@Param			body	body		RescheduleBookingRequest	true	"New slot"
+//	@Success		200		{object}	model.Booking
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Failure		409		{object}	controller.ErrorResponse
+//	@Router			/calendar/bookings/{id}/reschedule [post]
+func RescheduleBookingController(io controller.IO, h RescheduleBookingHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req RescheduleBookingRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		cmd := cmdCalendar.RescheduleBookingCommand{
+			UserID:         userID,
+			BookingID:      id,
+			AvailabilityID: req.AvailabilityID,
+			RuleID:         req.RuleID,
+		}
+		if req.RuleID != 0 {
+			start, err := time.Parse(time.RFC3339, req.Start)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			cmd.Start = start
+		}
+		res, err := h.RescheduleBooking(r.Context(), cmd)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,68 @@
+package cardio
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	qryCardio "github.com/msskobelina/fit-profi/internal/application/query/cardio"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type ListCardioSessionsHandler interface {
+	ListCardioSessions(ctx context.Context, q qryCardio.ListCardioSessionsQuery) ([]model.CardioSession, error)
+}
+
+// ListCardioSessionsController godoc
+//
+//	@Summary		List cardio sessions
+//	@Description	Returns imported runs, rides and other cardio sessions of the authenticated user (or one of their active clients when userId is set), newest first. Defaults to the last 30 days.
+//	@Tags			Cardio
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			userId	query		int		false	"Client user ID (coaches only)"
+//	@Param			from	query		string	false	"Window start (RFC3339)"
+//	@Param			to		query		string	false	"Window end (RFC3339)"
+//	@Success		200		{array}		model.CardioSession
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Router			/cardio/sessions [get]
+func ListCardioSessionsController(io controller.IO, h ListCardioSessionsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		q := qryCardio.ListCardioSessionsQuery{UserID: userID}
+		if v := r.URL.Query().Get("userId"); v != "" {
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.OwnerID = id
+		}
+		if v := r.URL.Query().Get("from"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.From = t
+		}
+		if v := r.URL.Query().Get("to"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.To = t
+		}
+		res, err := h.ListCardioSessions(r.Context(), q)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,37 @@
+package coaching
+
+import (
+	"context"
+	"net/http"
+
+	qryCoaching "github.com/msskobelina/fit-profi/internal/application/query/coaching"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type GetCoachHandler interface {
+	GetCoach(ctx context.Context, q qryCoaching.GetCoachQuery) (*model.CoachingRelationship, error)
+}
+
+// GetCoachController godoc
+//
+//	@Summary		Get my coach
+//	@Description	Returns the active coaching relationship of the authenticated client.
+//	@Tags			Coaching
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Success		200	{object}	model.CoachingRelationship
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Router			/coaching/coach [get]
+func GetCoachController(io controller.IO, h GetCoachHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		res, err := h.GetCoach(r.Context(), qryCoaching.GetCoachQuery{ClientID: userID})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,55 @@
+package coaching
+
+import (
+	"context"
+	"net/http"
+
+	cmdCoaching "github.com/msskobelina/fit-profi/internal/application/command/coaching"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// InviteClientRequest is the body for POST /coaching/invitations.
+type InviteClientRequest struct {
+	Email string `json:"email" validate:"required,email" example:"athlete@example.com"`
+}
+
+type InviteClientHandler interface {
+	InviteClient(ctx context.Context, cmd cmdCoaching.InviteClientCommand) (*model.CoachingRelationship, error)
+}
+
+// InviteClientController godoc
+//
+//	@Summary		Invite client
+//	@Description	Sends a coaching invitation to the given email. Coaches only.
+//	@Tags			Coaching
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		InviteClientRequest	true	"Client email"
+//	@Success		200		{object}	model.CoachingRelationship
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Router			/coaching/invitations [post]
+func InviteClientController(io controller.IO, h InviteClientHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		role, _ := r.Context().Value("userRole").(string)
+		var req InviteClientRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.InviteClient(r.Context(), cmdCoaching.InviteClientCommand{
+			CoachID:     userID,
+			CoachRole:   role,
+			ClientEmail: req.Email,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,118 @@
+package coaching_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	cmdCoaching "github.com/msskobelina/fit-profi/internal/application/command/coaching"
+	"github.com/msskobelina/fit-profi/internal/delivery/boundary"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller/coaching"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type mockInviteClientHandler struct {
+	result *model.CoachingRelationship
+	err    error
+	gotCmd cmdCoaching.InviteClientCommand
+}
+
+func (m *mockInviteClientHandler) InviteClient(_ context.Context, cmd cmdCoaching.InviteClientCommand) (*model.CoachingRelationship, error) {
+	m.gotCmd = cmd
+	return m.result, m.err
+}
+
+func requestAs(method, path, body string, userID int, role string) *http.Request {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	ctx := context.WithValue(req.Context(), "userID", userID)
+	ctx = context.WithValue(ctx, "userRole", role)
+	return req.WithContext(ctx)
+}
+
+func TestInviteClientController(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		handler    *mockInviteClientHandler
+		wantStatus int
+		wantErrKey string
+	}{
+		{
+			name:       "valid invitation",
+			body:       `{"email":"athlete@example.com"}`,
+			handler:    &mockInviteClientHandler{result: &model.CoachingRelationship{ID: 1, Status: model.CoachingPending}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing email",
+			body:       `{}`,
+			handler:    &mockInviteClientHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name:       "invalid email",
+			body:       `{"email":"nope"}`,
+			handler:    &mockInviteClientHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name:       "invalid JSON",
+			body:       `{bad`,
+			handler:    &mockInviteClientHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := coaching.InviteClientController(boundary.New(), tt.handler)
+
+			req := requestAs(http.MethodPost, "/api/v1/coaching/invitations", tt.body, 7, "coach")
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+
+			if tt.wantErrKey != "" {
+				var resp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("decode: %v", err)
+				}
+				if _, ok := resp[tt.wantErrKey]; !ok {
+					t.Errorf("expected key %q in response, got: %v", tt.wantErrKey, resp)
+				}
+			}
+		})
+	}
+}
+
+func TestInviteClientController_CallerFromContext(t *testing.T) {
+	handler := &mockInviteClientHandler{result: &model.CoachingRelationship{ID: 1}}
+	h := coaching.InviteClientController(boundary.New(), handler)
+
+	req := requestAs(http.MethodPost, "/api/v1/coaching/invitations", `{"email":"athlete@example.com"}`, 42, "coach")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d; body: %s", w.Code, w.Body.String())
+	}
+	if handler.gotCmd.CoachID != 42 {
+		t.Errorf("CoachID = %d, want 42", handler.gotCmd.CoachID)
+	}
+	if handler.gotCmd.CoachRole != "coach" {
+		t.Errorf("CoachRole = %q, want coach", handler.gotCmd.CoachRole)
+	}
+	if handler.gotCmd.ClientEmail != "athlete@example.com" {
+		t.Errorf("ClientEmail = %q", handler.gotCmd.ClientEmail)
+	}
+}
@@ -0,0 +1,41 @@
+package coaching
+
+import (
+	"context"
+	"net/http"
+
+	qryCoaching "github.com/msskobelina/fit-profi/internal/application/query/coaching"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type ListClientsHandler interface {
+	ListClients(ctx context.Context, q qryCoaching.ListClientsQuery) ([]model.CoachingRelationship, error)
+}
+
+// ListClientsController godoc
+//
+//	@Summary		List my clients
+//	@Description	Returns the coaching relationships of the authenticated coach, optionally filtered by status.
+//	@Tags			Coaching
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			status	query		string	false	"Relationship status"	Enums(pending, active, rejected, terminated)
+//	@Success		200		{array}		model.CoachingRelationship
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Router			/coaching/clients [get]
+func ListClientsController(io controller.IO, h ListClientsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		res, err := h.ListClients(r.Context(), qryCoaching.ListClientsQuery{
+			CoachID: userID,
+			Status:  r.URL.Query().Get("status"),
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
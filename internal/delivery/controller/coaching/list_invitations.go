@@ -0,0 +1,37 @@
+package coaching
+
+import (
+	"context"
+	"net/http"
+
+	qryCoaching "github.com/msskobelina/fit-profi/internal/application/query/coaching"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type ListInvitationsHandler interface {
+	ListInvitations(ctx context.Context, q qryCoaching.ListInvitationsQuery) ([]model.CoachingRelationship, error)
+}
+
+// ListInvitationsController godoc
+//
+//	@Summary		List my invitations
+//	@Description	Returns pending coaching invitations addressed to the authenticated user's email.
+//	@Tags			Coaching
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Success		200	{array}		model.CoachingRelationship
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Router			/coaching/invitations [get]
+func ListInvitationsController(io controller.IO, h ListInvitationsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		res, err := h.ListInvitations(r.Context(), qryCoaching.ListInvitationsQuery{UserID: userID})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
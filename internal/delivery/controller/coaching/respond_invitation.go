@@ -0,0 +1,71 @@
+package coaching
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdCoaching "github.com/msskobelina/fit-profi/internal/application/command/coaching"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type RespondInvitationHandler interface {
+	RespondInvitation(ctx context.Context, cmd cmdCoaching.RespondInvitationCommand) (*model.CoachingRelationship, error)
+}
+
+// AcceptInvitationController godoc
+//
+//	@Summary		Accept invitation
+//	@Description	Accepts a pending coaching invitation. A client can have only one active coach.
+//	@Tags			Coaching
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"Invitation ID"
+//	@Success		200	{object}	model.CoachingRelationship
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
+//	@Router			/coaching/invitations/{id}/accept [post]
+func AcceptInvitationController(io controller.IO, h RespondInvitationHandler) http.Handler {
+	return respondInvitationController(io, h, true)
+}
+
+// RejectInvitationController godoc
+//
+//	@Summary		Reject invitation
+//	@Description	Rejects a pending coaching invitation.
+//	@Tags			Coaching
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"Invitation ID"
+//	@Success		200	{object}	model.CoachingRelationship
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
+//	@Router			/coaching/invitations/{id}/reject [post]
+func RejectInvitationController(io controller.IO, h RespondInvitationHandler) http.Handler {
+	return respondInvitationController(io, h, false)
+}
+
+func respondInvitationController(io controller.IO, h RespondInvitationHandler, accept bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		idStr := controller.PathParam(r, "id")
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.RespondInvitation(r.Context(), cmdCoaching.RespondInvitationCommand{
+			RelationshipID: id,
+			UserID:         userID,
+			Accept:         accept,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
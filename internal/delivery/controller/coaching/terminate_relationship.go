@@ -0,0 +1,49 @@
+package coaching
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdCoaching "github.com/msskobelina/fit-profi/internal/application/command/coaching"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type TerminateRelationshipHandler interface {
+	TerminateRelationship(ctx context.Context, cmd cmdCoaching.TerminateRelationshipCommand) (*model.CoachingRelationship, error)
+}
+
+// TerminateRelationshipController godoc
+//
+//	@Summary		Terminate coaching relationship
+//	@Description	Ends an active coaching relationship (either party) or withdraws a pending invitation (coach).
+//	@Tags			Coaching
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"Relationship ID"
+//	@Success		200	{object}	model.CoachingRelationship
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
+//	@Router			/coaching/relationships/{id}/terminate [post]
+func TerminateRelationshipController(io controller.IO, h TerminateRelationshipHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		idStr := controller.PathParam(r, "id")
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.TerminateRelationship(r.Context(), cmdCoaching.TerminateRelationshipCommand{
+			RelationshipID: id,
+			UserID:         userID,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,41 @@
+package integrations
+
+import (
+	"context"
+	"net/http"
+
+	cmdIntegrations "github.com/msskobelina/fit-profi/internal/application/command/integrations"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type ConnectHandler interface {
+	Connect(ctx context.Context, cmd cmdIntegrations.ConnectCommand) (*cmdIntegrations.ConnectResult, error)
+}
+
+// ConnectController godoc
+//
+//	@Summary		Connect integration
+//	@Description	Redirects the authenticated user to the provider's OAuth consent screen.
+//	@Tags			Integrations
+//	@Security		BearerAuth
+//	@Param			provider	path	string	true	"Provider"	Enums(google, strava)
+//	@Success		302			"Redirect to the provider's OAuth consent screen"
+//	@Failure		400			{object}	controller.ErrorResponse
+//	@Failure		401			{object}	controller.ErrorResponse
+//	@Failure		404			{object}	controller.ErrorResponse
+//	@Router			/integrations/{provider}/connect [get]
+func ConnectController(io controller.IO, h ConnectHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		res, err := h.Connect(r.Context(), cmdIntegrations.ConnectCommand{
+			UserID:   userID,
+			Provider: model.Provider(controller.PathParam(r, "provider")),
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		http.Redirect(w, r, res.RedirectURL, http.StatusFound)
+	})
+}
@@ -0,0 +1,75 @@
+package integrations_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	cmdIntegrations "github.com/msskobelina/fit-profi/internal/application/command/integrations"
+	"github.com/msskobelina/fit-profi/internal/delivery/boundary"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller/integrations"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type mockConnectHandler struct {
+	result *cmdIntegrations.ConnectResult
+	err    error
+	gotCmd cmdIntegrations.ConnectCommand
+}
+
+func (m *mockConnectHandler) Connect(_ context.Context, cmd cmdIntegrations.ConnectCommand) (*cmdIntegrations.ConnectResult, error) {
+	m.gotCmd = cmd
+	return m.result, m.err
+}
+
+func TestConnectController(t *testing.T) {
+	tests := []struct {
+		name         string
+		provider     string
+		handler      *mockConnectHandler
+		wantStatus   int
+		wantLocation string
+	}{
+		{
+			name:         "redirects to consent screen",
+			provider:     "strava",
+			handler:      &mockConnectHandler{result: &cmdIntegrations.ConnectResult{RedirectURL: "https://www.strava.com/oauth/authorize?state=s"}},
+			wantStatus:   http.StatusFound,
+			wantLocation: "https://www.strava.com/oauth/authorize?state=s",
+		},
+		{
+			name:     "unknown provider",
+			provider: "dropbox",
+			handler: &mockConnectHandler{
+				err: &utilsErrors.Error{Message: "Integration provider not found", Status: http.StatusNotFound},
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := integrations.ConnectController(boundary.New(), tt.handler)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/integrations/"+tt.provider+"/connect", nil)
+			ctx := context.WithValue(req.Context(), "userID", 5)
+			ctx = context.WithValue(ctx, controller.PathParamKey("provider"), tt.provider)
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, req.WithContext(ctx))
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if got := w.Header().Get("Location"); got != tt.wantLocation {
+				t.Errorf("Location = %q, want %q", got, tt.wantLocation)
+			}
+			if tt.handler.gotCmd.UserID != 5 || tt.handler.gotCmd.Provider != model.Provider(tt.provider) {
+				t.Errorf("unexpected command: %+v", tt.handler.gotCmd)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package integrations
+
+import (
+	"context"
+	"net/http"
+
+	cmdIntegrations "github.com/msskobelina/fit-profi/internal/application/command/integrations"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type DisconnectHandler interface {
+	Disconnect(ctx context.Context, cmd cmdIntegrations.DisconnectCommand) error
+}
+
+// DisconnectController godoc
+//
+//	@Summary		Disconnect integration
+//	@Description	Revokes the user's token at the provider and removes the integration.
+//	@Tags			Integrations
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			provider	path	string	true	"Provider"	Enums(google, strava)
+//	@Success		204			"No Content"
+//	@Failure		400			{object}	controller.ErrorResponse
+//	@Failure		401			{object}	controller.ErrorResponse
+//	@Failure		404			{object}	controller.ErrorResponse
+//	@Router			/integrations/{provider}/disconnect [post]
+//	@Router			/integrations/{provider} [delete]
+func DisconnectController(io controller.IO, h DisconnectHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		if err := h.Disconnect(r.Context(), cmdIntegrations.DisconnectCommand{
+			UserID:   userID,
+			Provider: model.Provider(controller.PathParam(r, "provider")),
+		}); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
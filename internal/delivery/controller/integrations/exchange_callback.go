@@ -6,6 +6,7 @@ import (
 
 	cmdIntegrations "github.com/msskobelina/fit-profi/internal/application/command/integrations"
 	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
 )
 
 type ExchangeCallbackHandler interface {
@@ -14,27 +15,30 @@ type ExchangeCallbackHandler interface {
 
 // ExchangeCallbackController godoc
 //
-//	@Summary		Google OAuth callback
-//	@Description	Receives the OAuth authorization code from Google and stores the access token.
+//	@Summary		OAuth callback
+//	@Description	Receives the OAuth authorization code from the provider and stores the access token.
 //	@Tags			Integrations
 //	@Produce		plain
-//	@Param			state	query		string	true	"OAuth state parameter"
-//	@Param			code	query		string	true	"OAuth authorization code"
-//	@Success		200		{string}	string	"Google connected ✓"
-//	@Failure		400		{object}	controller.ErrorResponse
-//	@Router			/integrations/google/callback [get]
+//	@Param			provider	path		string	true	"Provider"	Enums(google, strava)
+//	@Param			state		query		string	true	"OAuth state parameter"
+//	@Param			code		query		string	true	"OAuth authorization code"
+//	@Success		200			{string}	string	"Integration connected ✓"
+//	@Failure		400			{object}	controller.ErrorResponse
+//	@Failure		404			{object}	controller.ErrorResponse
+//	@Router			/integrations/{provider}/callback [get]
 func ExchangeCallbackController(io controller.IO, h ExchangeCallbackHandler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		state := r.URL.Query().Get("state")
 		code := r.URL.Query().Get("code")
 		if err := h.ExchangeCallback(r.Context(), cmdIntegrations.ExchangeCallbackCommand{
-			State: state,
-			Code:  code,
+			Provider: model.Provider(controller.PathParam(r, "provider")),
+			State:    state,
+			Code:     code,
 		}); err != nil {
 			io.Error(err, r, w)
 			return
 		}
 		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte("Google connected ✓"))
+		_, _ = w.Write([]byte("Integration connected ✓"))
 	})
 }
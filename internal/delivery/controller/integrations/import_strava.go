@@ -0,0 +1,39 @@
+package integrations
+
+import (
+	"context"
+	"net/http"
+
+	cmdIntegrations "github.com/msskobelina/fit-profi/internal/application/command/integrations"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+type ImportStravaHandler interface {
+	ImportStrava(ctx context.Context, cmd cmdIntegrations.ImportStravaCommand) (*cmdIntegrations.ImportStravaResult, error)
+}
+
+// ImportStravaController godoc
+//
+//	@Summary		Import Strava activities
+//	@Description	Imports the activities recorded since the previous import as cardio sessions. An import interrupted by Strava's rate limit continues from where it stopped on the next call.
+//	@Tags			Integrations
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Success		200	{object}	cmdIntegrations.ImportStravaResult
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
+//	@Failure		409	{object}	controller.ErrorResponse
+//	@Failure		429	{object}	controller.ErrorResponse
+//	@Router			/integrations/strava/import [post]
+func ImportStravaController(io controller.IO, h ImportStravaHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		res, err := h.ImportStrava(r.Context(), cmdIntegrations.ImportStravaCommand{UserID: userID})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
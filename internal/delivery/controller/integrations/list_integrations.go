@@ -0,0 +1,36 @@
+package integrations
+
+import (
+	"context"
+	"net/http"
+
+	qryIntegrations "github.com/msskobelina/fit-profi/internal/application/query/integrations"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+type ListIntegrationsHandler interface {
+	ListIntegrations(ctx context.Context, q qryIntegrations.ListIntegrationsQuery) ([]qryIntegrations.IntegrationInfo, error)
+}
+
+// ListIntegrationsController godoc
+//
+//	@Summary		List connected integrations
+//	@Description	Returns the user's connected providers with their status. An integration in the needs_reconnect status was revoked by the provider and has to be connected again.
+//	@Tags			Integrations
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Success		200	{array}		qryIntegrations.IntegrationInfo
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Router			/integrations [get]
+func ListIntegrationsController(io controller.IO, h ListIntegrationsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		res, err := h.ListIntegrations(r.Context(), qryIntegrations.ListIntegrationsQuery{UserID: userID})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
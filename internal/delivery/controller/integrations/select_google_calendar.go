@@ -0,0 +1,53 @@
+package integrations
+
+import (
+	"context"
+	"net/http"
+
+	cmdIntegrations "github.com/msskobelina/fit-profi/internal/application/command/integrations"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+// SelectGoogleCalendarRequest is the body for PUT /integrations/google.
+type SelectGoogleCalendarRequest struct {
+	CalendarID string `json:"calendarId" validate:"required" example:"team@group.calendar.google.com"`
+}
+
+type SelectGoogleCalendarHandler interface {
+	SelectGoogleCalendar(ctx context.Context, cmd cmdIntegrations.SelectGoogleCalendarCommand) (*cmdIntegrations.SelectGoogleCalendarResult, error)
+}
+
+// SelectGoogleCalendarController godoc
+//
+//	@Summary		Select Google Calendar
+//	@Description	Sets the calendar sessions and workouts are written to. The calendar must be one of GET /calendar/list that the user can edit; its time zone becomes the integration's time zone.
+//	@Tags			Integrations
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		SelectGoogleCalendarRequest	true	"Calendar"
+//	@Success		200		{object}	cmdIntegrations.SelectGoogleCalendarResult
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Failure		409		{object}	controller.ErrorResponse
+//	@Router			/integrations/google [put]
+func SelectGoogleCalendarController(io controller.IO, h SelectGoogleCalendarHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		var req SelectGoogleCalendarRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.SelectGoogleCalendar(r.Context(), cmdIntegrations.SelectGoogleCalendarCommand{
+			UserID:     userID,
+			CalendarID: req.CalendarID,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,101 @@
+package integrations_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	cmdIntegrations "github.com/msskobelina/fit-profi/internal/application/command/integrations"
+	"github.com/msskobelina/fit-profi/internal/delivery/boundary"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller/integrations"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type mockSelectGoogleCalendarHandler struct {
+	result *cmdIntegrations.SelectGoogleCalendarResult
+	err    error
+	gotCmd cmdIntegrations.SelectGoogleCalendarCommand
+}
+
+func (m *mockSelectGoogleCalendarHandler) SelectGoogleCalendar(_ context.Context, cmd cmdIntegrations.SelectGoogleCalendarCommand) (*cmdIntegrations.SelectGoogleCalendarResult, error) {
+	m.gotCmd = cmd
+	return m.result, m.err
+}
+
+func TestSelectGoogleCalendarController(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		handler    *mockSelectGoogleCalendarHandler
+		wantStatus int
+		wantErrKey string
+	}{
+		{
+			name: "valid request",
+			body: `{"calendarId":"team@group.calendar.google.com"}`,
+			handler: &mockSelectGoogleCalendarHandler{result: &cmdIntegrations.SelectGoogleCalendarResult{
+				CalendarID: "team@group.calendar.google.com",
+				Timezone:   "Europe/Warsaw",
+			}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "missing calendar",
+			body:       `{}`,
+			handler:    &mockSelectGoogleCalendarHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name: "unknown calendar",
+			body: `{"calendarId":"nope"}`,
+			handler: &mockSelectGoogleCalendarHandler{
+				err: &utilsErrors.Error{Message: "Calendar not found", Status: http.StatusNotFound},
+			},
+			wantStatus: http.StatusNotFound,
+			wantErrKey: "error",
+		},
+		{
+			name: "read-only calendar",
+			body: `{"calendarId":"holidays"}`,
+			handler: &mockSelectGoogleCalendarHandler{
+				err: &utilsErrors.Error{Message: "Calendar is read-only", Status: http.StatusBadRequest},
+			},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := integrations.SelectGoogleCalendarController(boundary.New(), tt.handler)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/integrations/google", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req = req.WithContext(context.WithValue(req.Context(), "userID", 5))
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+
+			if tt.wantErrKey != "" {
+				var resp map[string]string
+				if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+					t.Fatalf("decode: %v", err)
+				}
+				if _, ok := resp[tt.wantErrKey]; !ok {
+					t.Errorf("expected key %q in response, got: %v", tt.wantErrKey, resp)
+				}
+			}
+			if tt.wantStatus == http.StatusOK && tt.handler.gotCmd.UserID != 5 {
+				t.Errorf("UserID = %d, want 5", tt.handler.gotCmd.UserID)
+			}
+		})
+	}
+}
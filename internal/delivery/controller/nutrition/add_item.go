@@ -0,0 +1,89 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// AddItemRequest is the body for POST /nutrition/entries/:id/items.
+type AddItemRequest struct {
+	FoodID      *int     `json:"foodId"      validate:"omitempty,gt=0"                  example:"42"`
+	Name        string   `json:"name"        validate:"required_without=FoodID,max=255" example:"Banana"`
+	Grams       float32  `json:"grams"       validate:"gte=0,lte=10000"                 example:"120"`
+	Calories    float32  `json:"calories"    validate:"gte=0,lte=10000"                 example:"107"`
+	ProteinG    float32  `json:"proteinG"    validate:"gte=0,lte=1000"                  example:"1.3"`
+	FatG        float32  `json:"fatG"        validate:"gte=0,lte=1000"                  example:"0.4"`
+	CarbsG      float32  `json:"carbsG"      validate:"gte=0,lte=1000"                  example:"27"`
+	FibreG      *float32 `json:"fibreG"      validate:"omitempty,gte=0,lte=1000"        example:"3.1"`
+	SugarG      *float32 `json:"sugarG"      validate:"omitempty,gte=0,lte=1000"        example:"14"`
+	SodiumMg    *float32 `json:"sodiumMg"    validate:"omitempty,gte=0,lte=50000"       example:"1"`
+	PotassiumMg *float32 `json:"potassiumMg" validate:"omitempty,gte=0,lte=50000"       example:"422"`
+	CaffeineMg  *float32 `json:"caffeineMg"  validate:"omitempty,gte=0,lte=5000"        example:"0"`
+	WaterMl     *float32 `json:"waterMl"     validate:"omitempty,gte=0,lte=10000"       example:"89"`
+}
+
+type AddItemHandler interface {
+	AddItem(context.Context, cmdNutrition.AddItemCommand) (*model.DiaryEntry, error)
+}
+
+// AddItemController godoc
+//
+//	@Summary		Add item to diary entry
+//	@Description	Adds one food item to an existing diary entry. Items with a foodId from the food catalog only need grams. Micronutrients and water are optional.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int				true	"Entry ID"
+//	@Param			body	body		AddItemRequest	true	"Food item"
+//	@Success		200		{object}	model.DiaryEntry
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Router			/nutrition/entries/{id}/items [post]
+func AddItemController(io controller.IO, h AddItemHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req AddItemRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.AddItem(r.Context(), cmdNutrition.AddItemCommand{
+			EntryID: id,
+			UserID:  userID,
+			Item: model.DiaryItem{
+				FoodID:   req.FoodID,
+				Name:     req.Name,
+				Grams:    req.Grams,
+				Calories: req.Calories,
+				ProteinG: req.ProteinG,
+				FatG:     req.FatG,
+				CarbsG:   req.CarbsG,
+				Micronutrients: model.Micronutrients{
+					FibreG:      req.FibreG,
+					SugarG:      req.SugarG,
+					SodiumMg:    req.SodiumMg,
+					PotassiumMg: req.PotassiumMg,
+					CaffeineMg:  req.CaffeineMg,
+					WaterMl:     req.WaterMl,
+				},
+			},
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,47 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+type ClearTargetsHandler interface {
+	ClearTargets(context.Context, cmdNutrition.ClearTargetsCommand) error
+}
+
+// ClearTargetsController godoc
+//
+//	@Summary		Remove client nutrition targets override
+//	@Description	Removes the targets the coach set for a client, so they are computed from the client's profile again.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			clientId	path	int	true	"Client user ID"
+//	@Success		204			"No Content"
+//	@Failure		400			{object}	controller.ErrorResponse
+//	@Failure		401			{object}	controller.ErrorResponse
+//	@Failure		403			{object}	controller.ErrorResponse
+//	@Failure		404			{object}	controller.ErrorResponse
+//	@Router			/nutrition/targets/{clientId} [delete]
+func ClearTargetsController(io controller.IO, h ClearTargetsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		clientID, err := strconv.Atoi(controller.PathParam(r, "clientId"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		if err := h.ClearTargets(r.Context(), cmdNutrition.ClearTargetsCommand{
+			CoachID:  userID,
+			ClientID: clientID,
+		}); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,70 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// CopyEntriesRequest is the body for POST /nutrition/entries/copy.
+type CopyEntriesRequest struct {
+	FromDate   string `json:"fromDate"   validate:"required"                                     example:"2024-03-14"`
+	ToDate     string `json:"toDate"     validate:"required"                                     example:"2024-03-15"`
+	MealType   string `json:"mealType"   validate:"omitempty,oneof=breakfast lunch dinner snack" example:"breakfast"`
+	ToMealType string `json:"toMealType" validate:"omitempty,oneof=breakfast lunch dinner snack" example:"snack"`
+}
+
+type CopyEntriesHandler interface {
+	CopyEntries(context.Context, cmdNutrition.CopyEntriesCommand) ([]model.DiaryEntry, error)
+}
+
+// CopyEntriesController godoc
+//
+//	@Summary		Copy diary day or meal
+//	@Description	Clones the entries of fromDate, with their items, onto toDate. Set mealType to copy a single meal and toMealType to file the copies under another meal.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		CopyEntriesRequest	true	"What to copy"
+//	@Success		200		{array}		model.DiaryEntry
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Router			/nutrition/entries/copy [post]
+func CopyEntriesController(io controller.IO, h CopyEntriesHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		var req CopyEntriesRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		from, err := time.Parse("2006-01-02", req.FromDate)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		to, err := time.Parse("2006-01-02", req.ToDate)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.CopyEntries(r.Context(), cmdNutrition.CopyEntriesCommand{
+			UserID:     userID,
+			FromDate:   from,
+			ToDate:     to,
+			MealType:   req.MealType,
+			ToMealType: req.ToMealType,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
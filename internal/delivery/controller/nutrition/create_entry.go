@@ -24,7 +24,7 @@ type CreateEntryHandler interface {
 // CreateEntryController godoc
 //
 //	@Summary		Create nutrition diary entry
-//	@Description	Records a meal with food items for the authenticated user.
+//	@Description	Records a meal with food items for the authenticated user. Items with a foodId from the food catalog only need grams; their name and macros are computed from the catalog.
 //	@Tags			Nutrition
 //	@Security		BearerAuth
 //	@Accept			json
@@ -33,6 +33,7 @@ type CreateEntryHandler interface {
 //	@Success		200		{object}	model.DiaryEntry
 //	@Failure		400		{object}	controller.ErrorResponse
 //	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
 //	@Router			/nutrition/entries [post]
 func CreateEntryController(io controller.IO, h CreateEntryHandler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
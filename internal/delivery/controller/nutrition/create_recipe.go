@@ -0,0 +1,59 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// CreateRecipeRequest is the body for POST /nutrition/recipes.
+type CreateRecipeRequest struct {
+	Name        string                   `json:"name"        validate:"required,max=255"       example:"Overnight oats"`
+	Servings    int                      `json:"servings"    validate:"required,gte=1,lte=100" example:"2"`
+	YieldGrams  float32                  `json:"yieldGrams"  validate:"gte=0,lte=50000"        example:"600"`
+	Ingredients []model.RecipeIngredient `json:"ingredients" validate:"required,min=1"`
+}
+
+type CreateRecipeHandler interface {
+	CreateRecipe(context.Context, cmdNutrition.CreateRecipeCommand) (*model.Recipe, error)
+}
+
+// CreateRecipeController godoc
+//
+//	@Summary		Create recipe
+//	@Description	Saves a recipe or a saved meal (a recipe with one serving) made of ingredients. Ingredients with a foodId from the food catalog only need grams; their name and macros are computed from the catalog.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		CreateRecipeRequest	true	"Recipe"
+//	@Success		200		{object}	model.Recipe
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Router			/nutrition/recipes [post]
+func CreateRecipeController(io controller.IO, h CreateRecipeHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		var req CreateRecipeRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.CreateRecipe(r.Context(), cmdNutrition.CreateRecipeCommand{
+			UserID:      userID,
+			Name:        req.Name,
+			Servings:    req.Servings,
+			YieldGrams:  req.YieldGrams,
+			Ingredients: req.Ingredients,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
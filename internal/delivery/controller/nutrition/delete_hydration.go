@@ -0,0 +1,46 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+type DeleteHydrationHandler interface {
+	DeleteHydration(context.Context, cmdNutrition.DeleteHydrationCommand) error
+}
+
+// DeleteHydrationController godoc
+//
+//	@Summary		Delete a drink
+//	@Description	Removes a drink from the authenticated user's hydration log.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"Hydration entry ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
+//	@Router			/nutrition/hydration/{id} [delete]
+func DeleteHydrationController(io controller.IO, h DeleteHydrationHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		if err := h.DeleteHydration(r.Context(), cmdNutrition.DeleteHydrationCommand{
+			EntryID: id,
+			UserID:  userID,
+		}); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,56 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type DeleteItemHandler interface {
+	DeleteItem(context.Context, cmdNutrition.DeleteItemCommand) (*model.DiaryEntry, error)
+}
+
+// DeleteItemController godoc
+//
+//	@Summary		Delete diary entry item
+//	@Description	Removes one item from a diary entry. The last item cannot be removed; delete the entry instead.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id		path		int	true	"Entry ID"
+//	@Param			itemId	path		int	true	"Item ID"
+//	@Success		200		{object}	model.DiaryEntry
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Failure		409		{object}	controller.ErrorResponse
+//	@Router			/nutrition/entries/{id}/items/{itemId} [delete]
+func DeleteItemController(io controller.IO, h DeleteItemHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		itemID, err := strconv.Atoi(controller.PathParam(r, "itemId"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.DeleteItem(r.Context(), cmdNutrition.DeleteItemCommand{
+			EntryID: id,
+			ItemID:  itemID,
+			UserID:  userID,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,46 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+type DeleteRecipeHandler interface {
+	DeleteRecipe(context.Context, cmdNutrition.DeleteRecipeCommand) error
+}
+
+// DeleteRecipeController godoc
+//
+//	@Summary		Delete recipe
+//	@Description	Deletes one of the user's recipes. Copies shared with clients are kept.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"Recipe ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
+//	@Router			/nutrition/recipes/{id} [delete]
+func DeleteRecipeController(io controller.IO, h DeleteRecipeHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		if err := h.DeleteRecipe(r.Context(), cmdNutrition.DeleteRecipeCommand{
+			RecipeID: id,
+			UserID:   userID,
+		}); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
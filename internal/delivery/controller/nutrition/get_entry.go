@@ -17,14 +17,16 @@ type GetEntryHandler interface {
 // GetEntryController godoc
 //
 //	@Summary		Get nutrition diary entry
-//	@Description	Returns a single diary entry by ID.
+//	@Description	Returns a single diary entry by ID. Coaches pass userId to read an entry of an active client.
 //	@Tags			Nutrition
 //	@Security		BearerAuth
 //	@Produce		json
-//	@Param			id	path		int	true	"Entry ID"
-//	@Success		200	{object}	model.DiaryEntry
-//	@Failure		400	{object}	controller.ErrorResponse
-//	@Failure		401	{object}	controller.ErrorResponse
+//	@Param			id		path		int	true	"Entry ID"
+//	@Param			userId	query		int	false	"Client user ID (coaches only)"
+//	@Success		200		{object}	model.DiaryEntry
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
 //	@Router			/nutrition/entries/{id} [get]
 func GetEntryController(io controller.IO, h GetEntryHandler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -35,7 +37,14 @@ func GetEntryController(io controller.IO, h GetEntryHandler) http.Handler {
 			io.Error(err, r, w)
 			return
 		}
-		res, err := h.GetEntry(r.Context(), qryNutrition.GetEntryQuery{EntryID: id, UserID: userID})
+		var ownerID int
+		if v := r.URL.Query().Get("userId"); v != "" {
+			if ownerID, err = strconv.Atoi(v); err != nil {
+				io.Error(err, r, w)
+				return
+			}
+		}
+		res, err := h.GetEntry(r.Context(), qryNutrition.GetEntryQuery{EntryID: id, UserID: userID, OwnerID: ownerID})
 		if err != nil {
 			io.Error(err, r, w)
 			return
@@ -0,0 +1,49 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	qryNutrition "github.com/msskobelina/fit-profi/internal/application/query/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type GetLimitsHandler interface {
+	GetLimits(context.Context, qryNutrition.GetLimitsQuery) (*model.NutritionLimits, error)
+}
+
+// GetLimitsController godoc
+//
+//	@Summary		Get daily nutrition limits
+//	@Description	Returns the daily sugar, sodium and caffeine ceilings and fibre, potassium and water floors for the authenticated user (or one of their active clients when userId is set). Users who never set limits get the WHO defaults. A null limit is not checked.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			userId	query		int	false	"Client user ID (coaches only)"
+//	@Success		200		{object}	model.NutritionLimits
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Router			/nutrition/limits [get]
+func GetLimitsController(io controller.IO, h GetLimitsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		q := qryNutrition.GetLimitsQuery{UserID: userID}
+		if v := r.URL.Query().Get("userId"); v != "" {
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.OwnerID = id
+		}
+		res, err := h.GetLimits(r.Context(), q)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,54 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	qryNutrition "github.com/msskobelina/fit-profi/internal/application/query/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type GetRecipeHandler interface {
+	GetRecipe(context.Context, qryNutrition.GetRecipeQuery) (*model.Recipe, error)
+}
+
+// GetRecipeController godoc
+//
+//	@Summary		Get recipe
+//	@Description	Returns a single recipe with its ingredients. Coaches pass userId to read a recipe of an active client.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id		path		int	true	"Recipe ID"
+//	@Param			userId	query		int	false	"Client user ID (coaches only)"
+//	@Success		200		{object}	model.Recipe
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Router			/nutrition/recipes/{id} [get]
+func GetRecipeController(io controller.IO, h GetRecipeHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var ownerID int
+		if v := r.URL.Query().Get("userId"); v != "" {
+			if ownerID, err = strconv.Atoi(v); err != nil {
+				io.Error(err, r, w)
+				return
+			}
+		}
+		res, err := h.GetRecipe(r.Context(), qryNutrition.GetRecipeQuery{RecipeID: id, UserID: userID, OwnerID: ownerID})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,68 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	qryNutrition "github.com/msskobelina/fit-profi/internal/application/query/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type GetSummaryHandler interface {
+	GetSummary(context.Context, qryNutrition.GetSummaryQuery) (*model.NutritionSummary, error)
+}
+
+// GetSummaryController godoc
+//
+//	@Summary		Nutrition summary
+//	@Description	Returns calorie, macro and micronutrient totals per day and per meal for the authenticated user (or one of their active clients when userId is set), with period totals and averages over the logged days. Micronutrient and water totals are included when recorded, and drinks from the hydration log count towards the day's water and caffeine. When the user has nutrition targets, each day also reports what remains and what was exceeded; days that break the user's daily limits carry warnings. Both dates are inclusive; by default the last 7 days up to today in the user's profile time zone.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			from	query		string	false	"First date in YYYY-MM-DD format"	example(2024-03-09)
+//	@Param			to		query		string	false	"Last date in YYYY-MM-DD format"	example(2024-03-15)
+//	@Param			userId	query		int		false	"Client user ID (coaches only)"
+//	@Success		200		{object}	model.NutritionSummary
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Router			/nutrition/summary [get]
+func GetSummaryController(io controller.IO, h GetSummaryHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		q := qryNutrition.GetSummaryQuery{UserID: userID}
+		if v := r.URL.Query().Get("userId"); v != "" {
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.OwnerID = id
+		}
+		if v := r.URL.Query().Get("from"); v != "" {
+			d, err := time.Parse("2006-01-02", v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.From = d
+		}
+		if v := r.URL.Query().Get("to"); v != "" {
+			d, err := time.Parse("2006-01-02", v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.To = d
+		}
+		res, err := h.GetSummary(r.Context(), q)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
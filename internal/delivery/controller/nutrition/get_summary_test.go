@@ -0,0 +1,99 @@
+package nutrition_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	qryNutrition "github.com/msskobelina/fit-profi/internal/application/query/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/boundary"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller/nutrition"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type mockGetSummaryHandler struct {
+	err   error
+	gotQ  qryNutrition.GetSummaryQuery
+	calls int
+}
+
+func (m *mockGetSummaryHandler) GetSummary(_ context.Context, q qryNutrition.GetSummaryQuery) (*model.NutritionSummary, error) {
+	m.gotQ = q
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &model.NutritionSummary{From: "2024-03-09", To: "2024-03-15"}, nil
+}
+
+func TestGetSummaryController(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		handler    *mockGetSummaryHandler
+		wantStatus int
+		wantQ      qryNutrition.GetSummaryQuery
+	}{
+		{
+			name:       "defaults",
+			handler:    &mockGetSummaryHandler{},
+			wantStatus: http.StatusOK,
+			wantQ:      qryNutrition.GetSummaryQuery{UserID: 5},
+		},
+		{
+			name:       "explicit range for client",
+			query:      "?from=2024-03-09&to=2024-03-15&userId=9",
+			handler:    &mockGetSummaryHandler{},
+			wantStatus: http.StatusOK,
+			wantQ: qryNutrition.GetSummaryQuery{
+				UserID:  5,
+				OwnerID: 9,
+				From:    time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC),
+				To:      time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:       "invalid from",
+			query:      "?from=09-03-2024",
+			handler:    &mockGetSummaryHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid userId",
+			query:      "?userId=abc",
+			handler:    &mockGetSummaryHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "handler error",
+			handler:    &mockGetSummaryHandler{err: &testError{"db error"}},
+			wantStatus: http.StatusBadRequest,
+			wantQ:      qryNutrition.GetSummaryQuery{UserID: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := nutrition.GetSummaryController(boundary.New(), tt.handler)
+
+			req := requestWithUserID(http.MethodGet, "/api/v1/nutrition/summary"+tt.query, "", 5)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d; body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusBadRequest && tt.handler.err == nil {
+				if tt.handler.calls != 0 {
+					t.Error("handler should not be called on invalid query")
+				}
+				return
+			}
+			if tt.handler.gotQ != tt.wantQ {
+				t.Errorf("query = %+v, want %+v", tt.handler.gotQ, tt.wantQ)
+			}
+		})
+	}
+}
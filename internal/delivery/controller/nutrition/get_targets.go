@@ -0,0 +1,49 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	qryNutrition "github.com/msskobelina/fit-profi/internal/application/query/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type GetTargetsHandler interface {
+	GetTargets(context.Context, qryNutrition.GetTargetsQuery) (*model.NutritionTargets, error)
+}
+
+// GetTargetsController godoc
+//
+//	@Summary		Get nutrition targets
+//	@Description	Returns daily calorie and macro targets for the authenticated user (or one of their active clients when userId is set). Targets set by the current coach take precedence; otherwise they are computed from the profile's age, weight, height, sex, activity level and goal.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			userId	query		int	false	"Client user ID (coaches only)"
+//	@Success		200		{object}	model.NutritionTargets
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Router			/nutrition/targets [get]
+func GetTargetsController(io controller.IO, h GetTargetsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		q := qryNutrition.GetTargetsQuery{UserID: userID}
+		if v := r.URL.Query().Get("userId"); v != "" {
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.OwnerID = id
+		}
+		res, err := h.GetTargets(r.Context(), q)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
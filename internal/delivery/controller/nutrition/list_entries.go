@@ -3,6 +3,7 @@ package nutrition
 import (
 	"context"
 	"net/http"
+	"strconv"
 	"time"
 
 	qryNutrition "github.com/msskobelina/fit-profi/internal/application/query/nutrition"
@@ -17,14 +18,16 @@ type ListEntriesHandler interface {
 // ListEntriesController godoc
 //
 //	@Summary		List nutrition diary entries
-//	@Description	Returns all diary entries for the authenticated user on a given date (default: today).
+//	@Description	Returns all diary entries for the authenticated user (or one of their active clients when userId is set) on a given date (default: today).
 //	@Tags			Nutrition
 //	@Security		BearerAuth
 //	@Produce		json
 //	@Param			date	query		string	false	"Date in YYYY-MM-DD format"	example(2024-03-15)
+//	@Param			userId	query		int		false	"Client user ID (coaches only)"
 //	@Success		200		{array}		model.DiaryEntry
 //	@Failure		400		{object}	controller.ErrorResponse
 //	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
 //	@Router			/nutrition/entries [get]
 func ListEntriesController(io controller.IO, h ListEntriesHandler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -34,7 +37,14 @@ func ListEntriesController(io controller.IO, h ListEntriesHandler) http.Handler
 		if err != nil {
 			date = time.Now()
 		}
-		res, err := h.ListEntries(r.Context(), qryNutrition.ListEntriesQuery{UserID: userID, Date: date})
+		var ownerID int
+		if v := r.URL.Query().Get("userId"); v != "" {
+			if ownerID, err = strconv.Atoi(v); err != nil {
+				io.Error(err, r, w)
+				return
+			}
+		}
+		res, err := h.ListEntries(r.Context(), qryNutrition.ListEntriesQuery{UserID: userID, OwnerID: ownerID, Date: date})
 		if err != nil {
 			io.Error(err, r, w)
 			return
@@ -0,0 +1,53 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	qryNutrition "github.com/msskobelina/fit-profi/internal/application/query/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type ListHydrationHandler interface {
+	ListHydration(context.Context, qryNutrition.ListHydrationQuery) ([]model.HydrationEntry, error)
+}
+
+// ListHydrationController godoc
+//
+//	@Summary		List hydration log
+//	@Description	Returns the drinks logged by the authenticated user (or one of their active clients when userId is set) on a given date (default: today).
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			date	query		string	false	"Date in YYYY-MM-DD format"	example(2024-03-15)
+//	@Param			userId	query		int		false	"Client user ID (coaches only)"
+//	@Success		200		{array}		model.HydrationEntry
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Router			/nutrition/hydration [get]
+func ListHydrationController(io controller.IO, h ListHydrationHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		date, err := time.Parse("2006-01-02", r.URL.Query().Get("date"))
+		if err != nil {
+			date = time.Now()
+		}
+		var ownerID int
+		if v := r.URL.Query().Get("userId"); v != "" {
+			if ownerID, err = strconv.Atoi(v); err != nil {
+				io.Error(err, r, w)
+				return
+			}
+		}
+		res, err := h.ListHydration(r.Context(), qryNutrition.ListHydrationQuery{UserID: userID, OwnerID: ownerID, Date: date})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,48 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	qryNutrition "github.com/msskobelina/fit-profi/internal/application/query/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type ListRecipesHandler interface {
+	ListRecipes(context.Context, qryNutrition.ListRecipesQuery) ([]model.Recipe, error)
+}
+
+// ListRecipesController godoc
+//
+//	@Summary		List recipes
+//	@Description	Returns the recipes and saved meals of the authenticated user, including the ones their coach shared, or of one of their active clients when userId is set.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			userId	query		int	false	"Client user ID (coaches only)"
+//	@Success		200		{array}		model.Recipe
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Router			/nutrition/recipes [get]
+func ListRecipesController(io controller.IO, h ListRecipesHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		var ownerID int
+		if v := r.URL.Query().Get("userId"); v != "" {
+			var err error
+			if ownerID, err = strconv.Atoi(v); err != nil {
+				io.Error(err, r, w)
+				return
+			}
+		}
+		res, err := h.ListRecipes(r.Context(), qryNutrition.ListRecipesQuery{UserID: userID, OwnerID: ownerID})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,64 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// LogHydrationRequest is the body for POST /nutrition/hydration.
+type LogHydrationRequest struct {
+	Date       string  `json:"date"       validate:"required"               example:"2024-03-15"`
+	Beverage   string  `json:"beverage"   validate:"required,max=64"        example:"water"`
+	AmountMl   float32 `json:"amountMl"   validate:"required,gt=0,lte=5000" example:"500"`
+	CaffeineMg float32 `json:"caffeineMg" validate:"gte=0,lte=1000"         example:"0"`
+}
+
+type LogHydrationHandler interface {
+	LogHydration(context.Context, cmdNutrition.LogHydrationCommand) (*model.HydrationEntry, error)
+}
+
+// LogHydrationController godoc
+//
+//	@Summary		Log a drink
+//	@Description	Adds a drink to the authenticated user's hydration log. Drinks count towards the day's water and caffeine totals in the nutrition summary.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		LogHydrationRequest	true	"Drink"
+//	@Success		200		{object}	model.HydrationEntry
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Router			/nutrition/hydration [post]
+func LogHydrationController(io controller.IO, h LogHydrationHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		var req LogHydrationRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		date, err := time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.LogHydration(r.Context(), cmdNutrition.LogHydrationCommand{
+			UserID:     userID,
+			Date:       date,
+			Beverage:   req.Beverage,
+			AmountMl:   req.AmountMl,
+			CaffeineMg: req.CaffeineMg,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
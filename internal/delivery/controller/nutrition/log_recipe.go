@@ -0,0 +1,71 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// LogRecipeRequest is the body for POST /nutrition/recipes/:id/entries.
+type LogRecipeRequest struct {
+	Date     string  `json:"date"     validate:"required"                                    example:"2024-03-15"`
+	MealType string  `json:"mealType" validate:"required,oneof=breakfast lunch dinner snack" example:"breakfast"`
+	Servings float32 `json:"servings" validate:"required,gt=0,lte=50"                        example:"1.5"`
+}
+
+type LogRecipeHandler interface {
+	LogRecipe(context.Context, cmdNutrition.LogRecipeCommand) (*model.DiaryEntry, error)
+}
+
+// LogRecipeController godoc
+//
+//	@Summary		Log recipe to diary
+//	@Description	Creates a diary entry from one of the user's recipes. Servings is the portion multiplier; the item's weight and macros are the recipe totals divided by its servings and multiplied by it.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int					true	"Recipe ID"
+//	@Param			body	body		LogRecipeRequest	true	"Portion"
+//	@Success		200		{object}	model.DiaryEntry
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Router			/nutrition/recipes/{id}/entries [post]
+func LogRecipeController(io controller.IO, h LogRecipeHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req LogRecipeRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		date, err := time.Parse("2006-01-02", req.Date)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.LogRecipe(r.Context(), cmdNutrition.LogRecipeCommand{
+			RecipeID: id,
+			UserID:   userID,
+			Date:     date,
+			MealType: req.MealType,
+			Servings: req.Servings,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,39 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+
+	qryNutrition "github.com/msskobelina/fit-profi/internal/application/query/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+type LookupBarcodeHandler interface {
+	LookupBarcode(context.Context, qryNutrition.LookupBarcodeQuery) (*qryNutrition.LookupBarcodeResult, error)
+}
+
+// LookupBarcodeController godoc
+//
+//	@Summary		Look up food by barcode
+//	@Description	Finds a packaged food by its EAN-13, EAN-8 or UPC-A code after validating the check digit. Serving is one label serving (100 g when unknown) ready to add as a diary item. When the code is not in the catalog, submit it via POST /nutrition/foods/submissions.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			ean	path		string	true	"EAN or UPC code"	example(3017620422003)
+//	@Success		200	{object}	qryNutrition.LookupBarcodeResult
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
+//	@Router			/nutrition/foods/barcode/{ean} [get]
+func LookupBarcodeController(io controller.IO, h LookupBarcodeHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res, err := h.LookupBarcode(r.Context(), qryNutrition.LookupBarcodeQuery{
+			Barcode: controller.PathParam(r, "ean"),
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,55 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	qryNutrition "github.com/msskobelina/fit-profi/internal/application/query/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+const (
+	defaultFoodsLimit = 20
+	maxFoodsLimit     = 50
+)
+
+type SearchFoodsHandler interface {
+	SearchFoods(context.Context, qryNutrition.SearchFoodsQuery) ([]model.Food, error)
+}
+
+// SearchFoodsController godoc
+//
+//	@Summary		Search food catalog
+//	@Description	Full-text search over food names and brands, best matches first. Each word matches as a prefix. Nutrients are per 100 g; pass the food ID with grams when logging a diary item to have macros computed.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			q		query		string	true	"Search text"	example(greek yogurt)
+//	@Param			limit	query		int		false	"Max results (default 20, max 50)"
+//	@Success		200		{array}		model.Food
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Router			/nutrition/foods [get]
+func SearchFoodsController(io controller.IO, h SearchFoodsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		limit, err := strconv.Atoi(query.Get("limit"))
+		if err != nil || limit <= 0 {
+			limit = defaultFoodsLimit
+		}
+		if limit > maxFoodsLimit {
+			limit = maxFoodsLimit
+		}
+		res, err := h.SearchFoods(r.Context(), qryNutrition.SearchFoodsQuery{
+			Query: query.Get("q"),
+			Limit: limit,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
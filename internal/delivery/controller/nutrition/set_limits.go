@@ -0,0 +1,76 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// SetLimitsRequest is the body for PUT /nutrition/limits. Omitted or null
+// limits are turned off.
+type SetLimitsRequest struct {
+	SugarMaxG      *float64 `json:"sugarMaxG"      validate:"omitempty,gt=0,lte=1000"  example:"50"`
+	SodiumMaxMg    *float64 `json:"sodiumMaxMg"    validate:"omitempty,gt=0,lte=20000" example:"2000"`
+	CaffeineMaxMg  *float64 `json:"caffeineMaxMg"  validate:"omitempty,gt=0,lte=2000"  example:"400"`
+	FibreMinG      *float64 `json:"fibreMinG"      validate:"omitempty,gt=0,lte=200"   example:"30"`
+	PotassiumMinMg *float64 `json:"potassiumMinMg" validate:"omitempty,gt=0,lte=20000" example:"3500"`
+	WaterMinMl     *float64 `json:"waterMinMl"     validate:"omitempty,gt=0,lte=10000" example:"3000"`
+}
+
+type SetLimitsHandler interface {
+	SetLimits(context.Context, cmdNutrition.SetLimitsCommand) (*model.NutritionLimits, error)
+}
+
+// SetLimitsController godoc
+//
+//	@Summary		Set daily nutrition limits
+//	@Description	Replaces the daily micronutrient and water limits of the authenticated user, or of one of the coach's active clients when userId is set. Days in the nutrition summary that break a limit carry a warning.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			userId	query		int					false	"Client user ID (coaches only)"
+//	@Param			body	body		SetLimitsRequest	true	"Daily limits"
+//	@Success		200		{object}	model.NutritionLimits
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Router			/nutrition/limits [put]
+func SetLimitsController(io controller.IO, h SetLimitsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		var ownerID int
+		if v := r.URL.Query().Get("userId"); v != "" {
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			ownerID = id
+		}
+		var req SetLimitsRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.SetLimits(r.Context(), cmdNutrition.SetLimitsCommand{
+			UserID:         userID,
+			OwnerID:        ownerID,
+			SugarMaxG:      req.SugarMaxG,
+			SodiumMaxMg:    req.SodiumMaxMg,
+			CaffeineMaxMg:  req.CaffeineMaxMg,
+			FibreMinG:      req.FibreMinG,
+			PotassiumMinMg: req.PotassiumMinMg,
+			WaterMinMl:     req.WaterMinMl,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,67 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// SetTargetsRequest is the body for PUT /nutrition/targets/:clientId.
+type SetTargetsRequest struct {
+	Calories float64 `json:"calories" validate:"required,gt=0,lte=10000" example:"2200"`
+	ProteinG float64 `json:"proteinG" validate:"gte=0,lte=1000"          example:"160"`
+	FatG     float64 `json:"fatG"     validate:"gte=0,lte=1000"          example:"70"`
+	CarbsG   float64 `json:"carbsG"   validate:"gte=0,lte=2000"          example:"230"`
+}
+
+type SetTargetsHandler interface {
+	SetTargets(context.Context, cmdNutrition.SetTargetsCommand) (*model.NutritionTargets, error)
+}
+
+// SetTargetsController godoc
+//
+//	@Summary		Override client nutrition targets
+//	@Description	Sets daily calorie and macro targets for one of the coach's active clients, replacing the ones computed from the client's profile.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			clientId	path		int					true	"Client user ID"
+//	@Param			body		body		SetTargetsRequest	true	"Daily targets"
+//	@Success		200			{object}	model.NutritionTargets
+//	@Failure		400			{object}	controller.ErrorResponse
+//	@Failure		401			{object}	controller.ErrorResponse
+//	@Failure		403			{object}	controller.ErrorResponse
+//	@Router			/nutrition/targets/{clientId} [put]
+func SetTargetsController(io controller.IO, h SetTargetsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		clientID, err := strconv.Atoi(controller.PathParam(r, "clientId"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req SetTargetsRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.SetTargets(r.Context(), cmdNutrition.SetTargetsCommand{
+			CoachID:  userID,
+			ClientID: clientID,
+			Calories: req.Calories,
+			ProteinG: req.ProteinG,
+			FatG:     req.FatG,
+			CarbsG:   req.CarbsG,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
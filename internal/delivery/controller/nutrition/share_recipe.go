@@ -0,0 +1,62 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// ShareRecipeRequest is the body for POST /nutrition/recipes/:id/share.
+type ShareRecipeRequest struct {
+	ClientIDs []int `json:"clientIds" validate:"required,min=1,dive,gt=0" example:"12,15"`
+}
+
+type ShareRecipeHandler interface {
+	ShareRecipe(context.Context, cmdNutrition.ShareRecipeCommand) ([]model.Recipe, error)
+}
+
+// ShareRecipeController godoc
+//
+//	@Summary		Share recipe with clients
+//	@Description	Copies one of the coach's recipes to each of the given active clients.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int					true	"Recipe ID"
+//	@Param			body	body		ShareRecipeRequest	true	"Clients to share with"
+//	@Success		200		{array}		model.Recipe
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Router			/nutrition/recipes/{id}/share [post]
+func ShareRecipeController(io controller.IO, h ShareRecipeHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req ShareRecipeRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.ShareRecipe(r.Context(), cmdNutrition.ShareRecipeCommand{
+			RecipeID:  id,
+			CoachID:   userID,
+			ClientIDs: req.ClientIDs,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,67 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// SubmitFoodRequest is the body for POST /nutrition/foods/submissions.
+type SubmitFoodRequest struct {
+	Barcode         string  `json:"barcode"         validate:"required"           example:"4820000000007"`
+	Name            string  `json:"name"            validate:"required,max=255"   example:"Kefir 2.5%"`
+	Brand           string  `json:"brand"           validate:"max=255"            example:"Halychyna"`
+	ServingGrams    float64 `json:"servingGrams"    validate:"gte=0,lte=5000"     example:"250"`
+	CaloriesPer100g float64 `json:"caloriesPer100g" validate:"gte=0,lte=900"      example:"53"`
+	ProteinPer100g  float64 `json:"proteinPer100g"  validate:"gte=0,lte=100"      example:"2.8"`
+	FatPer100g      float64 `json:"fatPer100g"      validate:"gte=0,lte=100"      example:"2.5"`
+	CarbsPer100g    float64 `json:"carbsPer100g"    validate:"gte=0,lte=100"      example:"4.7"`
+}
+
+type SubmitFoodHandler interface {
+	SubmitFood(context.Context, cmdNutrition.SubmitFoodCommand) (*model.FoodSubmission, error)
+}
+
+// SubmitFoodController godoc
+//
+//	@Summary		Submit missing barcode
+//	@Description	Queues a packaged food that is missing from the catalog for admin review. Nutrients are per 100 g as printed on the label.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		SubmitFoodRequest	true	"Food label data"
+//	@Success		200		{object}	model.FoodSubmission
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		409		{object}	controller.ErrorResponse
+//	@Router			/nutrition/foods/submissions [post]
+func SubmitFoodController(io controller.IO, h SubmitFoodHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		var req SubmitFoodRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.SubmitFood(r.Context(), cmdNutrition.SubmitFoodCommand{
+			UserID:          userID,
+			Barcode:         req.Barcode,
+			Name:            req.Name,
+			Brand:           req.Brand,
+			ServingGrams:    req.ServingGrams,
+			CaloriesPer100g: req.CaloriesPer100g,
+			ProteinPer100g:  req.ProteinPer100g,
+			FatPer100g:      req.FatPer100g,
+			CarbsPer100g:    req.CarbsPer100g,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -23,7 +23,7 @@ type UpdateEntryHandler interface {
 // UpdateEntryController godoc
 //
 //	@Summary		Update nutrition diary entry
-//	@Description	Replaces the meal type and food items of an existing diary entry.
+//	@Description	Replaces the meal type and food items of an existing diary entry. Items with a foodId get their name and macros computed from the food catalog.
 //	@Tags			Nutrition
 //	@Security		BearerAuth
 //	@Accept			json
@@ -33,6 +33,7 @@ type UpdateEntryHandler interface {
 //	@Success		200		{object}	model.DiaryEntry
 //	@Failure		400		{object}	controller.ErrorResponse
 //	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
 //	@Router			/nutrition/entries/{id} [put]
 func UpdateEntryController(io controller.IO, h UpdateEntryHandler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
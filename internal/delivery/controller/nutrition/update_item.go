@@ -0,0 +1,95 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// UpdateItemRequest is the body for PATCH /nutrition/entries/:id/items/:itemId.
+// Omitted fields are left unchanged.
+type UpdateItemRequest struct {
+	FoodID      *int     `json:"foodId"      validate:"omitempty,gt=0"             example:"42"`
+	Name        *string  `json:"name"        validate:"omitempty,min=1,max=255"    example:"Banana"`
+	Grams       *float32 `json:"grams"       validate:"omitempty,gte=0,lte=10000"  example:"150"`
+	Calories    *float32 `json:"calories"    validate:"omitempty,gte=0,lte=10000"  example:"134"`
+	ProteinG    *float32 `json:"proteinG"    validate:"omitempty,gte=0,lte=1000"   example:"1.6"`
+	FatG        *float32 `json:"fatG"        validate:"omitempty,gte=0,lte=1000"   example:"0.5"`
+	CarbsG      *float32 `json:"carbsG"      validate:"omitempty,gte=0,lte=1000"   example:"34"`
+	FibreG      *float32 `json:"fibreG"      validate:"omitempty,gte=0,lte=1000"   example:"3.9"`
+	SugarG      *float32 `json:"sugarG"      validate:"omitempty,gte=0,lte=1000"   example:"18"`
+	SodiumMg    *float32 `json:"sodiumMg"    validate:"omitempty,gte=0,lte=50000"  example:"2"`
+	PotassiumMg *float32 `json:"potassiumMg" validate:"omitempty,gte=0,lte=50000"  example:"537"`
+	CaffeineMg  *float32 `json:"caffeineMg"  validate:"omitempty,gte=0,lte=5000"   example:"0"`
+	WaterMl     *float32 `json:"waterMl"     validate:"omitempty,gte=0,lte=10000"  example:"112"`
+}
+
+type UpdateItemHandler interface {
+	UpdateItem(context.Context, cmdNutrition.UpdateItemCommand) (*model.DiaryEntry, error)
+}
+
+// UpdateItemController godoc
+//
+//	@Summary		Update diary entry item
+//	@Description	Changes the given fields of one item of a diary entry. Catalog items are recomputed from the food, so only foodId, grams and micronutrients apply to them.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int					true	"Entry ID"
+//	@Param			itemId	path		int					true	"Item ID"
+//	@Param			body	body		UpdateItemRequest	true	"Fields to change"
+//	@Success		200		{object}	model.DiaryEntry
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Router			/nutrition/entries/{id}/items/{itemId} [patch]
+func UpdateItemController(io controller.IO, h UpdateItemHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		itemID, err := strconv.Atoi(controller.PathParam(r, "itemId"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req UpdateItemRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.UpdateItem(r.Context(), cmdNutrition.UpdateItemCommand{
+			EntryID:  id,
+			ItemID:   itemID,
+			UserID:   userID,
+			FoodID:   req.FoodID,
+			Name:     req.Name,
+			Grams:    req.Grams,
+			Calories: req.Calories,
+			ProteinG: req.ProteinG,
+			FatG:     req.FatG,
+			CarbsG:   req.CarbsG,
+			Micronutrients: model.Micronutrients{
+				FibreG:      req.FibreG,
+				SugarG:      req.SugarG,
+				SodiumMg:    req.SodiumMg,
+				PotassiumMg: req.PotassiumMg,
+				CaffeineMg:  req.CaffeineMg,
+				WaterMl:     req.WaterMl,
+			},
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
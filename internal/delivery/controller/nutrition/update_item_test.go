@@ -0,0 +1,131 @@
+package nutrition_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/boundary"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller/nutrition"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type mockUpdateItemHandler struct {
+	result *model.DiaryEntry
+	err    error
+	gotCmd cmdNutrition.UpdateItemCommand
+}
+
+func (m *mockUpdateItemHandler) UpdateItem(_ context.Context, cmd cmdNutrition.UpdateItemCommand) (*model.DiaryEntry, error) {
+	m.gotCmd = cmd
+	return m.result, m.err
+}
+
+func withPathParams(req *http.Request, kv ...string) *http.Request {
+	ctx := req.Context()
+	for i := 0; i+1 < len(kv); i += 2 {
+		ctx = context.WithValue(ctx, controller.PathParamKey(kv[i]), kv[i+1])
+	}
+	return req.WithContext(ctx)
+}
+
+func TestUpdateItemController(t *testing.T) {
+	tests := []struct {
+		name       string
+		itemID     string
+		body       string
+		handler    *mockUpdateItemHandler
+		wantStatus int
+	}{
+		{
+			name:       "grams only",
+			itemID:     "9",
+			body:       `{"grams":150}`,
+			handler:    &mockUpdateItemHandler{result: &model.DiaryEntry{ID: 3}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "zero calories is a value",
+			itemID:     "9",
+			body:       `{"calories":0}`,
+			handler:    &mockUpdateItemHandler{result: &model.DiaryEntry{ID: 3}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "empty name",
+			itemID:     "9",
+			body:       `{"name":""}`,
+			handler:    &mockUpdateItemHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "negative grams",
+			itemID:     "9",
+			body:       `{"grams":-5}`,
+			handler:    &mockUpdateItemHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid item id",
+			itemID:     "x",
+			body:       `{"grams":150}`,
+			handler:    &mockUpdateItemHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "item not found",
+			itemID: "10",
+			body:   `{"grams":150}`,
+			handler: &mockUpdateItemHandler{
+				err: &utilsErrors.Error{Message: "Item not found", Status: http.StatusNotFound},
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := nutrition.UpdateItemController(boundary.New(), tt.handler)
+			req := requestWithUserID(http.MethodPatch, "/api/v1/nutrition/entries/3/items/"+tt.itemID, tt.body, 5)
+			req = withPathParams(req, "id", "3", "itemId", tt.itemID)
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestUpdateItemController_LeavesOmittedFieldsUnset(t *testing.T) {
+	handler := &mockUpdateItemHandler{result: &model.DiaryEntry{ID: 3}}
+	h := nutrition.UpdateItemController(boundary.New(), handler)
+	req := requestWithUserID(http.MethodPatch, "/api/v1/nutrition/entries/3/items/9", `{"grams":150,"fatG":0}`, 5)
+	req = withPathParams(req, "id", "3", "itemId", "9")
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d; body: %s", w.Code, w.Body.String())
+	}
+	cmd := handler.gotCmd
+	if cmd.EntryID != 3 || cmd.ItemID != 9 || cmd.UserID != 5 {
+		t.Errorf("ids = (%d, %d, %d), want (3, 9, 5)", cmd.EntryID, cmd.ItemID, cmd.UserID)
+	}
+	if cmd.Grams == nil || *cmd.Grams != 150 {
+		t.Errorf("Grams = %v, want 150", cmd.Grams)
+	}
+	if cmd.FatG == nil || *cmd.FatG != 0 {
+		t.Errorf("FatG = %v, want 0", cmd.FatG)
+	}
+	if cmd.Name != nil || cmd.Calories != nil || cmd.FoodID != nil {
+		t.Errorf("omitted fields set: name=%v calories=%v foodId=%v", cmd.Name, cmd.Calories, cmd.FoodID)
+	}
+}
@@ -0,0 +1,67 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdNutrition "github.com/msskobelina/fit-profi/internal/application/command/nutrition"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// UpdateRecipeRequest is the body for PUT /nutrition/recipes/:id.
+type UpdateRecipeRequest struct {
+	Name        string                   `json:"name"        validate:"required,max=255"       example:"Overnight oats"`
+	Servings    int                      `json:"servings"    validate:"required,gte=1,lte=100" example:"2"`
+	YieldGrams  float32                  `json:"yieldGrams"  validate:"gte=0,lte=50000"        example:"600"`
+	Ingredients []model.RecipeIngredient `json:"ingredients" validate:"required,min=1"`
+}
+
+type UpdateRecipeHandler interface {
+	UpdateRecipe(context.Context, cmdNutrition.UpdateRecipeCommand) (*model.Recipe, error)
+}
+
+// UpdateRecipeController godoc
+//
+//	@Summary		Update recipe
+//	@Description	Replaces the name, servings, yield and ingredients of one of the user's recipes. Diary items already logged from it keep their macros.
+//	@Tags			Nutrition
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int					true	"Recipe ID"
+//	@Param			body	body		UpdateRecipeRequest	true	"Updated recipe"
+//	@Success		200		{object}	model.Recipe
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Router			/nutrition/recipes/{id} [put]
+func UpdateRecipeController(io controller.IO, h UpdateRecipeHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req UpdateRecipeRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.UpdateRecipe(r.Context(), cmdNutrition.UpdateRecipeCommand{
+			RecipeID:    id,
+			UserID:      userID,
+			Name:        req.Name,
+			Servings:    req.Servings,
+			YieldGrams:  req.YieldGrams,
+			Ingredients: req.Ingredients,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
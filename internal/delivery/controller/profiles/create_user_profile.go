@@ -16,11 +16,15 @@ type (
 
 	// CreateUserProfileRequest is the body for POST /profiles/user.
 	CreateUserProfileRequest struct {
-		FullName    string  `json:"fullName"    validate:"required"                                                   example:"John Doe"`
-		Age         int     `json:"age"         validate:"required,gt=0,lt=130"                                       example:"25"`
-		WeightKg    float32 `json:"weightKg"    validate:"required,gt=0"                                              example:"75.5"`
-		Goal        string  `json:"goal"        validate:"required,oneof=lose_weight gain_weight rehab keep_fit competition" example:"keep_fit"`
-		Description string  `json:"description"                                                                        example:"I want to stay healthy"`
+		FullName      string  `json:"fullName"      validate:"required"                                                          example:"John Doe"`
+		Age           int     `json:"age"           validate:"required,gt=0,lt=130"                                              example:"25"`
+		WeightKg      float32 `json:"weightKg"      validate:"required,gt=0"                                                     example:"75.5"`
+		Goal          string  `json:"goal"          validate:"required,oneof=lose_weight gain_weight rehab keep_fit competition" example:"keep_fit"`
+		Description   string  `json:"description"                                                                                example:"I want to stay healthy"`
+		Timezone      string  `json:"timezone"      validate:"omitempty,timezone"                                                example:"Europe/Kyiv"`
+		HeightCm      float32 `json:"heightCm"      validate:"omitempty,gt=0,lt=300"                                             example:"180"`
+		Sex           string  `json:"sex"           validate:"omitempty,oneof=male female"                                       example:"male"`
+		ActivityLevel string  `json:"activityLevel" validate:"omitempty,oneof=sedentary light moderate active very_active"       example:"moderate"`
 	}
 )
 
@@ -52,12 +56,16 @@ func CreateUserProfileController(
 		}
 
 		cmd := profiles.CreateUserProfileCommand{
-			UserID:      userID,
-			FullName:    req.FullName,
-			Age:         req.Age,
-			WeightKg:    req.WeightKg,
-			Goal:        req.Goal,
-			Description: req.Description,
+			UserID:        userID,
+			FullName:      req.FullName,
+			Age:           req.Age,
+			WeightKg:      req.WeightKg,
+			Goal:          req.Goal,
+			Description:   req.Description,
+			Timezone:      req.Timezone,
+			HeightCm:      req.HeightCm,
+			Sex:           req.Sex,
+			ActivityLevel: req.ActivityLevel,
 		}
 
 		resp, err := handler.CreateUserProfile(r.Context(), cmd)
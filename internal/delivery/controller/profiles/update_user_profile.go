@@ -11,11 +11,15 @@ import (
 
 // UpdateUserProfileRequest is the body for PUT /profiles/user.
 type UpdateUserProfileRequest struct {
-	FullName    string  `json:"fullName"    validate:"required"                                                   example:"John Doe"`
-	Age         int     `json:"age"         validate:"required,gt=0,lt=130"                                       example:"26"`
-	WeightKg    float32 `json:"weightKg"    validate:"required,gt=0"                                              example:"74.0"`
-	Goal        string  `json:"goal"        validate:"required,oneof=lose_weight gain_weight rehab keep_fit competition" example:"lose_weight"`
-	Description string  `json:"description"                                                                        example:"Updated description"`
+	FullName      string  `json:"fullName"      validate:"required"                                                          example:"John Doe"`
+	Age           int     `json:"age"           validate:"required,gt=0,lt=130"                                              example:"26"`
+	WeightKg      float32 `json:"weightKg"      validate:"required,gt=0"                                                     example:"74.0"`
+	Goal          string  `json:"goal"          validate:"required,oneof=lose_weight gain_weight rehab keep_fit competition" example:"lose_weight"`
+	Description   string  `json:"description"                                                                                example:"Updated description"`
+	Timezone      string  `json:"timezone"      validate:"omitempty,timezone"                                                example:"Europe/Kyiv"`
+	HeightCm      float32 `json:"heightCm"      validate:"omitempty,gt=0,lt=300"                                             example:"180"`
+	Sex           string  `json:"sex"           validate:"omitempty,oneof=male female"                                       example:"male"`
+	ActivityLevel string  `json:"activityLevel" validate:"omitempty,oneof=sedentary light moderate active very_active"       example:"moderate"`
 }
 
 type UpdateUserProfileHandler interface {
@@ -44,12 +48,16 @@ func UpdateUserProfileController(io controller.IO, h UpdateUserProfileHandler) h
 			return
 		}
 		res, err := h.UpdateUserProfile(r.Context(), cmdProfiles.UpdateUserProfileCommand{
-			UserID:      userID,
-			FullName:    req.FullName,
-			Age:         req.Age,
-			WeightKg:    req.WeightKg,
-			Goal:        req.Goal,
-			Description: req.Description,
+			UserID:        userID,
+			FullName:      req.FullName,
+			Age:           req.Age,
+			WeightKg:      req.WeightKg,
+			Goal:          req.Goal,
+			Description:   req.Description,
+			Timezone:      req.Timezone,
+			HeightCm:      req.HeightCm,
+			Sex:           req.Sex,
+			ActivityLevel: req.ActivityLevel,
 		})
 		if err != nil {
 			io.Error(err, r, w)
@@ -0,0 +1,62 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdPrograms "github.com/msskobelina/fit-profi/internal/application/command/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// AbandonSessionRequest is the body for POST /programs/sessions/:id/abandon.
+type AbandonSessionRequest struct {
+	Notes string `json:"notes" validate:"max=2000" example:"Shoulder pain"`
+}
+
+type AbandonSessionHandler interface {
+	AbandonSession(context.Context, cmdPrograms.AbandonSessionCommand) (*model.WorkoutSession, error)
+}
+
+// AbandonSessionController godoc
+//
+//	@Summary		Abandon workout session
+//	@Description	Closes one of the authenticated user's sessions in progress without saving any sets.
+//	@Tags			Programs
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int						true	"Session ID"
+//	@Param			body	body		AbandonSessionRequest	true	"Reason"
+//	@Success		200		{object}	model.WorkoutSession
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Failure		409		{object}	controller.ErrorResponse
+//	@Router			/programs/sessions/{id}/abandon [post]
+func AbandonSessionController(io controller.IO, h AbandonSessionHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req AbandonSessionRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.AbandonSession(r.Context(), cmdPrograms.AbandonSessionCommand{
+			SessionID: id,
+			UserID:    userID,
+			Notes:     req.Notes,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,63 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdPrograms "github.com/msskobelina/fit-profi/internal/application/command/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// AssignProgramRequest is the body for POST /programs/:id/assign.
+type AssignProgramRequest struct {
+	ClientIDs []int `json:"clientIds" validate:"required,min=1,dive,gt=0" example:"12,15"`
+}
+
+type AssignProgramHandler interface {
+	AssignProgram(context.Context, cmdPrograms.AssignProgramCommand) ([]model.TrainingProgram, error)
+}
+
+// AssignProgramController godoc
+//
+//	@Summary		Assign training program
+//	@Description	Copies a program authored by the coach to each of the given active clients.
+//	@Tags			Programs
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int						true	"Program ID"
+//	@Param			body	body		AssignProgramRequest	true	"Clients to assign to"
+//	@Success		200		{array}		model.TrainingProgram
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Router			/programs/{id}/assign [post]
+func AssignProgramController(io controller.IO, h AssignProgramHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		idStr := controller.PathParam(r, "id")
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req AssignProgramRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.AssignProgram(r.Context(), cmdPrograms.AssignProgramCommand{
+			ProgramID: id,
+			CoachID:   userID,
+			ClientIDs: req.ClientIDs,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
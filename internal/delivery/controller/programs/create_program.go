@@ -11,9 +11,38 @@ import (
 
 // CreateProgramRequest is the body for POST /programs.
 type CreateProgramRequest struct {
-	Title       string             `json:"title"       validate:"required" example:"Beginner Full Body"`
-	Description string             `json:"description"                     example:"3-day full-body routine"`
-	Days        []model.ProgramDay `json:"days"`
+	Title       string              `json:"title"       validate:"required" example:"Beginner Full Body"`
+	Description string              `json:"description"                     example:"3-day full-body routine"`
+	AssigneeID  int                 `json:"assigneeId"  validate:"gte=0"    example:"12"`
+	Days        []ProgramDayRequest `json:"days"        validate:"max=31,dive"`
+}
+
+type ProgramDayRequest struct {
+	DayNumber int                      `json:"dayNumber" validate:"gte=0,lte=31"    example:"1"`
+	Title     string                   `json:"title"     validate:"max=255"         example:"Day 1"`
+	Exercises []ProgramExerciseRequest `json:"exercises" validate:"max=50,dive"`
+}
+
+// ProgramExerciseRequest prescribes an exercise: reps is the lower end of a
+// range when repsMax is set, load is weightKg or percentOneRm and effort rpe
+// or rir, never both.
+type ProgramExerciseRequest struct {
+	CatalogID       *int     `json:"catalogId"       validate:"omitempty,gt=0"                                       example:"1"`
+	Name            string   `json:"name"            validate:"required_without=CatalogID,max=255"                   example:"Bench press"`
+	Sets            int      `json:"sets"            validate:"gte=0,lte=100"                                        example:"3"`
+	Reps            int      `json:"reps"            validate:"gte=0,lte=1000"                                       example:"8"`
+	RepsMax         int      `json:"repsMax"         validate:"omitempty,gtfield=Reps,lte=1000"                      example:"12"`
+	WeightKg        float64  `json:"weightKg"        validate:"gte=0,lte=1000"                                       example:"62.5"`
+	PercentOneRM    float64  `json:"percentOneRm"    validate:"omitempty,gt=0,lte=150,excluded_with=WeightKg"        example:"75"`
+	RPE             *float64 `json:"rpe"             validate:"omitempty,gte=1,lte=10,excluded_with=RIR"             example:"8"`
+	RIR             *int     `json:"rir"             validate:"omitempty,gte=0,lte=10"                               example:"2"`
+	Tempo           string   `json:"tempo"           validate:"omitempty,tempo"                                      example:"3-1-1-0"`
+	RestSeconds     int      `json:"restSeconds"     validate:"gte=0,lte=3600"                                       example:"90"`
+	DurationSeconds int      `json:"durationSeconds" validate:"gte=0,lte=86400"                                      example:"60"`
+	DistanceMeters  float64  `json:"distanceMeters"  validate:"gte=0,lte=1000000"                                    example:"400"`
+	Group           string   `json:"group"           validate:"required_with=GroupType,max=8"                        example:"A"`
+	GroupType       string   `json:"groupType"       validate:"required_with=Group,omitempty,oneof=superset circuit" example:"superset"`
+	Notes           string   `json:"notes"           validate:"max=2000"                                             example:"Pause at the chest"`
 }
 
 type CreateProgramHandler interface {
@@ -23,7 +52,7 @@ type CreateProgramHandler interface {
 // CreateProgramController godoc
 //
 //	@Summary		Create training program
-//	@Description	Creates a new training program with optional days and exercises.
+//	@Description	Creates a new training program with optional days and exercises. Coaches may set assigneeId to build the program directly for one of their active clients. Exercises may refer to a catalog entry by catalogId, which follows entries merged into another; without it they are linked to the entry matching their name or an alias. They prescribe a rep range (reps to repsMax), load as weightKg or percentOneRm, effort as rpe or rir, tempo, rest, duration and distance. Exercises of a day sharing a group label form a superset or circuit of at least two.
 //	@Tags			Programs
 //	@Security		BearerAuth
 //	@Accept			json
@@ -32,6 +61,7 @@ type CreateProgramHandler interface {
 //	@Success		200		{object}	model.TrainingProgram
 //	@Failure		400		{object}	controller.ErrorResponse
 //	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
 //	@Router			/programs [post]
 func CreateProgramController(io controller.IO, h CreateProgramHandler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -43,9 +73,10 @@ func CreateProgramController(io controller.IO, h CreateProgramHandler) http.Hand
 		}
 		res, err := h.CreateProgram(r.Context(), cmdPrograms.CreateProgramCommand{
 			UserID:      userID,
+			AssigneeID:  req.AssigneeID,
 			Title:       req.Title,
 			Description: req.Description,
-			Days:        req.Days,
+			Days:        programDays(req.Days),
 		})
 		if err != nil {
 			io.Error(err, r, w)
@@ -54,3 +85,35 @@ func CreateProgramController(io controller.IO, h CreateProgramHandler) http.Hand
 		io.Result(res, w)
 	})
 }
+
+func programDays(days []ProgramDayRequest) []model.ProgramDay {
+	res := make([]model.ProgramDay, len(days))
+	for i, d := range days {
+		res[i] = model.ProgramDay{
+			DayNumber: d.DayNumber,
+			Title:     d.Title,
+			Exercises: make([]model.ProgramExercise, len(d.Exercises)),
+		}
+		for j, e := range d.Exercises {
+			res[i].Exercises[j] = model.ProgramExercise{
+				CatalogID:       e.CatalogID,
+				Name:            e.Name,
+				Sets:            e.Sets,
+				Reps:            e.Reps,
+				RepsMax:         e.RepsMax,
+				WeightKg:        e.WeightKg,
+				PercentOneRM:    e.PercentOneRM,
+				RPE:             e.RPE,
+				RIR:             e.RIR,
+				Tempo:           e.Tempo,
+				RestSeconds:     e.RestSeconds,
+				DurationSeconds: e.DurationSeconds,
+				DistanceMeters:  e.DistanceMeters,
+				Group:           e.Group,
+				GroupType:       model.ExerciseGroupType(e.GroupType),
+				Notes:           e.Notes,
+			}
+		}
+	}
+	return res
+}
@@ -59,6 +59,91 @@ func TestCreateProgramController(t *testing.T) {
 			handler:    &mockCreateProgramHandler{result: successResult},
 			wantStatus: http.StatusOK,
 		},
+		{
+			name:       "valid prescription",
+			body:       `{"title":"Strength","days":[{"dayNumber":1,"exercises":[{"name":"Bench press","sets":3,"reps":8,"repsMax":12,"rpe":8,"restSeconds":90,"group":"A","groupType":"superset"},{"catalogId":4,"sets":3,"reps":10,"percentOneRm":70,"rir":2,"tempo":"3-1-1-0","group":"A","groupType":"superset"},{"name":"Plank","durationSeconds":60},{"name":"Run","sets":6,"distanceMeters":400,"weightKg":2.5}]}]}`,
+			userID:     5,
+			handler:    &mockCreateProgramHandler{result: successResult},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "rep range below reps",
+			body:       `{"title":"Strength","days":[{"exercises":[{"name":"Squat","reps":12,"repsMax":8}]}]}`,
+			userID:     5,
+			handler:    &mockCreateProgramHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name:       "weight and percent of 1RM",
+			body:       `{"title":"Strength","days":[{"exercises":[{"name":"Squat","weightKg":100,"percentOneRm":80}]}]}`,
+			userID:     5,
+			handler:    &mockCreateProgramHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name:       "rpe and rir",
+			body:       `{"title":"Strength","days":[{"exercises":[{"name":"Squat","rpe":8,"rir":0}]}]}`,
+			userID:     5,
+			handler:    &mockCreateProgramHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name:       "group without type",
+			body:       `{"title":"Strength","days":[{"exercises":[{"name":"Squat","group":"A"}]}]}`,
+			userID:     5,
+			handler:    &mockCreateProgramHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name:       "explosive tempo",
+			body:       `{"title":"Strength","days":[{"exercises":[{"name":"Squat","tempo":"3-1-X-0"}]}]}`,
+			userID:     5,
+			handler:    &mockCreateProgramHandler{result: successResult},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "malformed tempo",
+			body:       `{"title":"Strength","days":[{"exercises":[{"name":"Squat","tempo":"slow"}]}]}`,
+			userID:     5,
+			handler:    &mockCreateProgramHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name:       "day number past the last day",
+			body:       `{"title":"Strength","days":[{"dayNumber":32}]}`,
+			userID:     5,
+			handler:    &mockCreateProgramHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name:       "exercise without name or catalog entry",
+			body:       `{"title":"Strength","days":[{"exercises":[{"sets":3,"reps":5}]}]}`,
+			userID:     5,
+			handler:    &mockCreateProgramHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
+		{
+			name:       "valid request for client",
+			body:       `{"title":"Client Plan","assigneeId":7}`,
+			userID:     5,
+			handler:    &mockCreateProgramHandler{result: successResult},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "negative assignee",
+			body:       `{"title":"Client Plan","assigneeId":-1}`,
+			userID:     5,
+			handler:    &mockCreateProgramHandler{},
+			wantStatus: http.StatusBadRequest,
+			wantErrKey: "error",
+		},
 		{
 			name:       "missing title",
 			body:       `{"description":"No title here"}`,
@@ -137,6 +222,44 @@ func TestCreateProgramController_UserIDFromContext(t *testing.T) {
 	}
 }
 
+func TestCreateProgramController_MapsPrescription(t *testing.T) {
+	handler := &mockCreateProgramHandler{result: &model.TrainingProgram{ID: 1}}
+	h := programs.CreateProgramController(boundary.New(), handler)
+
+	body := `{"title":"Strength","days":[{"dayNumber":2,"exercises":[{"name":"Bench press","sets":3,"reps":8,"repsMax":12,"weightKg":62.5,"rpe":8.5,"restSeconds":90,"group":"A","groupType":"superset"}]}]}`
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, requestWithUserID(http.MethodPost, "/api/v1/programs", body, 5))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d; body: %s", w.Code, w.Body.String())
+	}
+	days := handler.gotCmd.Days
+	if len(days) != 1 || days[0].DayNumber != 2 || len(days[0].Exercises) != 1 {
+		t.Fatalf("days = %+v", days)
+	}
+	e := days[0].Exercises[0]
+	if e.Reps != 8 || e.RepsMax != 12 || e.WeightKg != 62.5 || e.RPE == nil || *e.RPE != 8.5 ||
+		e.RestSeconds != 90 || e.Group != "A" || e.GroupType != model.GroupSuperset {
+		t.Errorf("exercise = %+v", e)
+	}
+}
+
+func TestCreateProgramController_ConflictMessage(t *testing.T) {
+	h := programs.CreateProgramController(boundary.New(), &mockCreateProgramHandler{})
+
+	body := `{"title":"Strength","days":[{"exercises":[{"name":"Squat","rpe":8,"rir":1}]}]}`
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, requestWithUserID(http.MethodPost, "/api/v1/programs", body, 5))
+
+	var resp map[string]string
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if want := "rpe cannot be combined with rir"; resp["error"] != want {
+		t.Errorf("error = %q, want %q", resp["error"], want)
+	}
+}
+
 type testError struct{ msg string }
 
 func (e *testError) Error() string { return e.msg }
@@ -16,7 +16,7 @@ type DeleteProgramHandler interface {
 // DeleteProgramController godoc
 //
 //	@Summary		Delete training program
-//	@Description	Deletes a training program owned by the authenticated user.
+//	@Description	Deletes a training program the authenticated user owns. Coaches may delete programs they authored for their active clients.
 //	@Tags			Programs
 //	@Security		BearerAuth
 //	@Produce		json
@@ -24,6 +24,7 @@ type DeleteProgramHandler interface {
 //	@Success		204	"No Content"
 //	@Failure		400	{object}	controller.ErrorResponse
 //	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
 //	@Router			/programs/{id} [delete]
 func DeleteProgramController(io controller.IO, h DeleteProgramHandler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,98 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	cmdPrograms "github.com/msskobelina/fit-profi/internal/application/command/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// FinishSessionRequest is the body for POST /programs/sessions/:id/finish.
+type FinishSessionRequest struct {
+	EndedAt string              `json:"endedAt"                                  example:"2024-03-15T19:10:00Z"`
+	Notes   string              `json:"notes"   validate:"max=2000"              example:"Felt strong today"`
+	Sets    []SessionSetRequest `json:"sets"    validate:"required,max=500,dive"`
+}
+
+type SessionSetRequest struct {
+	ExerciseID  int      `json:"exerciseId"  validate:"required,gt=0"            example:"5"`
+	SetNumber   int      `json:"setNumber"   validate:"gte=0,lte=100"            example:"1"`
+	Reps        int      `json:"reps"        validate:"gte=0,lte=1000"           example:"8"`
+	WeightKg    float64  `json:"weightKg"    validate:"gte=0,lte=1000"           example:"82.5"`
+	RPE         *float64 `json:"rpe"         validate:"omitempty,gte=1,lte=10"   example:"8.5"`
+	Tempo       string   `json:"tempo"       validate:"omitempty,tempo"          example:"3-1-1-0"`
+	RestSeconds *int     `json:"restSeconds" validate:"omitempty,gte=0,lte=3600" example:"120"`
+	Completed   bool     `json:"completed"                                       example:"true"`
+}
+
+type FinishSessionHandler interface {
+	FinishSession(context.Context, cmdPrograms.FinishSessionCommand) (*model.WorkoutSession, error)
+}
+
+// FinishSessionController godoc
+//
+//	@Summary		Finish workout session
+//	@Description	Closes one of the authenticated user's sessions in progress and saves the sets performed, all in one transaction. Every set must be of an exercise of the session's program day; sets without setNumber are numbered in order per exercise. endedAt defaults to now. Personal records the completed sets beat are returned in records and the user's coach is notified.
+//	@Tags			Programs
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int						true	"Session ID"
+//	@Param			body	body		FinishSessionRequest	true	"Sets performed"
+//	@Success		200		{object}	model.WorkoutSession
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Failure		409		{object}	controller.ErrorResponse
+//	@Router			/programs/sessions/{id}/finish [post]
+func FinishSessionController(io controller.IO, h FinishSessionHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req FinishSessionRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		cmd := cmdPrograms.FinishSessionCommand{
+			SessionID: id,
+			UserID:    userID,
+			Notes:     req.Notes,
+			Sets:      make([]model.WorkoutSet, len(req.Sets)),
+		}
+		if req.EndedAt != "" {
+			t, err := time.Parse(time.RFC3339, req.EndedAt)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			cmd.EndedAt = t
+		}
+		for i, s := range req.Sets {
+			cmd.Sets[i] = model.WorkoutSet{
+				ExerciseID:  s.ExerciseID,
+				SetNumber:   s.SetNumber,
+				Reps:        s.Reps,
+				WeightKg:    s.WeightKg,
+				RPE:         s.RPE,
+				Tempo:       s.Tempo,
+				RestSeconds: s.RestSeconds,
+				Completed:   s.Completed,
+			}
+		}
+		res, err := h.FinishSession(r.Context(), cmd)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
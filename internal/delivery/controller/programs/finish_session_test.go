@@ -0,0 +1,131 @@
+package programs_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	cmdPrograms "github.com/msskobelina/fit-profi/internal/application/command/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/boundary"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller/programs"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type mockFinishSessionHandler struct {
+	result *model.WorkoutSession
+	err    error
+	gotCmd cmdPrograms.FinishSessionCommand
+}
+
+func (m *mockFinishSessionHandler) FinishSession(_ context.Context, cmd cmdPrograms.FinishSessionCommand) (*model.WorkoutSession, error) {
+	m.gotCmd = cmd
+	return m.result, m.err
+}
+
+func TestFinishSessionController(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		body       string
+		handler    *mockFinishSessionHandler
+		wantStatus int
+	}{
+		{
+			name:       "valid request",
+			id:         "4",
+			body:       `{"endedAt":"2024-03-15T19:10:00Z","sets":[{"exerciseId":5,"reps":8,"weightKg":82.5,"rpe":8.5,"tempo":"3-1-1-0","completed":true}]}`,
+			handler:    &mockFinishSessionHandler{result: &model.WorkoutSession{ID: 4, Status: model.SessionFinished}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "no sets",
+			id:         "4",
+			body:       `{"notes":"Rest day after all"}`,
+			handler:    &mockFinishSessionHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "set without exercise",
+			id:         "4",
+			body:       `{"sets":[{"reps":8,"weightKg":80}]}`,
+			handler:    &mockFinishSessionHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "rpe out of range",
+			id:         "4",
+			body:       `{"sets":[{"exerciseId":5,"reps":8,"rpe":11}]}`,
+			handler:    &mockFinishSessionHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid endedAt",
+			id:         "4",
+			body:       `{"endedAt":"yesterday","sets":[{"exerciseId":5,"reps":8}]}`,
+			handler:    &mockFinishSessionHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid id",
+			id:         "abc",
+			body:       `{"sets":[{"exerciseId":5,"reps":8}]}`,
+			handler:    &mockFinishSessionHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "already closed",
+			id:   "4",
+			body: `{"sets":[{"exerciseId":5,"reps":8}]}`,
+			handler: &mockFinishSessionHandler{
+				err: &utilsErrors.Error{Message: "Workout session is already finished", Status: http.StatusConflict},
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := programs.FinishSessionController(boundary.New(), tt.handler)
+			req := requestWithUserID(http.MethodPost, "/api/v1/programs/sessions/"+tt.id+"/finish", tt.body, 7)
+			req = withPathParam(req, "id", tt.id)
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestFinishSessionController_MapsSets(t *testing.T) {
+	handler := &mockFinishSessionHandler{result: &model.WorkoutSession{ID: 4}}
+	h := programs.FinishSessionController(boundary.New(), handler)
+	body := `{"notes":"Good","sets":[{"exerciseId":5,"setNumber":2,"reps":8,"weightKg":82.5,"rpe":8.5,"restSeconds":120,"completed":true},{"exerciseId":6,"reps":10}]}`
+	req := withPathParam(requestWithUserID(http.MethodPost, "/api/v1/programs/sessions/4/finish", body, 7), "id", "4")
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d; body: %s", w.Code, w.Body.String())
+	}
+	cmd := handler.gotCmd
+	if cmd.SessionID != 4 || cmd.UserID != 7 || cmd.Notes != "Good" || !cmd.EndedAt.IsZero() {
+		t.Errorf("cmd = %+v", cmd)
+	}
+	if len(cmd.Sets) != 2 {
+		t.Fatalf("len(Sets) = %d, want 2", len(cmd.Sets))
+	}
+	first := cmd.Sets[0]
+	if first.ExerciseID != 5 || first.SetNumber != 2 || first.WeightKg != 82.5 || !first.Completed ||
+		first.RPE == nil || *first.RPE != 8.5 || first.RestSeconds == nil || *first.RestSeconds != 120 {
+		t.Errorf("Sets[0] = %+v", first)
+	}
+	if second := cmd.Sets[1]; second.RPE != nil || second.RestSeconds != nil || second.Completed {
+		t.Errorf("Sets[1] = %+v", second)
+	}
+}
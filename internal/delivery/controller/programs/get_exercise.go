@@ -0,0 +1,44 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	qryPrograms "github.com/msskobelina/fit-profi/internal/application/query/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type GetExerciseHandler interface {
+	GetExercise(context.Context, qryPrograms.GetExerciseQuery) (*model.Exercise, error)
+}
+
+// GetExerciseController godoc
+//
+//	@Summary		Get catalog exercise
+//	@Description	Returns an entry of the exercise catalog. Entries merged into another are not found.
+//	@Tags			Programs
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"Catalog exercise ID"
+//	@Success		200	{object}	model.Exercise
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
+//	@Router			/programs/exercises/{id} [get]
+func GetExerciseController(io controller.IO, h GetExerciseHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.GetExercise(r.Context(), qryPrograms.GetExerciseQuery{ExerciseID: id})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
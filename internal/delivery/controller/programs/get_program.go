@@ -17,7 +17,7 @@ type GetProgramHandler interface {
 // GetProgramController godoc
 //
 //	@Summary		Get training program
-//	@Description	Returns a training program by ID.
+//	@Description	Returns a training program by ID. Coaches can read programs of their active clients.
 //	@Tags			Programs
 //	@Security		BearerAuth
 //	@Produce		json
@@ -25,16 +25,18 @@ type GetProgramHandler interface {
 //	@Success		200	{object}	model.TrainingProgram
 //	@Failure		400	{object}	controller.ErrorResponse
 //	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
 //	@Router			/programs/{id} [get]
 func GetProgramController(io controller.IO, h GetProgramHandler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
 		idStr := controller.PathParam(r, "id")
 		id, err := strconv.Atoi(idStr)
 		if err != nil {
 			io.Error(err, r, w)
 			return
 		}
-		res, err := h.GetProgram(r.Context(), qryPrograms.GetProgramQuery{ProgramID: id})
+		res, err := h.GetProgram(r.Context(), qryPrograms.GetProgramQuery{ProgramID: id, UserID: userID})
 		if err != nil {
 			io.Error(err, r, w)
 			return
@@ -0,0 +1,99 @@
+package programs_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	qryPrograms "github.com/msskobelina/fit-profi/internal/application/query/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/boundary"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller/programs"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type mockGetProgramHandler struct {
+	result *model.TrainingProgram
+	err    error
+	gotQry qryPrograms.GetProgramQuery
+}
+
+func (m *mockGetProgramHandler) GetProgram(_ context.Context, q qryPrograms.GetProgramQuery) (*model.TrainingProgram, error) {
+	m.gotQry = q
+	return m.result, m.err
+}
+
+func withPathParam(req *http.Request, name, value string) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), controller.PathParamKey(name), value))
+}
+
+func TestGetProgramController(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		handler    *mockGetProgramHandler
+		wantStatus int
+	}{
+		{
+			name:       "own program",
+			id:         "1",
+			handler:    &mockGetProgramHandler{result: &model.TrainingProgram{ID: 1, UserID: 5}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid id",
+			id:         "abc",
+			handler:    &mockGetProgramHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "someone else's program",
+			id:   "2",
+			handler: &mockGetProgramHandler{
+				err: &utilsErrors.Error{Message: "Program not found", Status: http.StatusNotFound},
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "handler error",
+			id:         "1",
+			handler:    &mockGetProgramHandler{err: &testError{"db error"}},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io := boundary.New()
+			h := programs.GetProgramController(io, tt.handler)
+
+			req := requestWithUserID(http.MethodGet, "/api/v1/programs/"+tt.id, "", 5)
+			req = withPathParam(req, "id", tt.id)
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetProgramController_PassesCaller(t *testing.T) {
+	handler := &mockGetProgramHandler{result: &model.TrainingProgram{ID: 3}}
+	h := programs.GetProgramController(boundary.New(), handler)
+
+	req := withPathParam(requestWithUserID(http.MethodGet, "/api/v1/programs/3", "", 42), "id", "3")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d; body: %s", w.Code, w.Body.String())
+	}
+	if handler.gotQry.UserID != 42 || handler.gotQry.ProgramID != 3 {
+		t.Errorf("query = %+v, want UserID 42 and ProgramID 3", handler.gotQry)
+	}
+}
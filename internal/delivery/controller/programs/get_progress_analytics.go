@@ -0,0 +1,84 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	qryPrograms "github.com/msskobelina/fit-profi/internal/application/query/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type GetProgressAnalyticsHandler interface {
+	GetProgressAnalytics(context.Context, qryPrograms.GetProgressAnalyticsQuery) (*model.ProgressAnalytics, error)
+}
+
+// GetProgressAnalyticsController godoc
+//
+//	@Summary		Get progress analytics
+//	@Description	Returns, per exercise, a weekly or monthly time series of estimated 1RM, total volume and best set built from finished workout sessions and tracked progress. Program exercises of the same catalog entry share one series, carrying catalogId, across all programs. Defaults to the last 12 periods with the Epley formula.
+//	@Tags			Programs
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			exerciseId	query		int		false	"Program exercise ID; includes program exercises of the same catalog entry"
+//	@Param			from		query		string	false	"Window start (RFC3339)"
+//	@Param			to			query		string	false	"Window end (RFC3339)"
+//	@Param			period		query		string	false	"Aggregation period (default week)"		Enums(week, month)
+//	@Param			formula		query		string	false	"1RM estimation formula (default epley)"	Enums(epley, brzycki)
+//	@Param			userId		query		int		false	"Client user ID (coaches only)"
+//	@Success		200			{object}	model.ProgressAnalytics
+//	@Failure		400			{object}	controller.ErrorResponse
+//	@Failure		401			{object}	controller.ErrorResponse
+//	@Failure		403			{object}	controller.ErrorResponse
+//	@Router			/programs/progress/analytics [get]
+func GetProgressAnalyticsController(io controller.IO, h GetProgressAnalyticsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		query := r.URL.Query()
+		q := qryPrograms.GetProgressAnalyticsQuery{
+			UserID:  userID,
+			Period:  model.AnalyticsPeriod(query.Get("period")),
+			Formula: model.OneRMFormula(query.Get("formula")),
+		}
+		if v := query.Get("userId"); v != "" {
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.OwnerID = id
+		}
+		if v := query.Get("exerciseId"); v != "" {
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.ExerciseID = id
+		}
+		if v := query.Get("from"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.From = t
+		}
+		if v := query.Get("to"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.To = t
+		}
+		res, err := h.GetProgressAnalytics(r.Context(), q)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,101 @@
+package programs_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	qryPrograms "github.com/msskobelina/fit-profi/internal/application/query/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/boundary"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller/programs"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type mockGetProgressAnalyticsHandler struct {
+	result *model.ProgressAnalytics
+	err    error
+	gotQ   qryPrograms.GetProgressAnalyticsQuery
+}
+
+func (m *mockGetProgressAnalyticsHandler) GetProgressAnalytics(_ context.Context, q qryPrograms.GetProgressAnalyticsQuery) (*model.ProgressAnalytics, error) {
+	m.gotQ = q
+	return m.result, m.err
+}
+
+func TestGetProgressAnalyticsController(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		handler    *mockGetProgressAnalyticsHandler
+		wantStatus int
+	}{
+		{
+			name:       "defaults",
+			query:      "",
+			handler:    &mockGetProgressAnalyticsHandler{result: &model.ProgressAnalytics{Period: model.PeriodWeek}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "all filters",
+			query:      "?exerciseId=5&period=month&formula=brzycki&from=2024-01-01T00:00:00Z&to=2024-07-01T00:00:00Z&userId=3",
+			handler:    &mockGetProgressAnalyticsHandler{result: &model.ProgressAnalytics{Period: model.PeriodMonth}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid exerciseId",
+			query:      "?exerciseId=squat",
+			handler:    &mockGetProgressAnalyticsHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid from",
+			query:      "?from=2024-01-01",
+			handler:    &mockGetProgressAnalyticsHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "unknown period",
+			query: "?period=day",
+			handler: &mockGetProgressAnalyticsHandler{
+				err: &utilsErrors.Error{Message: "period must be one of: week, month"},
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := programs.GetProgressAnalyticsController(boundary.New(), tt.handler)
+			req := requestWithUserID(http.MethodGet, "/api/v1/programs/progress/analytics"+tt.query, "", 7)
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetProgressAnalyticsController_MapsQuery(t *testing.T) {
+	handler := &mockGetProgressAnalyticsHandler{result: &model.ProgressAnalytics{}}
+	h := programs.GetProgressAnalyticsController(boundary.New(), handler)
+	req := requestWithUserID(http.MethodGet, "/api/v1/programs/progress/analytics?exerciseId=5&period=month&formula=brzycki&userId=3", "", 7)
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d; body: %s", w.Code, w.Body.String())
+	}
+	q := handler.gotQ
+	if q.UserID != 7 || q.OwnerID != 3 || q.ExerciseID != 5 || q.Period != model.PeriodMonth || q.Formula != model.FormulaBrzycki {
+		t.Errorf("query = %+v", q)
+	}
+	if !q.From.IsZero() || !q.To.IsZero() {
+		t.Errorf("window = %v..%v, want zero", q.From, q.To)
+	}
+}
@@ -0,0 +1,45 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	qryPrograms "github.com/msskobelina/fit-profi/internal/application/query/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type GetSessionHandler interface {
+	GetSession(context.Context, qryPrograms.GetSessionQuery) (*model.WorkoutSession, error)
+}
+
+// GetSessionController godoc
+//
+//	@Summary		Get workout session
+//	@Description	Returns a workout session with its sets. Coaches can read the sessions of their active clients.
+//	@Tags			Programs
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"Session ID"
+//	@Success		200	{object}	model.WorkoutSession
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
+//	@Router			/programs/sessions/{id} [get]
+func GetSessionController(io controller.IO, h GetSessionHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.GetSession(r.Context(), qryPrograms.GetSessionQuery{SessionID: id, UserID: userID})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
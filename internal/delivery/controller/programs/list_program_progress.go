@@ -0,0 +1,49 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	qryPrograms "github.com/msskobelina/fit-profi/internal/application/query/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type ListProgramProgressHandler interface {
+	ListProgramProgress(context.Context, qryPrograms.ListProgramProgressQuery) ([]model.ExerciseProgress, error)
+}
+
+// ListProgramProgressController godoc
+//
+//	@Summary		List program progress
+//	@Description	Returns the progress logged against a program. Coaches can read programs of their active clients, and the coach who authored a program also sees the progress of the active clients it was assigned to.
+//	@Tags			Programs
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"Program ID"
+//	@Success		200	{array}		model.ExerciseProgress
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
+//	@Router			/programs/{id}/progress [get]
+func ListProgramProgressController(io controller.IO, h ListProgramProgressHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		idStr := controller.PathParam(r, "id")
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.ListProgramProgress(r.Context(), qryPrograms.ListProgramProgressQuery{
+			ProgramID: id,
+			UserID:    userID,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
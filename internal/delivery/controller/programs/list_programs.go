@@ -3,6 +3,7 @@ package programs
 import (
 	"context"
 	"net/http"
+	"strconv"
 
 	qryPrograms "github.com/msskobelina/fit-profi/internal/application/query/programs"
 	"github.com/msskobelina/fit-profi/internal/delivery/controller"
@@ -16,18 +17,29 @@ type ListProgramsHandler interface {
 // ListProgramsController godoc
 //
 //	@Summary		List training programs
-//	@Description	Returns all training programs belonging to the authenticated user.
+//	@Description	Returns all training programs belonging to the authenticated user, or to one of their active clients when userId is set.
 //	@Tags			Programs
 //	@Security		BearerAuth
 //	@Produce		json
-//	@Success		200	{array}		model.TrainingProgram
-//	@Failure		400	{object}	controller.ErrorResponse
-//	@Failure		401	{object}	controller.ErrorResponse
+//	@Param			userId	query		int	false	"Client user ID (coaches only)"
+//	@Success		200		{array}		model.TrainingProgram
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
 //	@Router			/programs [get]
 func ListProgramsController(io controller.IO, h ListProgramsHandler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		userID, _ := r.Context().Value("userID").(int)
-		res, err := h.ListPrograms(r.Context(), qryPrograms.ListProgramsQuery{UserID: userID})
+		var ownerID int
+		if v := r.URL.Query().Get("userId"); v != "" {
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			ownerID = id
+		}
+		res, err := h.ListPrograms(r.Context(), qryPrograms.ListProgramsQuery{UserID: userID, OwnerID: ownerID})
 		if err != nil {
 			io.Error(err, r, w)
 			return
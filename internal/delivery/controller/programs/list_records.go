@@ -0,0 +1,79 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	qryPrograms "github.com/msskobelina/fit-profi/internal/application/query/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type ListRecordsHandler interface {
+	ListRecords(context.Context, qryPrograms.ListRecordsQuery) ([]model.PersonalRecord, error)
+}
+
+// ListRecordsController godoc
+//
+//	@Summary		List personal records
+//	@Description	Returns the personal record timeline, newest first, of the authenticated user (or of one of their active clients when userId is set). Records are detected when progress is tracked or a workout session is finished, against the history of the exercise in every program when it refers to the exercise catalog.
+//	@Tags			Programs
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			exerciseId	query		int		false	"Program exercise ID; includes program exercises of the same catalog entry"
+//	@Param			kind		query		string	false	"Record kind"	Enums(heaviest_weight, most_reps, best_e1rm, session_volume)
+//	@Param			from		query		string	false	"Window start (RFC3339)"
+//	@Param			to			query		string	false	"Window end (RFC3339)"
+//	@Param			userId		query		int		false	"Client user ID (coaches only)"
+//	@Success		200			{array}		model.PersonalRecord
+//	@Failure		400			{object}	controller.ErrorResponse
+//	@Failure		401			{object}	controller.ErrorResponse
+//	@Failure		403			{object}	controller.ErrorResponse
+//	@Router			/programs/records [get]
+func ListRecordsController(io controller.IO, h ListRecordsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		query := r.URL.Query()
+		q := qryPrograms.ListRecordsQuery{UserID: userID, Kind: model.RecordKind(query.Get("kind"))}
+		if v := query.Get("userId"); v != "" {
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.OwnerID = id
+		}
+		if v := query.Get("exerciseId"); v != "" {
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.ExerciseID = id
+		}
+		if v := query.Get("from"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.From = t
+		}
+		if v := query.Get("to"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.To = t
+		}
+		res, err := h.ListRecords(r.Context(), q)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,57 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	qryPrograms "github.com/msskobelina/fit-profi/internal/application/query/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type ListScheduledWorkoutsHandler interface {
+	ListScheduledWorkouts(ctx context.Context, q qryPrograms.ListScheduledWorkoutsQuery) ([]model.ScheduledWorkout, error)
+}
+
+// ListScheduledWorkoutsController godoc
+//
+//	@Summary		List scheduled workouts
+//	@Description	Returns the authenticated user's scheduled workouts in the window. Defaults to the next 30 days.
+//	@Tags			Programs
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			from	query		string	false	"Window start (RFC3339)"
+//	@Param			to		query		string	false	"Window end (RFC3339)"
+//	@Success		200		{array}		model.ScheduledWorkout
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Router			/programs/schedule [get]
+func ListScheduledWorkoutsController(io controller.IO, h ListScheduledWorkoutsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		q := qryPrograms.ListScheduledWorkoutsQuery{UserID: userID}
+		if v := r.URL.Query().Get("from"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.From = t
+		}
+		if v := r.URL.Query().Get("to"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.To = t
+		}
+		res, err := h.ListScheduledWorkouts(r.Context(), q)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
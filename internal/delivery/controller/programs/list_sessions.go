@@ -0,0 +1,68 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	qryPrograms "github.com/msskobelina/fit-profi/internal/application/query/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type ListSessionsHandler interface {
+	ListSessions(context.Context, qryPrograms.ListSessionsQuery) ([]model.WorkoutSession, error)
+}
+
+// ListSessionsController godoc
+//
+//	@Summary		List workout sessions
+//	@Description	Returns the workout sessions, newest first, that the authenticated user (or one of their active clients when userId is set) started in the window. Defaults to the last 30 days.
+//	@Tags			Programs
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			from	query		string	false	"Window start (RFC3339)"
+//	@Param			to		query		string	false	"Window end (RFC3339)"
+//	@Param			userId	query		int		false	"Client user ID (coaches only)"
+//	@Success		200		{array}		model.WorkoutSession
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		403		{object}	controller.ErrorResponse
+//	@Router			/programs/sessions [get]
+func ListSessionsController(io controller.IO, h ListSessionsHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		q := qryPrograms.ListSessionsQuery{UserID: userID}
+		if v := r.URL.Query().Get("userId"); v != "" {
+			id, err := strconv.Atoi(v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.OwnerID = id
+		}
+		if v := r.URL.Query().Get("from"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.From = t
+		}
+		if v := r.URL.Query().Get("to"); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			q.To = t
+		}
+		res, err := h.ListSessions(r.Context(), q)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,69 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	cmdPrograms "github.com/msskobelina/fit-profi/internal/application/command/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// RescheduleWorkoutRequest is the body for PUT /programs/schedule/{id}.
+type RescheduleWorkoutRequest struct {
+	Start           string `json:"start"           validate:"required"                 example:"2024-03-16T18:00:00Z"`
+	DurationMinutes int    `json:"durationMinutes" validate:"omitempty,gt=0,lte=1440" example:"60"`
+}
+
+type RescheduleWorkoutHandler interface {
+	RescheduleWorkout(ctx context.Context, cmd cmdPrograms.RescheduleWorkoutCommand) (*model.ScheduledWorkout, error)
+}
+
+// RescheduleWorkoutController godoc
+//
+//	@Summary		Reschedule workout
+//	@Description	Moves a scheduled workout of the authenticated user. The duration is kept unless durationMinutes is set.
+//	@Tags			Programs
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"Scheduled workout ID"
+//	@Param			body	body		RescheduleWorkoutRequest	true	"New time"
+//	@Success		200		{object}	model.ScheduledWorkout
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Router			/programs/schedule/{id} [put]
+func RescheduleWorkoutController(io controller.IO, h RescheduleWorkoutHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req RescheduleWorkoutRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		start, err := time.Parse(time.RFC3339, req.Start)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.RescheduleWorkout(r.Context(), cmdPrograms.RescheduleWorkoutCommand{
+			UserID:          userID,
+			WorkoutID:       id,
+			Start:           start,
+			DurationMinutes: req.DurationMinutes,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,73 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	cmdPrograms "github.com/msskobelina/fit-profi/internal/application/command/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// ScheduleWorkoutRequest is the body for POST /programs/{id}/schedule.
+type ScheduleWorkoutRequest struct {
+	DayID           int    `json:"dayId"           validate:"required,gt=0"          example:"3"`
+	Start           string `json:"start"           validate:"required"               example:"2024-03-15T18:00:00Z"`
+	DurationMinutes int    `json:"durationMinutes" validate:"required,gt=0,lte=1440" example:"60"`
+	Notes           string `json:"notes"                                             example:"Before work"`
+}
+
+type ScheduleWorkoutHandler interface {
+	ScheduleWorkout(ctx context.Context, cmd cmdPrograms.ScheduleWorkoutCommand) (*model.ScheduledWorkout, error)
+}
+
+// ScheduleWorkoutController godoc
+//
+//	@Summary		Schedule workout
+//	@Description	Puts a day of one of the authenticated user's programs on their calendar. The event is mirrored to Google Calendar when connected.
+//	@Tags			Programs
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int						true	"Program ID"
+//	@Param			body	body		ScheduleWorkoutRequest	true	"Schedule"
+//	@Success		200		{object}	model.ScheduledWorkout
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Router			/programs/{id}/schedule [post]
+func ScheduleWorkoutController(io controller.IO, h ScheduleWorkoutHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		var req ScheduleWorkoutRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		start, err := time.Parse(time.RFC3339, req.Start)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		res, err := h.ScheduleWorkout(r.Context(), cmdPrograms.ScheduleWorkoutCommand{
+			UserID:          userID,
+			ProgramID:       id,
+			DayID:           req.DayID,
+			Start:           start,
+			DurationMinutes: req.DurationMinutes,
+			Notes:           req.Notes,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -0,0 +1,61 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	qryPrograms "github.com/msskobelina/fit-profi/internal/application/query/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+const (
+	defaultExercisesLimit = 20
+	maxExercisesLimit     = 50
+)
+
+type SearchExercisesHandler interface {
+	SearchExercises(context.Context, qryPrograms.SearchExercisesQuery) ([]model.Exercise, error)
+}
+
+// SearchExercisesController godoc
+//
+//	@Summary		Search exercise catalog
+//	@Description	Full-text search over exercise names and aliases, best matches first, optionally filtered by muscle group (primary or secondary), equipment and movement pattern. Without q entries are listed by name. Pass the entry ID as catalogId when creating a program.
+//	@Tags			Programs
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			q			query		string	false	"Search text"	example(bench)
+//	@Param			muscle		query		string	false	"Muscle group"	Enums(chest, upper_back, lats, lower_back, traps, shoulders, biceps, triceps, forearms, abs, obliques, quads, hamstrings, glutes, adductors, calves)
+//	@Param			equipment	query		string	false	"Equipment"		Enums(barbell, dumbbell, kettlebell, machine, cable, bodyweight, band, other)
+//	@Param			pattern		query		string	false	"Movement pattern"	Enums(horizontal_push, vertical_push, horizontal_pull, vertical_pull, squat, hinge, lunge, carry, core, isolation)
+//	@Param			limit		query		int		false	"Max results (default 20, max 50)"
+//	@Success		200			{array}		model.Exercise
+//	@Failure		400			{object}	controller.ErrorResponse
+//	@Failure		401			{object}	controller.ErrorResponse
+//	@Router			/programs/exercises [get]
+func SearchExercisesController(io controller.IO, h SearchExercisesHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		limit, err := strconv.Atoi(query.Get("limit"))
+		if err != nil || limit <= 0 {
+			limit = defaultExercisesLimit
+		}
+		if limit > maxExercisesLimit {
+			limit = maxExercisesLimit
+		}
+		res, err := h.SearchExercises(r.Context(), qryPrograms.SearchExercisesQuery{
+			Query:     query.Get("q"),
+			Muscle:    model.MuscleGroup(query.Get("muscle")),
+			Equipment: model.Equipment(query.Get("equipment")),
+			Pattern:   model.MovementPattern(query.Get("pattern")),
+			Limit:     limit,
+		})
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
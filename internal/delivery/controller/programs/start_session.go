@@ -0,0 +1,67 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	cmdPrograms "github.com/msskobelina/fit-profi/internal/application/command/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// StartSessionRequest is the body for POST /programs/sessions.
+type StartSessionRequest struct {
+	ProgramID int    `json:"programId" validate:"required,gt=0" example:"1"`
+	DayID     int    `json:"dayId"     validate:"required,gt=0" example:"3"`
+	StartedAt string `json:"startedAt"                          example:"2024-03-15T18:00:00Z"`
+}
+
+type StartSessionHandler interface {
+	StartSession(context.Context, cmdPrograms.StartSessionCommand) (*model.WorkoutSession, error)
+}
+
+// StartSessionController godoc
+//
+//	@Summary		Start workout session
+//	@Description	Starts a workout of a day of one of the authenticated user's programs. startedAt defaults to now. Only one session can be in progress at a time.
+//	@Tags			Programs
+//	@Security		BearerAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			body	body		StartSessionRequest	true	"Program day to perform"
+//	@Success		200		{object}	model.WorkoutSession
+//	@Failure		400		{object}	controller.ErrorResponse
+//	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
+//	@Failure		409		{object}	controller.ErrorResponse
+//	@Router			/programs/sessions [post]
+func StartSessionController(io controller.IO, h StartSessionHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		var req StartSessionRequest
+		if err := io.Read(&req, r.Body); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		cmd := cmdPrograms.StartSessionCommand{
+			UserID:    userID,
+			ProgramID: req.ProgramID,
+			DayID:     req.DayID,
+		}
+		if req.StartedAt != "" {
+			t, err := time.Parse(time.RFC3339, req.StartedAt)
+			if err != nil {
+				io.Error(err, r, w)
+				return
+			}
+			cmd.StartedAt = t
+		}
+		res, err := h.StartSession(r.Context(), cmd)
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		io.Result(res, w)
+	})
+}
@@ -11,11 +11,11 @@ import (
 
 // TrackProgressRequest is the body for POST /programs/progress.
 type TrackProgressRequest struct {
-	ExerciseID int    `json:"exerciseId" validate:"required,gt=0" example:"5"`
-	Sets       int    `json:"sets"       validate:"required,gt=0" example:"3"`
-	Reps       int    `json:"reps"       validate:"required,gt=0" example:"12"`
-	WeightKg   int    `json:"weightKg"   validate:"gte=0"         example:"60"`
-	Notes      string `json:"notes"                               example:"Felt strong today"`
+	ExerciseID int     `json:"exerciseId" validate:"required,gt=0"  example:"5"`
+	Sets       int     `json:"sets"       validate:"required,gt=0"  example:"3"`
+	Reps       int     `json:"reps"       validate:"required,gt=0"  example:"12"`
+	WeightKg   float64 `json:"weightKg"   validate:"gte=0,lte=1000" example:"62.5"`
+	Notes      string  `json:"notes"                                example:"Felt strong today"`
 }
 
 type TrackProgressHandler interface {
@@ -25,7 +25,7 @@ type TrackProgressHandler interface {
 // TrackProgressController godoc
 //
 //	@Summary		Track exercise progress
-//	@Description	Records a completed set of an exercise for the authenticated user.
+//	@Description	Records a completed set of an exercise from one of the authenticated user's programs. Workout sessions record whole workouts set by set and are preferred; this endpoint stays for clients that log aggregate sets. Personal records the sets beat are returned in records and the user's coach is notified.
 //	@Tags			Programs
 //	@Security		BearerAuth
 //	@Accept			json
@@ -34,6 +34,7 @@ type TrackProgressHandler interface {
 //	@Success		200		{object}	model.ExerciseProgress
 //	@Failure		400		{object}	controller.ErrorResponse
 //	@Failure		401		{object}	controller.ErrorResponse
+//	@Failure		404		{object}	controller.ErrorResponse
 //	@Router			/programs/progress [post]
 func TrackProgressController(io controller.IO, h TrackProgressHandler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
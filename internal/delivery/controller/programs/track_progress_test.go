@@ -0,0 +1,99 @@
+package programs_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	cmdPrograms "github.com/msskobelina/fit-profi/internal/application/command/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/boundary"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller/programs"
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+type mockTrackProgressHandler struct {
+	result *model.ExerciseProgress
+	err    error
+	gotCmd cmdPrograms.TrackProgressCommand
+}
+
+func (m *mockTrackProgressHandler) TrackProgress(_ context.Context, cmd cmdPrograms.TrackProgressCommand) (*model.ExerciseProgress, error) {
+	m.gotCmd = cmd
+	return m.result, m.err
+}
+
+func TestTrackProgressController(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		handler    *mockTrackProgressHandler
+		wantStatus int
+		wantWeight float64
+	}{
+		{
+			name:       "valid request",
+			body:       `{"exerciseId":5,"sets":3,"reps":12,"weightKg":60}`,
+			handler:    &mockTrackProgressHandler{result: &model.ExerciseProgress{ID: 1}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "fractional weight",
+			body:       `{"exerciseId":5,"sets":3,"reps":12,"weightKg":62.5}`,
+			handler:    &mockTrackProgressHandler{result: &model.ExerciseProgress{ID: 1}},
+			wantStatus: http.StatusOK,
+			wantWeight: 62.5,
+		},
+		{
+			name:       "weight out of range",
+			body:       `{"exerciseId":5,"sets":3,"reps":12,"weightKg":1200}`,
+			handler:    &mockTrackProgressHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing exercise",
+			body:       `{"sets":3,"reps":12}`,
+			handler:    &mockTrackProgressHandler{},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "exercise from another user's program",
+			body: `{"exerciseId":9,"sets":3,"reps":12}`,
+			handler: &mockTrackProgressHandler{
+				err: &utilsErrors.Error{Message: "Exercise not found", Status: http.StatusNotFound},
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "forbidden",
+			body: `{"exerciseId":9,"sets":3,"reps":12}`,
+			handler: &mockTrackProgressHandler{
+				err: &utilsErrors.Error{Message: "Access denied", Status: http.StatusForbidden},
+			},
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io := boundary.New()
+			h := programs.TrackProgressController(io, tt.handler)
+
+			req := requestWithUserID(http.MethodPost, "/api/v1/programs/progress", tt.body, 5)
+			w := httptest.NewRecorder()
+
+			h.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d; body: %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if tt.wantStatus == http.StatusOK && tt.handler.gotCmd.UserID != 5 {
+				t.Errorf("UserID = %d, want 5", tt.handler.gotCmd.UserID)
+			}
+			if tt.wantWeight != 0 && tt.handler.gotCmd.WeightKg != tt.wantWeight {
+				t.Errorf("WeightKg = %v, want %v", tt.handler.gotCmd.WeightKg, tt.wantWeight)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package programs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	cmdPrograms "github.com/msskobelina/fit-profi/internal/application/command/programs"
+	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+)
+
+type UnscheduleWorkoutHandler interface {
+	UnscheduleWorkout(ctx context.Context, cmd cmdPrograms.UnscheduleWorkoutCommand) error
+}
+
+// UnscheduleWorkoutController godoc
+//
+//	@Summary		Unschedule workout
+//	@Description	Removes a scheduled workout of the authenticated user.
+//	@Tags			Programs
+//	@Security		BearerAuth
+//	@Produce		json
+//	@Param			id	path		int	true	"Scheduled workout ID"
+//	@Success		204	"No Content"
+//	@Failure		400	{object}	controller.ErrorResponse
+//	@Failure		401	{object}	controller.ErrorResponse
+//	@Failure		404	{object}	controller.ErrorResponse
+//	@Router			/programs/schedule/{id} [delete]
+func UnscheduleWorkoutController(io controller.IO, h UnscheduleWorkoutHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID, _ := r.Context().Value("userID").(int)
+		id, err := strconv.Atoi(controller.PathParam(r, "id"))
+		if err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		if err := h.UnscheduleWorkout(r.Context(), cmdPrograms.UnscheduleWorkoutCommand{
+			UserID:    userID,
+			WorkoutID: id,
+		}); err != nil {
+			io.Error(err, r, w)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
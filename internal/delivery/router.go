@@ -7,8 +7,11 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/msskobelina/fit-profi/internal/delivery/controller"
+	ctrlAdmin "github.com/msskobelina/fit-profi/internal/delivery/controller/admin"
 	ctrlAuthorize "github.com/msskobelina/fit-profi/internal/delivery/controller/authorize"
 	ctrlCalendar "github.com/msskobelina/fit-profi/internal/delivery/controller/calendar"
+	ctrlCardio "github.com/msskobelina/fit-profi/internal/delivery/controller/cardio"
+	ctrlCoaching "github.com/msskobelina/fit-profi/internal/delivery/controller/coaching"
 	ctrlIntegrations "github.com/msskobelina/fit-profi/internal/delivery/controller/integrations"
 	ctrlNutrition "github.com/msskobelina/fit-profi/internal/delivery/controller/nutrition"
 	ctrlProfiles "github.com/msskobelina/fit-profi/internal/delivery/controller/profiles"
@@ -22,6 +25,16 @@ type App interface {
 	ctrlAuthorize.LogoutHandler
 	ctrlAuthorize.SendEmailHandler
 	ctrlAuthorize.ResetPasswordHandler
+	ctrlAuthorize.RefreshTokenHandler
+	// admin
+	ctrlAdmin.ListUsersHandler
+	ctrlAdmin.ChangeUserRoleHandler
+	ctrlAdmin.SetUserStatusHandler
+	ctrlAdmin.DeleteUserHandler
+	ctrlAdmin.ListFoodSubmissionsHandler
+	ctrlAdmin.ApproveFoodSubmissionHandler
+	ctrlAdmin.RejectFoodSubmissionHandler
+	ctrlAdmin.MergeExercisesHandler
 	// profiles
 	ctrlProfiles.CreateUserProfileHandler
 	ctrlProfiles.UpdateUserProfileHandler
@@ -29,24 +42,88 @@ type App interface {
 	ctrlProfiles.CreateCoachProfileHandler
 	ctrlProfiles.UpdateCoachProfileHandler
 	ctrlProfiles.GetCoachProfileHandler
+	// coaching
+	ctrlCoaching.InviteClientHandler
+	ctrlCoaching.ListInvitationsHandler
+	ctrlCoaching.RespondInvitationHandler
+	ctrlCoaching.TerminateRelationshipHandler
+	ctrlCoaching.ListClientsHandler
+	ctrlCoaching.GetCoachHandler
 	// programs
 	ctrlPrograms.CreateProgramHandler
 	ctrlPrograms.GetProgramHandler
 	ctrlPrograms.ListProgramsHandler
 	ctrlPrograms.DeleteProgramHandler
 	ctrlPrograms.TrackProgressHandler
+	ctrlPrograms.AssignProgramHandler
+	ctrlPrograms.ListProgramProgressHandler
+	ctrlPrograms.ScheduleWorkoutHandler
+	ctrlPrograms.RescheduleWorkoutHandler
+	ctrlPrograms.UnscheduleWorkoutHandler
+	ctrlPrograms.ListScheduledWorkoutsHandler
+	ctrlPrograms.StartSessionHandler
+	ctrlPrograms.FinishSessionHandler
+	ctrlPrograms.AbandonSessionHandler
+	ctrlPrograms.GetSessionHandler
+	ctrlPrograms.ListSessionsHandler
+	ctrlPrograms.ListProgressHandler
+	ctrlPrograms.GetProgressAnalyticsHandler
+	ctrlPrograms.ListRecordsHandler
+	ctrlPrograms.SearchExercisesHandler
+	ctrlPrograms.GetExerciseHandler
 	// nutrition
 	ctrlNutrition.CreateEntryHandler
 	ctrlNutrition.ListEntriesHandler
 	ctrlNutrition.GetEntryHandler
 	ctrlNutrition.UpdateEntryHandler
 	ctrlNutrition.DeleteEntryHandler
+	ctrlNutrition.AddItemHandler
+	ctrlNutrition.UpdateItemHandler
+	ctrlNutrition.DeleteItemHandler
+	ctrlNutrition.CopyEntriesHandler
+	ctrlNutrition.GetSummaryHandler
+	ctrlNutrition.GetTargetsHandler
+	ctrlNutrition.SetTargetsHandler
+	ctrlNutrition.ClearTargetsHandler
+	ctrlNutrition.GetLimitsHandler
+	ctrlNutrition.SetLimitsHandler
+	ctrlNutrition.LogHydrationHandler
+	ctrlNutrition.ListHydrationHandler
+	ctrlNutrition.DeleteHydrationHandler
+	ctrlNutrition.SearchFoodsHandler
+	ctrlNutrition.LookupBarcodeHandler
+	ctrlNutrition.SubmitFoodHandler
+	ctrlNutrition.CreateRecipeHandler
+	ctrlNutrition.ListRecipesHandler
+	ctrlNutrition.GetRecipeHandler
+	ctrlNutrition.UpdateRecipeHandler
+	ctrlNutrition.DeleteRecipeHandler
+	ctrlNutrition.ShareRecipeHandler
+	ctrlNutrition.LogRecipeHandler
 	// integrations
-	ctrlIntegrations.ConnectGoogleHandler
+	ctrlIntegrations.ConnectHandler
 	ctrlIntegrations.ExchangeCallbackHandler
+	ctrlIntegrations.DisconnectHandler
+	ctrlIntegrations.ListIntegrationsHandler
+	ctrlIntegrations.SelectGoogleCalendarHandler
+	ctrlIntegrations.ImportStravaHandler
+	// cardio
+	ctrlCardio.ListCardioSessionsHandler
 	// calendar
 	ctrlCalendar.ListCalendarsHandler
 	ctrlCalendar.CreateEventHandler
+	ctrlCalendar.AddAvailabilityHandler
+	ctrlCalendar.ListAvailabilityHandler
+	ctrlCalendar.BookSessionHandler
+	ctrlCalendar.CancelBookingHandler
+	ctrlCalendar.RescheduleBookingHandler
+	ctrlCalendar.ListUpcomingSessionsHandler
+	ctrlCalendar.CreateAvailabilityRuleHandler
+	ctrlCalendar.ListAvailabilityRulesHandler
+	ctrlCalendar.DeleteAvailabilityRuleHandler
+	ctrlCalendar.AddAvailabilityExceptionHandler
+	ctrlCalendar.ListAvailabilityExceptionsHandler
+	ctrlCalendar.DeleteAvailabilityExceptionHandler
 }
 
 func wrap(h http.Handler, params ...string) echo.HandlerFunc {
@@ -69,12 +146,24 @@ func Register(e *echo.Echo, app App, io controller.IO, authMW echo.MiddlewareFun
 	users.POST("/login", wrap(ctrlAuthorize.LoginController(io, app)))
 	users.POST("/send-email", wrap(ctrlAuthorize.SendEmailController(io, app)))
 	users.PATCH("/reset-password", wrap(ctrlAuthorize.ResetPasswordController(io, app)))
+	users.POST("/token/refresh", wrap(ctrlAuthorize.RefreshTokenController(io, app)))
 
 	// users (private)
 	usersPriv := v1.Group("/users", authMW)
 	usersPriv.POST("/logout", wrap(ctrlAuthorize.LogoutController(io, app)))
 	usersPriv.GET("/check", wrap(ctrlAuthorize.CheckController(io)))
 
+	// admin
+	adm := v1.Group("/admin", authMW, RequireAdmin)
+	adm.GET("/users", wrap(ctrlAdmin.ListUsersController(io, app)))
+	adm.PATCH("/users/:id/role", wrap(ctrlAdmin.ChangeUserRoleController(io, app), "id"))
+	adm.PATCH("/users/:id/status", wrap(ctrlAdmin.SetUserStatusController(io, app), "id"))
+	adm.DELETE("/users/:id", wrap(ctrlAdmin.DeleteUserController(io, app), "id"))
+	adm.GET("/food-submissions", wrap(ctrlAdmin.ListFoodSubmissionsController(io, app)))
+	adm.POST("/food-submissions/:id/approve", wrap(ctrlAdmin.ApproveFoodSubmissionController(io, app), "id"))
+	adm.POST("/food-submissions/:id/reject", wrap(ctrlAdmin.RejectFoodSubmissionController(io, app), "id"))
+	adm.POST("/exercises/:id/merge", wrap(ctrlAdmin.MergeExercisesController(io, app), "id"))
+
 	// profiles
 	prof := v1.Group("/profiles", authMW)
 	prof.POST("/user", wrap(ctrlProfiles.CreateUserProfileController(io, app)))
@@ -84,6 +173,16 @@ func Register(e *echo.Echo, app App, io controller.IO, authMW echo.MiddlewareFun
 	prof.GET("/coach", wrap(ctrlProfiles.GetCoachProfileController(io, app)))
 	prof.PUT("/coach", wrap(ctrlProfiles.UpdateCoachProfileController(io, app)))
 
+	// coaching
+	coach := v1.Group("/coaching", authMW)
+	coach.POST("/invitations", wrap(ctrlCoaching.InviteClientController(io, app)))
+	coach.GET("/invitations", wrap(ctrlCoaching.ListInvitationsController(io, app)))
+	coach.POST("/invitations/:id/accept", wrap(ctrlCoaching.AcceptInvitationController(io, app), "id"))
+	coach.POST("/invitations/:id/reject", wrap(ctrlCoaching.RejectInvitationController(io, app), "id"))
+	coach.POST("/relationships/:id/terminate", wrap(ctrlCoaching.TerminateRelationshipController(io, app), "id"))
+	coach.GET("/clients", wrap(ctrlCoaching.ListClientsController(io, app)))
+	coach.GET("/coach", wrap(ctrlCoaching.GetCoachController(io, app)))
+
 	// programs
 	prog := v1.Group("/programs", authMW)
 	prog.POST("", wrap(ctrlPrograms.CreateProgramController(io, app)))
@@ -91,6 +190,22 @@ func Register(e *echo.Echo, app App, io controller.IO, authMW echo.MiddlewareFun
 	prog.GET("", wrap(ctrlPrograms.ListProgramsController(io, app)))
 	prog.DELETE("/:id", wrap(ctrlPrograms.DeleteProgramController(io, app), "id"))
 	prog.POST("/progress", wrap(ctrlPrograms.TrackProgressController(io, app)))
+	prog.GET("/progress", wrap(ctrlPrograms.ListProgressController(io, app)))
+	prog.GET("/progress/analytics", wrap(ctrlPrograms.GetProgressAnalyticsController(io, app)))
+	prog.GET("/records", wrap(ctrlPrograms.ListRecordsController(io, app)))
+	prog.GET("/exercises", wrap(ctrlPrograms.SearchExercisesController(io, app)))
+	prog.GET("/exercises/:id", wrap(ctrlPrograms.GetExerciseController(io, app), "id"))
+	prog.POST("/:id/assign", wrap(ctrlPrograms.AssignProgramController(io, app), "id"))
+	prog.GET("/:id/progress", wrap(ctrlPrograms.ListProgramProgressController(io, app), "id"))
+	prog.POST("/:id/schedule", wrap(ctrlPrograms.ScheduleWorkoutController(io, app), "id"))
+	prog.GET("/schedule", wrap(ctrlPrograms.ListScheduledWorkoutsController(io, app)))
+	prog.PUT("/schedule/:id", wrap(ctrlPrograms.RescheduleWorkoutController(io, app), "id"))
+	prog.DELETE("/schedule/:id", wrap(ctrlPrograms.UnscheduleWorkoutController(io, app), "id"))
+	prog.POST("/sessions", wrap(ctrlPrograms.StartSessionController(io, app)))
+	prog.GET("/sessions", wrap(ctrlPrograms.ListSessionsController(io, app)))
+	prog.GET("/sessions/:id", wrap(ctrlPrograms.GetSessionController(io, app), "id"))
+	prog.POST("/sessions/:id/finish", wrap(ctrlPrograms.FinishSessionController(io, app), "id"))
+	prog.POST("/sessions/:id/abandon", wrap(ctrlPrograms.AbandonSessionController(io, app), "id"))
 
 	// nutrition
 	nutr := v1.Group("/nutrition", authMW)
@@ -99,14 +214,58 @@ func Register(e *echo.Echo, app App, io controller.IO, authMW echo.MiddlewareFun
 	nutr.GET("/entries/:id", wrap(ctrlNutrition.GetEntryController(io, app), "id"))
 	nutr.PUT("/entries/:id", wrap(ctrlNutrition.UpdateEntryController(io, app), "id"))
 	nutr.DELETE("/entries/:id", wrap(ctrlNutrition.DeleteEntryController(io, app), "id"))
+	nutr.POST("/entries/copy", wrap(ctrlNutrition.CopyEntriesController(io, app)))
+	nutr.POST("/entries/:id/items", wrap(ctrlNutrition.AddItemController(io, app), "id"))
+	nutr.PATCH("/entries/:id/items/:itemId", wrap(ctrlNutrition.UpdateItemController(io, app), "id", "itemId"))
+	nutr.DELETE("/entries/:id/items/:itemId", wrap(ctrlNutrition.DeleteItemController(io, app), "id", "itemId"))
+	nutr.GET("/summary", wrap(ctrlNutrition.GetSummaryController(io, app)))
+	nutr.GET("/targets", wrap(ctrlNutrition.GetTargetsController(io, app)))
+	nutr.PUT("/targets/:clientId", wrap(ctrlNutrition.SetTargetsController(io, app), "clientId"))
+	nutr.DELETE("/targets/:clientId", wrap(ctrlNutrition.ClearTargetsController(io, app), "clientId"))
+	nutr.GET("/limits", wrap(ctrlNutrition.GetLimitsController(io, app)))
+	nutr.PUT("/limits", wrap(ctrlNutrition.SetLimitsController(io, app)))
+	nutr.POST("/hydration", wrap(ctrlNutrition.LogHydrationController(io, app)))
+	nutr.GET("/hydration", wrap(ctrlNutrition.ListHydrationController(io, app)))
+	nutr.DELETE("/hydration/:id", wrap(ctrlNutrition.DeleteHydrationController(io, app), "id"))
+	nutr.GET("/foods", wrap(ctrlNutrition.SearchFoodsController(io, app)))
+	nutr.GET("/foods/barcode/:ean", wrap(ctrlNutrition.LookupBarcodeController(io, app), "ean"))
+	nutr.POST("/foods/submissions", wrap(ctrlNutrition.SubmitFoodController(io, app)))
+	nutr.POST("/recipes", wrap(ctrlNutrition.CreateRecipeController(io, app)))
+	nutr.GET("/recipes", wrap(ctrlNutrition.ListRecipesController(io, app)))
+	nutr.GET("/recipes/:id", wrap(ctrlNutrition.GetRecipeController(io, app), "id"))
+	nutr.PUT("/recipes/:id", wrap(ctrlNutrition.UpdateRecipeController(io, app), "id"))
+	nutr.DELETE("/recipes/:id", wrap(ctrlNutrition.DeleteRecipeController(io, app), "id"))
+	nutr.POST("/recipes/:id/share", wrap(ctrlNutrition.ShareRecipeController(io, app), "id"))
+	nutr.POST("/recipes/:id/entries", wrap(ctrlNutrition.LogRecipeController(io, app), "id"))
 
 	// integrations
-	v1.GET("/integrations/google/callback", wrap(ctrlIntegrations.ExchangeCallbackController(io, app)))
-	integ := v1.Group("/integrations/google", authMW)
-	integ.GET("/connect", wrap(ctrlIntegrations.ConnectGoogleController(io, app)))
+	v1.GET("/integrations/:provider/callback", wrap(ctrlIntegrations.ExchangeCallbackController(io, app), "provider"))
+	integ := v1.Group("/integrations", authMW)
+	integ.GET("", wrap(ctrlIntegrations.ListIntegrationsController(io, app)))
+	integ.GET("/:provider/connect", wrap(ctrlIntegrations.ConnectController(io, app), "provider"))
+	integ.POST("/:provider/disconnect", wrap(ctrlIntegrations.DisconnectController(io, app), "provider"))
+	integ.DELETE("/:provider", wrap(ctrlIntegrations.DisconnectController(io, app), "provider"))
+	integ.PUT("/google", wrap(ctrlIntegrations.SelectGoogleCalendarController(io, app)))
+	integ.POST("/strava/import", wrap(ctrlIntegrations.ImportStravaController(io, app)))
+
+	// cardio
+	cardio := v1.Group("/cardio", authMW)
+	cardio.GET("/sessions", wrap(ctrlCardio.ListCardioSessionsController(io, app)))
 
 	// calendar
 	cal := v1.Group("/calendar", authMW)
 	cal.GET("/list", wrap(ctrlCalendar.ListCalendarsController(io, app)))
 	cal.POST("/me/events", wrap(ctrlCalendar.CreateEventController(io, app)))
+	cal.POST("/availability", wrap(ctrlCalendar.AddAvailabilityController(io, app)))
+	cal.GET("/availability", wrap(ctrlCalendar.ListAvailabilityController(io, app)))
+	cal.POST("/availability/rules", wrap(ctrlCalendar.CreateAvailabilityRuleController(io, app)))
+	cal.GET("/availability/rules", wrap(ctrlCalendar.ListAvailabilityRulesController(io, app)))
+	cal.DELETE("/availability/rules/:id", wrap(ctrlCalendar.DeleteAvailabilityRuleController(io, app), "id"))
+	cal.POST("/availability/exceptions", wrap(ctrlCalendar.AddAvailabilityExceptionController(io, app)))
+	cal.GET("/availability/exceptions", wrap(ctrlCalendar.ListAvailabilityExceptionsController(io, app)))
+	cal.DELETE("/availability/exceptions/:id", wrap(ctrlCalendar.DeleteAvailabilityExceptionController(io, app), "id"))
+	cal.POST("/bookings", wrap(ctrlCalendar.BookSessionController(io, app)))
+	cal.GET("/bookings/upcoming", wrap(ctrlCalendar.ListUpcomingSessionsController(io, app)))
+	cal.POST("/bookings/:id/cancel", wrap(ctrlCalendar.CancelBookingController(io, app), "id"))
+	cal.POST("/bookings/:id/reschedule", wrap(ctrlCalendar.RescheduleBookingController(io, app), "id"))
 }
@@ -4,14 +4,133 @@ import (
 	"time"
 
 	"github.com/msskobelina/fit-profi/pkg/mysql"
+	"github.com/msskobelina/fit-profi/pkg/rrule"
 )
 
 type CoachAvailability struct {
 	ID        int       `json:"id,omitempty" gorm:"primaryKey"`
 	CoachID   int       `json:"coachId" gorm:"index;not null"`
+	RuleID    *int      `json:"ruleId,omitempty" gorm:"index"`
 	StartTime time.Time `json:"startTime" gorm:"index;not null"`
 	EndTime   time.Time `json:"endTime" gorm:"not null"`
 	Notes     string    `json:"notes" gorm:"type:text"`
 
 	mysql.Model
 }
+
+type BookingStatus string
+
+const (
+	BookingBooked    BookingStatus = "booked"
+	BookingCancelled BookingStatus = "cancelled"
+)
+
+// Booking reserves a coach availability slot for a client. Start and end are
+// copied from the slot so overlap checks don't need to join availability.
+type Booking struct {
+	ID             int           `json:"id,omitempty" gorm:"primaryKey"`
+	AvailabilityID int           `json:"availabilityId" gorm:"index;not null"`
+	CoachID        int           `json:"coachId" gorm:"index;not null"`
+	ClientID       int           `json:"clientId" gorm:"index;not null"`
+	StartTime      time.Time     `json:"startTime" gorm:"index;not null"`
+	EndTime        time.Time     `json:"endTime" gorm:"not null"`
+	Status         BookingStatus `json:"status" gorm:"type:enum('booked','cancelled');default:'booked';not null;index"`
+	Notes          string        `json:"notes" gorm:"type:text"`
+	CancelledAt    *time.Time    `json:"cancelledAt,omitempty"`
+	CancelledBy    *int          `json:"cancelledBy,omitempty"`
+
+	mysql.Model
+}
+
+// AvailabilityRule is a recurring availability window. Start is the first
+// occurrence as wall-clock time in Timezone (an RFC 5545 DTSTART without a
+// zone) and RRule the recurrence, e.g. "FREQ=WEEKLY;BYDAY=MO,WE".
+type AvailabilityRule struct {
+	ID              int    `json:"id,omitempty" gorm:"primaryKey"`
+	CoachID         int    `json:"coachId" gorm:"index;not null"`
+	Start           string `json:"start" gorm:"size:19;not null"`
+	DurationMinutes int    `json:"durationMinutes" gorm:"not null"`
+	Timezone        string `json:"timezone" gorm:"size:64;not null"`
+	RRule           string `json:"rrule" gorm:"size:255;not null"`
+	Notes           string `json:"notes" gorm:"type:text"`
+
+	mysql.Model
+}
+
+// AvailabilityRuleLayout is the format of AvailabilityRule.Start.
+const AvailabilityRuleLayout = "2006-01-02T15:04:05"
+
+// AvailabilityException removes a local date from a single rule or, without a
+// RuleID, from all of the coach's rules (a holiday).
+type AvailabilityException struct {
+	ID      int    `json:"id,omitempty" gorm:"primaryKey"`
+	CoachID int    `json:"coachId" gorm:"index;not null"`
+	RuleID  *int   `json:"ruleId,omitempty" gorm:"index"`
+	Date    string `json:"date" gorm:"size:10;not null"`
+	Reason  string `json:"reason"`
+
+	mysql.Model
+}
+
+// Expand returns the rule's occurrences in [from, to) as unsaved slots,
+// skipping dates covered by exceptions.
+func (r AvailabilityRule) Expand(from, to time.Time, exceptions []AvailabilityException) ([]CoachAvailability, error) {
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	dtstart, err := time.ParseInLocation(AvailabilityRuleLayout, r.Start, loc)
+	if err != nil {
+		return nil, err
+	}
+	rule, err := rrule.Parse(r.RRule, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := make(map[string]bool)
+	for _, ex := range exceptions {
+		if ex.RuleID == nil || *ex.RuleID == r.ID {
+			skip[ex.Date] = true
+		}
+	}
+
+	duration := time.Duration(r.DurationMinutes) * time.Minute
+	ruleID := r.ID
+	var res []CoachAvailability
+	for _, start := range rule.Between(dtstart, from, to) {
+		end := start.Add(duration)
+		if end.After(to) || skip[start.Format("2006-01-02")] {
+			continue
+		}
+		res = append(res, CoachAvailability{
+			CoachID:   r.CoachID,
+			RuleID:    &ruleID,
+			StartTime: start.UTC(),
+			EndTime:   end.UTC(),
+			Notes:     r.Notes,
+		})
+	}
+	return res, nil
+}
+
+type CalendarSource string
+
+const (
+	CalendarSourceBooking CalendarSource = "booking"
+	CalendarSourceWorkout CalendarSource = "workout"
+)
+
+// CalendarEventLink remembers the Google Calendar event created for a booking
+// or scheduled workout in one user's calendar, so later syncs update or delete
+// that event instead of creating another one.
+type CalendarEventLink struct {
+	ID         int            `json:"id,omitempty" gorm:"primaryKey"`
+	UserID     int            `json:"userId" gorm:"uniqueIndex:idx_calendar_event_source;not null"`
+	SourceType CalendarSource `json:"sourceType" gorm:"type:varchar(16);uniqueIndex:idx_calendar_event_source;not null"`
+	SourceID   int            `json:"sourceId" gorm:"uniqueIndex:idx_calendar_event_source;not null"`
+	CalendarID string         `json:"calendarId" gorm:"type:varchar(256);not null"`
+	EventID    string         `json:"eventId" gorm:"type:varchar(1024);not null"`
+
+	mysql.Model
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+type CardioSource string
+
+const CardioSourceStrava CardioSource = "strava"
+
+// CardioSession is a run, ride or other endurance activity imported from a
+// tracking service. ExternalID is the activity ID at the source, so importing
+// the same activity twice updates the existing row.
+type CardioSession struct {
+	ID                  int          `json:"id,omitempty" gorm:"primaryKey"`
+	UserID              int          `json:"userId" gorm:"not null;uniqueIndex:idx_cardio_external"`
+	Source              CardioSource `json:"source" gorm:"type:enum('strava');not null;uniqueIndex:idx_cardio_external"`
+	ExternalID          string       `json:"externalId" gorm:"type:varchar(64);not null;uniqueIndex:idx_cardio_external"`
+	SportType           string       `json:"sportType" gorm:"type:varchar(32)"`
+	Name                string       `json:"name" gorm:"type:varchar(255)"`
+	StartTime           time.Time    `json:"startTime" gorm:"index;not null"`
+	DistanceMeters      float64      `json:"distanceMeters"`
+	MovingSeconds       int          `json:"movingSeconds"`
+	ElapsedSeconds      int          `json:"elapsedSeconds"`
+	AvgHeartRate        *float64     `json:"avgHeartRate,omitempty"`
+	MaxHeartRate        *float64     `json:"maxHeartRate,omitempty"`
+	ElevationGainMeters float64      `json:"elevationGainMeters"`
+
+	mysql.Model
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+type CoachingStatus string
+
+const (
+	CoachingPending    CoachingStatus = "pending"
+	CoachingActive     CoachingStatus = "active"
+	CoachingRejected   CoachingStatus = "rejected"
+	CoachingTerminated CoachingStatus = "terminated"
+)
+
+// CoachingRelationship links a coach to a client. It starts as an invitation
+// addressed to an email and becomes active once the invited user accepts it.
+type CoachingRelationship struct {
+	ID           int            `json:"id,omitempty" gorm:"primaryKey"`
+	CoachID      int            `json:"coachId" gorm:"index;not null"`
+	ClientID     int            `json:"clientId" gorm:"index"`
+	ClientEmail  string         `json:"clientEmail" gorm:"index;not null"`
+	Status       CoachingStatus `json:"status" gorm:"type:enum('pending','active','rejected','terminated');default:'pending';not null;index"`
+	InvitedAt    time.Time      `json:"invitedAt" gorm:"not null"`
+	AcceptedAt   *time.Time     `json:"acceptedAt,omitempty"`
+	RejectedAt   *time.Time     `json:"rejectedAt,omitempty"`
+	TerminatedAt *time.Time     `json:"terminatedAt,omitempty"`
+
+	mysql.Model
+}
@@ -0,0 +1,177 @@
+package model
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+type MuscleGroup string
+
+const (
+	MuscleChest      MuscleGroup = "chest"
+	MuscleUpperBack  MuscleGroup = "upper_back"
+	MuscleLats       MuscleGroup = "lats"
+	MuscleLowerBack  MuscleGroup = "lower_back"
+	MuscleTraps      MuscleGroup = "traps"
+	MuscleShoulders  MuscleGroup = "shoulders"
+	MuscleBiceps     MuscleGroup = "biceps"
+	MuscleTriceps    MuscleGroup = "triceps"
+	MuscleForearms   MuscleGroup = "forearms"
+	MuscleAbs        MuscleGroup = "abs"
+	MuscleObliques   MuscleGroup = "obliques"
+	MuscleQuads      MuscleGroup = "quads"
+	MuscleHamstrings MuscleGroup = "hamstrings"
+	MuscleGlutes     MuscleGroup = "glutes"
+	MuscleAdductors  MuscleGroup = "adductors"
+	MuscleCalves     MuscleGroup = "calves"
+)
+
+func (m MuscleGroup) Valid() bool {
+	switch m {
+	case MuscleChest, MuscleUpperBack, MuscleLats, MuscleLowerBack, MuscleTraps, MuscleShoulders,
+		MuscleBiceps, MuscleTriceps, MuscleForearms, MuscleAbs, MuscleObliques,
+		MuscleQuads, MuscleHamstrings, MuscleGlutes, MuscleAdductors, MuscleCalves:
+		return true
+	}
+	return false
+}
+
+type Equipment string
+
+const (
+	EquipmentBarbell    Equipment = "barbell"
+	EquipmentDumbbell   Equipment = "dumbbell"
+	EquipmentKettlebell Equipment = "kettlebell"
+	EquipmentMachine    Equipment = "machine"
+	EquipmentCable      Equipment = "cable"
+	EquipmentBodyweight Equipment = "bodyweight"
+	EquipmentBand       Equipment = "band"
+	EquipmentOther      Equipment = "other"
+)
+
+func (e Equipment) Valid() bool {
+	switch e {
+	case EquipmentBarbell, EquipmentDumbbell, EquipmentKettlebell, EquipmentMachine,
+		EquipmentCable, EquipmentBodyweight, EquipmentBand, EquipmentOther:
+		return true
+	}
+	return false
+}
+
+type MovementPattern string
+
+const (
+	PatternHorizontalPush MovementPattern = "horizontal_push"
+	PatternVerticalPush   MovementPattern = "vertical_push"
+	PatternHorizontalPull MovementPattern = "horizontal_pull"
+	PatternVerticalPull   MovementPattern = "vertical_pull"
+	PatternSquat          MovementPattern = "squat"
+	PatternHinge          MovementPattern = "hinge"
+	PatternLunge          MovementPattern = "lunge"
+	PatternCarry          MovementPattern = "carry"
+	PatternCore           MovementPattern = "core"
+	PatternIsolation      MovementPattern = "isolation"
+)
+
+func (p MovementPattern) Valid() bool {
+	switch p {
+	case PatternHorizontalPush, PatternVerticalPush, PatternHorizontalPull, PatternVerticalPull,
+		PatternSquat, PatternHinge, PatternLunge, PatternCarry, PatternCore, PatternIsolation:
+		return true
+	}
+	return false
+}
+
+// Exercise is an entry of the global exercise catalog that program exercises
+// refer to, so that "Bench press" and "BB bench" count as one movement. Slug
+// identifies entries of the bundled seed file. Aliases are other names the
+// exercise is searched by. An entry merged into another as a duplicate is
+// deleted and keeps MergedIntoID.
+type Exercise struct {
+	ID               int             `json:"id,omitempty" gorm:"primaryKey"`
+	Slug             string          `json:"slug" gorm:"type:varchar(64);not null;uniqueIndex"`
+	Name             string          `json:"name" gorm:"type:varchar(128);not null;index:idx_exercise_search,class:FULLTEXT"`
+	Aliases          Aliases         `json:"aliases" gorm:"type:text;index:idx_exercise_search,class:FULLTEXT"`
+	PrimaryMuscles   MuscleGroups    `json:"primaryMuscles" gorm:"type:varchar(255)"`
+	SecondaryMuscles MuscleGroups    `json:"secondaryMuscles" gorm:"type:varchar(255)"`
+	Equipment        Equipment       `json:"equipment" gorm:"type:varchar(16);not null;index"`
+	MovementPattern  MovementPattern `json:"movementPattern" gorm:"type:varchar(24);not null;index"`
+	MergedIntoID     *int            `json:"mergedIntoId,omitempty" gorm:"index"`
+
+	mysql.Model
+}
+
+// AddAliases adds names the exercise is not known by yet, ignoring case.
+func (e *Exercise) AddAliases(names ...string) {
+	known := map[string]bool{strings.ToLower(e.Name): true}
+	for _, a := range e.Aliases {
+		known[strings.ToLower(a)] = true
+	}
+	for _, n := range names {
+		n = strings.TrimSpace(n)
+		if n == "" || known[strings.ToLower(n)] {
+			continue
+		}
+		known[strings.ToLower(n)] = true
+		e.Aliases = append(e.Aliases, n)
+	}
+}
+
+// listSeparator joins list columns. It is not expected in exercise names.
+const listSeparator = "|"
+
+// Aliases is stored as one text column so that it can be full-text indexed
+// together with the name.
+type Aliases []string
+
+func (a Aliases) Value() (driver.Value, error) {
+	return strings.Join(a, listSeparator), nil
+}
+
+func (a *Aliases) Scan(value any) error {
+	s, err := listColumn(value)
+	*a = s
+	return err
+}
+
+// MuscleGroups is stored as one column; search matches a muscle group with
+// LIKE against the column wrapped in separators.
+type MuscleGroups []MuscleGroup
+
+func (m MuscleGroups) Value() (driver.Value, error) {
+	s := make([]string, len(m))
+	for i, g := range m {
+		s[i] = string(g)
+	}
+	return strings.Join(s, listSeparator), nil
+}
+
+func (m *MuscleGroups) Scan(value any) error {
+	s, err := listColumn(value)
+	*m = make(MuscleGroups, len(s))
+	for i, g := range s {
+		(*m)[i] = MuscleGroup(g)
+	}
+	return err
+}
+
+func listColumn(value any) ([]string, error) {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		return []string{}, nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return nil, fmt.Errorf("unsupported list column type %T", value)
+	}
+	if s == "" {
+		return []string{}, nil
+	}
+	return strings.Split(s, listSeparator), nil
+}
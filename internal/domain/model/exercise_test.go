@@ -0,0 +1,41 @@
+package model_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+func TestExerciseAddAliases(t *testing.T) {
+	e := model.Exercise{Name: "Barbell bench press", Aliases: model.Aliases{"Bench press"}}
+
+	e.AddAliases("BB bench", "bench PRESS", " barbell bench press ", "", "Bench", "bb bench")
+
+	want := model.Aliases{"Bench press", "BB bench", "Bench"}
+	if !reflect.DeepEqual(e.Aliases, want) {
+		t.Errorf("aliases = %q, want %q", e.Aliases, want)
+	}
+}
+
+func TestMuscleGroupsColumn(t *testing.T) {
+	in := model.MuscleGroups{model.MuscleQuads, model.MuscleGlutes}
+	v, err := in.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "quads|glutes" {
+		t.Errorf("value = %v, want quads|glutes", v)
+	}
+
+	var out model.MuscleGroups
+	if err := out.Scan([]byte("quads|glutes")); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("scanned = %v, want %v", out, in)
+	}
+	if err := out.Scan(""); err != nil || len(out) != 0 {
+		t.Errorf("scan of empty column = %v, %v; want empty", out, err)
+	}
+}
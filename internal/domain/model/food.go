@@ -0,0 +1,109 @@
+package model
+
+import (
+	"math"
+	"time"
+
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+type FoodSource string
+
+const (
+	FoodSourceUSDA          FoodSource = "usda"
+	FoodSourceOpenFoodFacts FoodSource = "openfoodfacts"
+	// FoodSourceCommunity marks foods added from approved user submissions.
+	FoodSourceCommunity FoodSource = "community"
+)
+
+// Food is a catalog item with nutrients per 100 g. ExternalID is the FDC ID or
+// barcode at the source, so re-importing a dump updates existing rows.
+// Barcode is normalized by barcode.Normalize; ServingGrams is the label
+// serving size when known.
+type Food struct {
+	ID              int        `json:"id,omitempty" gorm:"primaryKey"`
+	Source          FoodSource `json:"source" gorm:"type:varchar(16);not null;uniqueIndex:idx_food_external"`
+	ExternalID      string     `json:"externalId" gorm:"type:varchar(64);not null;uniqueIndex:idx_food_external"`
+	Name            string     `json:"name" gorm:"type:varchar(255);not null;index:idx_food_search,class:FULLTEXT"`
+	Brand           string     `json:"brand,omitempty" gorm:"type:varchar(255);index:idx_food_search,class:FULLTEXT"`
+	Barcode         string     `json:"barcode,omitempty" gorm:"type:varchar(14);index"`
+	ServingGrams    float64    `json:"servingGrams,omitempty"`
+	CaloriesPer100g float64    `json:"caloriesPer100g"`
+	ProteinPer100g  float64    `json:"proteinPer100g"`
+	FatPer100g      float64    `json:"fatPer100g"`
+	CarbsPer100g    float64    `json:"carbsPer100g"`
+
+	mysql.Model
+}
+
+// Serving returns a diary item for one label serving, or for 100 g when the
+// serving size is unknown.
+func (f Food) Serving() DiaryItem {
+	if f.ServingGrams > 0 {
+		return f.Portion(float32(f.ServingGrams))
+	}
+	return f.Portion(100)
+}
+
+// Portion returns a diary item for the given weight of the food.
+func (f Food) Portion(grams float32) DiaryItem {
+	scale := func(per100 float64) float32 {
+		return float32(math.Round(per100*float64(grams)) / 100)
+	}
+	id := f.ID
+	return DiaryItem{
+		FoodID:   &id,
+		Name:     f.Name,
+		Grams:    grams,
+		Calories: scale(f.CaloriesPer100g),
+		ProteinG: scale(f.ProteinPer100g),
+		FatG:     scale(f.FatPer100g),
+		CarbsG:   scale(f.CarbsPer100g),
+	}
+}
+
+type FoodSubmissionStatus string
+
+const (
+	FoodSubmissionPending  FoodSubmissionStatus = "pending"
+	FoodSubmissionApproved FoodSubmissionStatus = "approved"
+	FoodSubmissionRejected FoodSubmissionStatus = "rejected"
+)
+
+// FoodSubmission is a packaged food a user reported as missing from the
+// catalog. Admins review it; approving it adds a Food and links it here.
+type FoodSubmission struct {
+	ID              int                  `json:"id,omitempty" gorm:"primaryKey"`
+	UserID          int                  `json:"userId" gorm:"index;not null"`
+	Barcode         string               `json:"barcode" gorm:"type:varchar(14);index;not null"`
+	Name            string               `json:"name" gorm:"type:varchar(255);not null"`
+	Brand           string               `json:"brand,omitempty" gorm:"type:varchar(255)"`
+	ServingGrams    float64              `json:"servingGrams,omitempty"`
+	CaloriesPer100g float64              `json:"caloriesPer100g"`
+	ProteinPer100g  float64              `json:"proteinPer100g"`
+	FatPer100g      float64              `json:"fatPer100g"`
+	CarbsPer100g    float64              `json:"carbsPer100g"`
+	Status          FoodSubmissionStatus `json:"status" gorm:"type:enum('pending','approved','rejected');default:'pending';not null;index"`
+	ReviewedBy      *int                 `json:"reviewedBy,omitempty"`
+	ReviewedAt      *time.Time           `json:"reviewedAt,omitempty"`
+	RejectReason    string               `json:"rejectReason,omitempty" gorm:"type:varchar(255)"`
+	FoodID          *int                 `json:"foodId,omitempty"`
+
+	mysql.Model
+}
+
+// Food returns the catalog entry an approved submission becomes.
+func (s FoodSubmission) Food() Food {
+	return Food{
+		Source:          FoodSourceCommunity,
+		ExternalID:      s.Barcode,
+		Name:            s.Name,
+		Brand:           s.Brand,
+		Barcode:         s.Barcode,
+		ServingGrams:    s.ServingGrams,
+		CaloriesPer100g: s.CaloriesPer100g,
+		ProteinPer100g:  s.ProteinPer100g,
+		FatPer100g:      s.FatPer100g,
+		CarbsPer100g:    s.CarbsPer100g,
+	}
+}
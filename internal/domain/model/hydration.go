@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+// HydrationEntry is a drink logged outside of meals. Like diary entries, Date
+// is midnight of the diary day.
+type HydrationEntry struct {
+	ID         int       `json:"id,omitempty" gorm:"primaryKey"`
+	UserID     int       `json:"userId" gorm:"index;not null"`
+	Date       time.Time `json:"date" gorm:"index;not null"`
+	Beverage   string    `json:"beverage" gorm:"type:varchar(64);not null" example:"water"`
+	AmountMl   float32   `json:"amountMl" gorm:"not null" example:"500"`
+	CaffeineMg float32   `json:"caffeineMg,omitempty" example:"0"`
+
+	mysql.Model
+}
+
+// HydrationDay is the hydration log summed over one diary date.
+type HydrationDay struct {
+	Date       time.Time
+	AmountMl   float64
+	CaffeineMg float64
+}
@@ -4,18 +4,35 @@ import "github.com/msskobelina/fit-profi/pkg/mysql"
 
 type Provider string
 
-const ProviderGoogle Provider = "google"
+const (
+	ProviderGoogle Provider = "google"
+	ProviderStrava Provider = "strava"
+)
+
+type IntegrationStatus string
+
+const (
+	IntegrationActive         IntegrationStatus = "active"
+	IntegrationNeedsReconnect IntegrationStatus = "needs_reconnect"
+)
 
 type UserIntegration struct {
 	ID           int      `json:"id,omitempty" gorm:"primaryKey"`
 	UserID       int      `json:"userId" gorm:"index;not null"`
-	Provider     Provider `json:"provider" gorm:"type:enum('google');not null"`
+	Provider     Provider `json:"provider" gorm:"type:varchar(32);not null"`
 	AccessToken  string   `json:"-" gorm:"type:text;not null"`
 	RefreshToken string   `json:"-" gorm:"type:text"`
 	ExpiryUnix   int64    `json:"expiryUnix" gorm:"index"`
 	Scope        string   `json:"scope" gorm:"type:text"`
 	CalendarID   string   `json:"calendarId" gorm:"type:varchar(256);default:'primary'"`
 	Timezone     string   `json:"timezone" gorm:"type:varchar(64)"`
+	// Status turns to needs_reconnect when the provider rejects the refresh
+	// token; the user has to go through the connect flow again.
+	Status    IntegrationStatus `json:"status" gorm:"type:enum('active','needs_reconnect');default:'active';not null"`
+	LastError string            `json:"lastError,omitempty" gorm:"type:text"`
+	// SyncCursor is the start time (unix seconds) of the newest activity
+	// imported so far; the next import only asks for newer ones.
+	SyncCursor int64 `json:"syncCursor,omitempty"`
 
 	mysql.Model
 }
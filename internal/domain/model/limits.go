@@ -0,0 +1,72 @@
+package model
+
+import "github.com/msskobelina/fit-profi/pkg/mysql"
+
+type LimitKind string
+
+const (
+	LimitAboveMax LimitKind = "above_max"
+	LimitBelowMin LimitKind = "below_min"
+)
+
+// NutritionLimits are daily bounds on micronutrients and water. Max fields are
+// ceilings and Min fields are floors; a nil field is not checked. Users who
+// never set their own get DefaultNutritionLimits. SetBy is the coach who set
+// them for a client, or zero when the user set them.
+type NutritionLimits struct {
+	ID             int      `json:"id,omitempty" gorm:"primaryKey"`
+	UserID         int      `json:"userId" gorm:"uniqueIndex;not null"`
+	SetBy          int      `json:"setBy,omitempty"`
+	SugarMaxG      *float64 `json:"sugarMaxG" example:"50"`
+	SodiumMaxMg    *float64 `json:"sodiumMaxMg" example:"2000"`
+	CaffeineMaxMg  *float64 `json:"caffeineMaxMg" example:"400"`
+	FibreMinG      *float64 `json:"fibreMinG" example:"25"`
+	PotassiumMinMg *float64 `json:"potassiumMinMg" example:"3500"`
+	WaterMinMl     *float64 `json:"waterMinMl" example:"2000"`
+
+	mysql.Model
+}
+
+// DefaultNutritionLimits follows the WHO intake recommendations for adults and
+// the EFSA safe caffeine dose.
+func DefaultNutritionLimits(userID int) NutritionLimits {
+	v := func(f float64) *float64 { return &f }
+	return NutritionLimits{
+		UserID:         userID,
+		SugarMaxG:      v(50),
+		SodiumMaxMg:    v(2000),
+		CaffeineMaxMg:  v(400),
+		FibreMinG:      v(25),
+		PotassiumMinMg: v(3500),
+		WaterMinMl:     v(2000),
+	}
+}
+
+// NutritionWarning reports a daily total outside one of the limits. Nutrient
+// is the JSON name of the total.
+type NutritionWarning struct {
+	Nutrient string    `json:"nutrient" example:"sodiumMg"`
+	Kind     LimitKind `json:"kind" example:"above_max"`
+	Limit    float64   `json:"limit" example:"2000"`
+	Value    float64   `json:"value" example:"2650"`
+}
+
+// Check returns a warning for every limit the totals break, in field order.
+func (l NutritionLimits) Check(t NutritionTotals) []NutritionWarning {
+	var res []NutritionWarning
+	check := func(nutrient string, kind LimitKind, limit *float64, value float64) {
+		if limit == nil {
+			return
+		}
+		if kind == LimitAboveMax && value > *limit || kind == LimitBelowMin && value < *limit {
+			res = append(res, NutritionWarning{Nutrient: nutrient, Kind: kind, Limit: *limit, Value: value})
+		}
+	}
+	check("sugarG", LimitAboveMax, l.SugarMaxG, t.SugarG)
+	check("sodiumMg", LimitAboveMax, l.SodiumMaxMg, t.SodiumMg)
+	check("caffeineMg", LimitAboveMax, l.CaffeineMaxMg, t.CaffeineMg)
+	check("fibreG", LimitBelowMin, l.FibreMinG, t.FibreG)
+	check("potassiumMg", LimitBelowMin, l.PotassiumMinMg, t.PotassiumMg)
+	check("waterMl", LimitBelowMin, l.WaterMinMl, t.WaterMl)
+	return res
+}
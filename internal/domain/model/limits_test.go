@@ -0,0 +1,53 @@
+package model_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+func TestNutritionLimitsCheck(t *testing.T) {
+	defaults := model.DefaultNutritionLimits(1)
+
+	tests := []struct {
+		name   string
+		limits model.NutritionLimits
+		totals model.NutritionTotals
+		want   []model.NutritionWarning
+	}{
+		{
+			name:   "within limits",
+			limits: defaults,
+			totals: model.NutritionTotals{SugarG: 30, SodiumMg: 1500, CaffeineMg: 200, FibreG: 30, PotassiumMg: 3600, WaterMl: 2500},
+		},
+		{
+			name:   "limits are inclusive",
+			limits: defaults,
+			totals: model.NutritionTotals{SugarG: 50, SodiumMg: 2000, CaffeineMg: 400, FibreG: 25, PotassiumMg: 3500, WaterMl: 2000},
+		},
+		{
+			name:   "over and under",
+			limits: defaults,
+			totals: model.NutritionTotals{SugarG: 30, SodiumMg: 2650, CaffeineMg: 200, FibreG: 12, PotassiumMg: 3600, WaterMl: 2500},
+			want: []model.NutritionWarning{
+				{Nutrient: "sodiumMg", Kind: model.LimitAboveMax, Limit: 2000, Value: 2650},
+				{Nutrient: "fibreG", Kind: model.LimitBelowMin, Limit: 25, Value: 12},
+			},
+		},
+		{
+			name:   "unset limits are skipped",
+			limits: model.NutritionLimits{UserID: 1},
+			totals: model.NutritionTotals{SodiumMg: 5000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.limits.Check(tt.totals)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Check() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
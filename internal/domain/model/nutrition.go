@@ -16,15 +16,118 @@ type DiaryEntry struct {
 	mysql.Model
 }
 
+// DiaryItem is one food eaten in a meal. Items that reference the food
+// catalog by FoodID get their name and macros computed from the food and
+// Grams; items logged from a recipe keep its ID in RecipeID; the others are
+// entered by hand. Supplements are logged as items too, usually with zero
+// grams and only the micronutrients they provide.
 type DiaryItem struct {
 	ID       int     `json:"id,omitempty" gorm:"primaryKey"`
 	EntryID  int     `json:"entryId" gorm:"index;not null"`
+	FoodID   *int    `json:"foodId,omitempty" gorm:"index"`
+	RecipeID *int    `json:"recipeId,omitempty" gorm:"index"`
 	Name     string  `json:"name"`
 	Grams    float32 `json:"grams"`
 	Calories float32 `json:"calories"`
 	ProteinG float32 `json:"proteinG"`
 	FatG     float32 `json:"fatG"`
 	CarbsG   float32 `json:"carbsG"`
+	Micronutrients
 
 	mysql.Model
 }
+
+// Micronutrients are the optional extras of a diary item. Nil means the value
+// was not recorded, which summaries treat as zero.
+type Micronutrients struct {
+	FibreG      *float32 `json:"fibreG,omitempty" example:"3.1"`
+	SugarG      *float32 `json:"sugarG,omitempty" example:"14"`
+	SodiumMg    *float32 `json:"sodiumMg,omitempty" example:"1"`
+	PotassiumMg *float32 `json:"potassiumMg,omitempty" example:"422"`
+	CaffeineMg  *float32 `json:"caffeineMg,omitempty" example:"0"`
+	WaterMl     *float32 `json:"waterMl,omitempty" example:"89"`
+}
+
+// Fields lists pointers to the micronutrient fields in declaration order, so
+// sums can walk them without repeating the field list.
+func (m *Micronutrients) Fields() [6]**float32 {
+	return [6]**float32{&m.FibreG, &m.SugarG, &m.SodiumMg, &m.PotassiumMg, &m.CaffeineMg, &m.WaterMl}
+}
+
+// NutritionTotals is the sum of a set of diary items. Micronutrients are
+// omitted when zero.
+type NutritionTotals struct {
+	Calories    float64 `json:"calories"`
+	ProteinG    float64 `json:"proteinG"`
+	FatG        float64 `json:"fatG"`
+	CarbsG      float64 `json:"carbsG"`
+	FibreG      float64 `json:"fibreG,omitempty"`
+	SugarG      float64 `json:"sugarG,omitempty"`
+	SodiumMg    float64 `json:"sodiumMg,omitempty"`
+	PotassiumMg float64 `json:"potassiumMg,omitempty"`
+	CaffeineMg  float64 `json:"caffeineMg,omitempty"`
+	WaterMl     float64 `json:"waterMl,omitempty"`
+}
+
+// Per divides every total by n, for averages.
+func (t NutritionTotals) Per(n float64) NutritionTotals {
+	return NutritionTotals{
+		Calories:    t.Calories / n,
+		ProteinG:    t.ProteinG / n,
+		FatG:        t.FatG / n,
+		CarbsG:      t.CarbsG / n,
+		FibreG:      t.FibreG / n,
+		SugarG:      t.SugarG / n,
+		SodiumMg:    t.SodiumMg / n,
+		PotassiumMg: t.PotassiumMg / n,
+		CaffeineMg:  t.CaffeineMg / n,
+		WaterMl:     t.WaterMl / n,
+	}
+}
+
+// Against splits the gap between t and target into what is still left to eat
+// and what went over, per macro. Micronutrients are checked against
+// NutritionLimits instead.
+func (t NutritionTotals) Against(target NutritionTotals) (remaining, exceeded NutritionTotals) {
+	gap := func(have, want float64) (float64, float64) {
+		if have > want {
+			return 0, have - want
+		}
+		return want - have, 0
+	}
+	remaining.Calories, exceeded.Calories = gap(t.Calories, target.Calories)
+	remaining.ProteinG, exceeded.ProteinG = gap(t.ProteinG, target.ProteinG)
+	remaining.FatG, exceeded.FatG = gap(t.FatG, target.FatG)
+	remaining.CarbsG, exceeded.CarbsG = gap(t.CarbsG, target.CarbsG)
+	return remaining, exceeded
+}
+
+// NutritionDay holds the totals of one diary date, overall and per meal type.
+// Drinks from the hydration log count towards Totals but belong to no meal;
+// HydrationMl is their volume alone.
+type NutritionDay struct {
+	Date        string                     `json:"date" example:"2024-03-15"`
+	Totals      NutritionTotals            `json:"totals"`
+	Meals       map[string]NutritionTotals `json:"meals"`
+	HydrationMl float64                    `json:"hydrationMl,omitempty" example:"1500"`
+	// Remaining and Exceeded compare Totals with the daily target and are
+	// omitted when the user has none.
+	Remaining *NutritionTotals   `json:"remaining,omitempty"`
+	Exceeded  *NutritionTotals   `json:"exceeded,omitempty"`
+	Warnings  []NutritionWarning `json:"warnings,omitempty"`
+}
+
+// NutritionSummary aggregates diary entries and the hydration log over a range
+// of dates. Average is taken over LoggedDays, the days that have at least one
+// diary entry, so days the user skipped logging do not drag it down.
+type NutritionSummary struct {
+	From       string            `json:"from" example:"2024-03-09"`
+	To         string            `json:"to" example:"2024-03-15"`
+	Timezone   string            `json:"timezone" example:"Europe/Kyiv"`
+	Days       []NutritionDay    `json:"days"`
+	LoggedDays int               `json:"loggedDays"`
+	Totals     NutritionTotals   `json:"totals"`
+	Average    NutritionTotals   `json:"average"`
+	Target     *NutritionTargets `json:"target,omitempty"`
+	Limits     *NutritionLimits  `json:"limits,omitempty"`
+}
@@ -15,10 +15,31 @@ type UserProfile struct {
 	WeightKg    float32 `json:"weightKg"`
 	Goal        Goal    `json:"goal" gorm:"type:enum('lose_weight','gain_weight','rehab','keep_fit','competition')"`
 	Description string  `json:"description" gorm:"type:text"`
+	// HeightCm, Sex and ActivityLevel are optional; nutrition targets can only
+	// be computed once all three are set.
+	HeightCm      float32       `json:"heightCm,omitempty"`
+	Sex           Sex           `json:"sex,omitempty" gorm:"type:enum('male','female');default:null"`
+	ActivityLevel ActivityLevel `json:"activityLevel,omitempty" gorm:"type:enum('sedentary','light','moderate','active','very_active');default:null"`
+	// Timezone is an IANA zone name; it decides where the user's days start
+	// and end.
+	Timezone string `json:"timezone,omitempty" gorm:"type:varchar(64)"`
 
 	mysql.Model
 }
 
+// Location returns the profile's time zone, falling back to UTC when none is
+// set.
+func (p *UserProfile) Location() *time.Location {
+	if p == nil || p.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
 type Goal string
 
 const (
@@ -1,13 +1,23 @@
 package model
 
-import "github.com/msskobelina/fit-profi/pkg/mysql"
+import (
+	"time"
 
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+// TrainingProgram belongs to UserID, the athlete who performs it. AuthorID is
+// whoever built it: the athlete themselves or their coach. Programs a coach
+// assigns are per-athlete copies pointing back to the original via
+// AssignedFromID.
 type TrainingProgram struct {
-	ID          int          `json:"id,omitempty" gorm:"primaryKey"`
-	UserID      int          `json:"userId" gorm:"index;not null"`
-	Title       string       `json:"title"`
-	Description string       `json:"description" gorm:"type:text"`
-	Days        []ProgramDay `json:"days,omitempty" gorm:"foreignKey:ProgramID;constraint:OnDelete:CASCADE"`
+	ID             int          `json:"id,omitempty" gorm:"primaryKey"`
+	UserID         int          `json:"userId" gorm:"index;not null"`
+	AuthorID       int          `json:"authorId" gorm:"index"`
+	AssignedFromID *int         `json:"assignedFromId,omitempty" gorm:"index"`
+	Title          string       `json:"title"`
+	Description    string       `json:"description" gorm:"type:text"`
+	Days           []ProgramDay `json:"days,omitempty" gorm:"foreignKey:ProgramID;constraint:OnDelete:CASCADE"`
 
 	mysql.Model
 }
@@ -22,26 +32,71 @@ type ProgramDay struct {
 	mysql.Model
 }
 
+type ExerciseGroupType string
+
+const (
+	GroupSuperset ExerciseGroupType = "superset"
+	GroupCircuit  ExerciseGroupType = "circuit"
+)
+
+// ProgramExercise is an exercise of a program day. CatalogID refers to the
+// exercise catalog entry it is an instance of; Name defaults to the entry's
+// name, and exercises created by name alone are linked to the entry it
+// matches.
+//
+// The rest is the prescription. Reps is a fixed rep count, or the lower end of
+// a range when RepsMax is set ("3x8-12"). Load is either WeightKg or
+// PercentOneRM of the athlete's one-rep max, and effort an RPE on the 1-10
+// scale or RIR, the reps left in reserve. Tempo is the
+// eccentric-pause-concentric-pause seconds, e.g. "3-1-1-0". Timed and
+// distance work such as planks or 400 m intervals use DurationSeconds and
+// DistanceMeters. Exercises of a day sharing a Group label are performed
+// back to back as a superset or circuit, in the order they are listed.
 type ProgramExercise struct {
-	ID       int    `json:"id,omitempty" gorm:"primaryKey"`
-	DayID    int    `json:"dayId" gorm:"index;not null"`
-	Name     string `json:"name"`
-	Sets     int    `json:"sets"`
-	Reps     int    `json:"reps"`
-	WeightKg int    `json:"weightKg"`
-	Notes    string `json:"notes" gorm:"type:text"`
+	ID              int               `json:"id,omitempty" gorm:"primaryKey"`
+	DayID           int               `json:"dayId" gorm:"index;not null"`
+	CatalogID       *int              `json:"catalogId,omitempty" gorm:"index"`
+	Name            string            `json:"name"`
+	Sets            int               `json:"sets"`
+	Reps            int               `json:"reps"`
+	RepsMax         int               `json:"repsMax,omitempty"`
+	WeightKg        float64           `json:"weightKg" gorm:"type:decimal(6,2)"`
+	PercentOneRM    float64           `json:"percentOneRm,omitempty" gorm:"type:decimal(5,2)"`
+	RPE             *float64          `json:"rpe,omitempty" gorm:"type:decimal(3,1)"`
+	RIR             *int              `json:"rir,omitempty"`
+	Tempo           string            `json:"tempo,omitempty" gorm:"type:varchar(16)"`
+	RestSeconds     int               `json:"restSeconds,omitempty"`
+	DurationSeconds int               `json:"durationSeconds,omitempty"`
+	DistanceMeters  float64           `json:"distanceMeters,omitempty"`
+	Group           string            `json:"group,omitempty" gorm:"type:varchar(8)"`
+	GroupType       ExerciseGroupType `json:"groupType,omitempty" gorm:"type:varchar(16)"`
+	Notes           string            `json:"notes" gorm:"type:text"`
 
 	mysql.Model
 }
 
 type ExerciseProgress struct {
-	ID         int    `json:"id,omitempty" gorm:"primaryKey"`
-	UserID     int    `json:"userId" gorm:"index;not null"`
-	ExerciseID int    `json:"exerciseId" gorm:"index;not null"`
-	Sets       int    `json:"sets"`
-	Reps       int    `json:"reps"`
-	WeightKg   int    `json:"weightKg"`
-	Notes      string `json:"notes" gorm:"type:text"`
+	ID         int              `json:"id,omitempty" gorm:"primaryKey"`
+	UserID     int              `json:"userId" gorm:"index;not null"`
+	ExerciseID int              `json:"exerciseId" gorm:"index;not null"`
+	Sets       int              `json:"sets"`
+	Reps       int              `json:"reps"`
+	WeightKg   float64          `json:"weightKg" gorm:"type:decimal(6,2)"`
+	Notes      string           `json:"notes" gorm:"type:text"`
+	Records    []PersonalRecord `json:"records,omitempty" gorm:"foreignKey:ProgressID;constraint:OnDelete:CASCADE"`
+
+	mysql.Model
+}
+
+// ScheduledWorkout puts a program day on the athlete's calendar.
+type ScheduledWorkout struct {
+	ID        int       `json:"id,omitempty" gorm:"primaryKey"`
+	UserID    int       `json:"userId" gorm:"index;not null"`
+	ProgramID int       `json:"programId" gorm:"index;not null"`
+	DayID     int       `json:"dayId" gorm:"index;not null"`
+	StartTime time.Time `json:"startTime" gorm:"index;not null"`
+	EndTime   time.Time `json:"endTime" gorm:"not null"`
+	Notes     string    `json:"notes" gorm:"type:text"`
 
 	mysql.Model
 }
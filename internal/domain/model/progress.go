@@ -0,0 +1,172 @@
+package model
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+type OneRMFormula string
+
+const (
+	FormulaEpley   OneRMFormula = "epley"
+	FormulaBrzycki OneRMFormula = "brzycki"
+)
+
+// EstimateOneRM estimates the one-repetition maximum from a set of reps at a
+// weight. A single rep is its own max. Brzycki is undefined from 37 reps on,
+// where it reports zero.
+func EstimateOneRM(f OneRMFormula, weightKg float64, reps int) float64 {
+	switch {
+	case reps <= 0 || weightKg <= 0:
+		return 0
+	case reps == 1:
+		return weightKg
+	}
+	var est float64
+	if f == FormulaBrzycki {
+		if reps >= 37 {
+			return 0
+		}
+		est = weightKg * 36 / float64(37-reps)
+	} else {
+		est = weightKg * (1 + float64(reps)/30)
+	}
+	return math.Round(est*10) / 10
+}
+
+type AnalyticsPeriod string
+
+const (
+	PeriodWeek  AnalyticsPeriod = "week"
+	PeriodMonth AnalyticsPeriod = "month"
+)
+
+// Start returns the first day of the period containing t: the Monday of its
+// ISO week or the first of its month, in UTC.
+func (p AnalyticsPeriod) Start(t time.Time) time.Time {
+	t = t.UTC()
+	d := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if p == PeriodMonth {
+		return d.AddDate(0, 0, 1-d.Day())
+	}
+	return d.AddDate(0, 0, -(int(d.Weekday())+6)%7)
+}
+
+// PerformedSet is one set of an exercise as analytics sees it, whether it was
+// logged in a workout session or as aggregate progress. CatalogID is the
+// catalog entry of the program exercise, zero when it has none.
+type PerformedSet struct {
+	ExerciseID int
+	CatalogID  int
+	Name       string
+	Date       time.Time
+	Reps       int
+	WeightKg   float64
+}
+
+// SeriesKey identifies the movement a set counts towards: its catalog entry,
+// so that the same exercise in different programs shares one history, or the
+// program exercise when it is not in the catalog.
+type SeriesKey struct {
+	CatalogID  int
+	ExerciseID int
+}
+
+func (s PerformedSet) Series() SeriesKey {
+	if s.CatalogID != 0 {
+		return SeriesKey{CatalogID: s.CatalogID}
+	}
+	return SeriesKey{ExerciseID: s.ExerciseID}
+}
+
+// BestSet is the set with the highest estimated 1RM in a period.
+type BestSet struct {
+	Date             string  `json:"date" example:"2024-03-14"`
+	Reps             int     `json:"reps" example:"5"`
+	WeightKg         float64 `json:"weightKg" example:"100"`
+	EstimatedOneRMKg float64 `json:"estimatedOneRmKg" example:"116.7"`
+}
+
+// ExercisePoint sums up one period of an exercise. VolumeKg is the sum of
+// reps times weight over all sets.
+type ExercisePoint struct {
+	PeriodStart      string  `json:"periodStart" example:"2024-03-11"`
+	Sets             int     `json:"sets" example:"12"`
+	VolumeKg         float64 `json:"volumeKg" example:"5400"`
+	EstimatedOneRMKg float64 `json:"estimatedOneRmKg" example:"116.7"`
+	BestSet          BestSet `json:"bestSet"`
+}
+
+// ExerciseSeries is the history of one movement. Sets of program exercises
+// that share a catalog entry form one series under CatalogID, with the
+// program exercise it was first performed as in ExerciseID.
+type ExerciseSeries struct {
+	ExerciseID int             `json:"exerciseId" example:"5"`
+	CatalogID  int             `json:"catalogId,omitempty" example:"1"`
+	Name       string          `json:"name" example:"Back squat"`
+	Points     []ExercisePoint `json:"points"`
+}
+
+// ProgressAnalytics holds a time series per exercise over [From, To).
+type ProgressAnalytics struct {
+	Period    AnalyticsPeriod  `json:"period" example:"week"`
+	Formula   OneRMFormula     `json:"formula" example:"epley"`
+	From      string           `json:"from" example:"2023-12-18"`
+	To        string           `json:"to" example:"2024-03-15"`
+	Exercises []ExerciseSeries `json:"exercises"`
+}
+
+// BuildExerciseSeries groups sets by series and period. Series are ordered by
+// exercise ID and points by period; periods without sets are left out.
+func BuildExerciseSeries(sets []PerformedSet, period AnalyticsPeriod, formula OneRMFormula) []ExerciseSeries {
+	type key struct {
+		series SeriesKey
+		start  time.Time
+	}
+	points := map[key]*ExercisePoint{}
+	first := map[SeriesKey]PerformedSet{}
+	for _, s := range sets {
+		if _, ok := first[s.Series()]; !ok {
+			first[s.Series()] = s
+		}
+		start := period.Start(s.Date)
+		k := key{s.Series(), start}
+		p, ok := points[k]
+		if !ok {
+			p = &ExercisePoint{PeriodStart: start.Format("2006-01-02")}
+			points[k] = p
+		}
+		p.Sets++
+		p.VolumeKg += float64(s.Reps) * s.WeightKg
+		est := EstimateOneRM(formula, s.WeightKg, s.Reps)
+		if p.BestSet.Date == "" || est > p.BestSet.EstimatedOneRMKg {
+			p.BestSet = BestSet{
+				Date:             s.Date.UTC().Format("2006-01-02"),
+				Reps:             s.Reps,
+				WeightKg:         s.WeightKg,
+				EstimatedOneRMKg: est,
+			}
+			p.EstimatedOneRMKg = est
+		}
+	}
+
+	bySeries := map[SeriesKey]*ExerciseSeries{}
+	for k, p := range points {
+		p.VolumeKg = math.Round(p.VolumeKg*10) / 10
+		es, ok := bySeries[k.series]
+		if !ok {
+			f := first[k.series]
+			es = &ExerciseSeries{ExerciseID: f.ExerciseID, CatalogID: f.CatalogID, Name: f.Name}
+			bySeries[k.series] = es
+		}
+		es.Points = append(es.Points, *p)
+	}
+	res := make([]ExerciseSeries, 0, len(bySeries))
+	for _, es := range bySeries {
+		sort.Slice(es.Points, func(i, j int) bool { return es.Points[i].PeriodStart < es.Points[j].PeriodStart })
+		res = append(res, *es)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ExerciseID < res[j].ExerciseID })
+	return res
+}
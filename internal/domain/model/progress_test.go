@@ -0,0 +1,95 @@
+package model_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+func TestEstimateOneRM(t *testing.T) {
+	tests := []struct {
+		name    string
+		formula model.OneRMFormula
+		weight  float64
+		reps    int
+		want    float64
+	}{
+		{name: "epley", formula: model.FormulaEpley, weight: 100, reps: 5, want: 116.7},
+		{name: "brzycki", formula: model.FormulaBrzycki, weight: 100, reps: 5, want: 112.5},
+		{name: "single rep", formula: model.FormulaEpley, weight: 140, reps: 1, want: 140},
+		{name: "brzycki beyond range", formula: model.FormulaBrzycki, weight: 20, reps: 40, want: 0},
+		{name: "no reps", formula: model.FormulaEpley, weight: 100, reps: 0, want: 0},
+		{name: "bodyweight", formula: model.FormulaEpley, weight: 0, reps: 12, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := model.EstimateOneRM(tt.formula, tt.weight, tt.reps); got != tt.want {
+				t.Errorf("EstimateOneRM(%s, %v, %d) = %v, want %v", tt.formula, tt.weight, tt.reps, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyticsPeriodStart(t *testing.T) {
+	sunday := time.Date(2024, 3, 17, 21, 0, 0, 0, time.UTC)
+	if got := model.PeriodWeek.Start(sunday).Format("2006-01-02"); got != "2024-03-11" {
+		t.Errorf("week start = %s, want 2024-03-11", got)
+	}
+	monday := time.Date(2024, 3, 11, 6, 0, 0, 0, time.UTC)
+	if got := model.PeriodWeek.Start(monday).Format("2006-01-02"); got != "2024-03-11" {
+		t.Errorf("week start = %s, want 2024-03-11", got)
+	}
+	if got := model.PeriodMonth.Start(sunday).Format("2006-01-02"); got != "2024-03-01" {
+		t.Errorf("month start = %s, want 2024-03-01", got)
+	}
+}
+
+func TestBuildExerciseSeries(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 3, d, 18, 0, 0, 0, time.UTC) }
+	sets := []model.PerformedSet{
+		{ExerciseID: 5, Name: "Back squat", Date: day(12), Reps: 5, WeightKg: 100},
+		{ExerciseID: 5, Name: "Back squat", Date: day(12), Reps: 3, WeightKg: 110},
+		{ExerciseID: 2, Name: "Bench press", Date: day(14), Reps: 8, WeightKg: 60},
+		{ExerciseID: 5, Name: "Back squat", Date: day(19), Reps: 5, WeightKg: 105},
+	}
+
+	got := model.BuildExerciseSeries(sets, model.PeriodWeek, model.FormulaEpley)
+	want := []model.ExerciseSeries{
+		{ExerciseID: 2, Name: "Bench press", Points: []model.ExercisePoint{
+			{PeriodStart: "2024-03-11", Sets: 1, VolumeKg: 480, EstimatedOneRMKg: 76,
+				BestSet: model.BestSet{Date: "2024-03-14", Reps: 8, WeightKg: 60, EstimatedOneRMKg: 76}},
+		}},
+		{ExerciseID: 5, Name: "Back squat", Points: []model.ExercisePoint{
+			{PeriodStart: "2024-03-11", Sets: 2, VolumeKg: 830, EstimatedOneRMKg: 121,
+				BestSet: model.BestSet{Date: "2024-03-12", Reps: 3, WeightKg: 110, EstimatedOneRMKg: 121}},
+			{PeriodStart: "2024-03-18", Sets: 1, VolumeKg: 525, EstimatedOneRMKg: 122.5,
+				BestSet: model.BestSet{Date: "2024-03-19", Reps: 5, WeightKg: 105, EstimatedOneRMKg: 122.5}},
+		}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BuildExerciseSeries() = %+v\nwant %+v", got, want)
+	}
+}
+
+func TestBuildExerciseSeries_GroupsByCatalog(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 3, d, 18, 0, 0, 0, time.UTC) }
+	sets := []model.PerformedSet{
+		{ExerciseID: 5, CatalogID: 1, Name: "Bench press", Date: day(12), Reps: 5, WeightKg: 80},
+		{ExerciseID: 9, CatalogID: 1, Name: "Bench press", Date: day(19), Reps: 5, WeightKg: 85},
+		{ExerciseID: 7, Name: "Sled push", Date: day(19), Reps: 1, WeightKg: 100},
+	}
+
+	got := model.BuildExerciseSeries(sets, model.PeriodWeek, model.FormulaEpley)
+	if len(got) != 2 {
+		t.Fatalf("BuildExerciseSeries() = %+v, want a catalog series and a program exercise series", got)
+	}
+	if got[0].ExerciseID != 5 || got[0].CatalogID != 1 || len(got[0].Points) != 2 {
+		t.Errorf("catalog series = %+v, want both programs' sets under exercise 5", got[0])
+	}
+	if got[1].ExerciseID != 7 || got[1].CatalogID != 0 {
+		t.Errorf("series = %+v, want exercise 7 on its own", got[1])
+	}
+}
@@ -0,0 +1,121 @@
+package model
+
+import (
+	"math"
+
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+// Recipe is a dish or saved meal made of ingredients, cooked in one batch and
+// split into Servings portions. A saved meal is a recipe with one serving.
+// YieldGrams is the cooked weight of the batch; when it is zero the raw
+// ingredient weight is used. Recipes a coach shares are per-client copies
+// pointing back to the original via SharedFromID.
+type Recipe struct {
+	ID           int                `json:"id,omitempty" gorm:"primaryKey"`
+	UserID       int                `json:"userId" gorm:"index;not null"`
+	AuthorID     int                `json:"authorId" gorm:"index"`
+	SharedFromID *int               `json:"sharedFromId,omitempty" gorm:"index"`
+	Name         string             `json:"name" gorm:"type:varchar(255);not null"`
+	Servings     int                `json:"servings" gorm:"not null;default:1"`
+	YieldGrams   float32            `json:"yieldGrams,omitempty"`
+	Ingredients  []RecipeIngredient `json:"ingredients,omitempty" gorm:"foreignKey:RecipeID;constraint:OnDelete:CASCADE"`
+
+	mysql.Model
+}
+
+// RecipeIngredient is one food in a recipe. Like diary items, ingredients
+// that reference the food catalog get their name and macros computed from it.
+type RecipeIngredient struct {
+	ID       int     `json:"id,omitempty" gorm:"primaryKey"`
+	RecipeID int     `json:"recipeId" gorm:"index;not null"`
+	FoodID   *int    `json:"foodId,omitempty" gorm:"index"`
+	Name     string  `json:"name"`
+	Grams    float32 `json:"grams"`
+	Calories float32 `json:"calories"`
+	ProteinG float32 `json:"proteinG"`
+	FatG     float32 `json:"fatG"`
+	CarbsG   float32 `json:"carbsG"`
+	Micronutrients
+
+	mysql.Model
+}
+
+// Item returns the ingredient as a diary item.
+func (i RecipeIngredient) Item() DiaryItem {
+	return DiaryItem{
+		FoodID:   i.FoodID,
+		Name:     i.Name,
+		Grams:    i.Grams,
+		Calories: i.Calories,
+		ProteinG: i.ProteinG,
+		FatG:     i.FatG,
+		CarbsG:   i.CarbsG,
+
+		Micronutrients: i.Micronutrients,
+	}
+}
+
+// IngredientFromItem is the inverse of RecipeIngredient.Item.
+func IngredientFromItem(it DiaryItem) RecipeIngredient {
+	return RecipeIngredient{
+		FoodID:   it.FoodID,
+		Name:     it.Name,
+		Grams:    it.Grams,
+		Calories: it.Calories,
+		ProteinG: it.ProteinG,
+		FatG:     it.FatG,
+		CarbsG:   it.CarbsG,
+
+		Micronutrients: it.Micronutrients,
+	}
+}
+
+// Portion returns a diary item for the given number of servings of the
+// recipe, with macros summed over the ingredients. A micronutrient is set on
+// the portion when at least one ingredient records it.
+func (r Recipe) Portion(servings float32) DiaryItem {
+	var grams, kcal, protein, fat, carbs float64
+	var micros [6]float64
+	var tracked [6]bool
+	for _, i := range r.Ingredients {
+		grams += float64(i.Grams)
+		kcal += float64(i.Calories)
+		protein += float64(i.ProteinG)
+		fat += float64(i.FatG)
+		carbs += float64(i.CarbsG)
+		for k, v := range i.Micronutrients.Fields() {
+			if *v != nil {
+				micros[k] += float64(**v)
+				tracked[k] = true
+			}
+		}
+	}
+	if r.YieldGrams > 0 {
+		grams = float64(r.YieldGrams)
+	}
+	n := r.Servings
+	if n <= 0 {
+		n = 1
+	}
+	scale := func(total float64) float32 {
+		return float32(math.Round(total*float64(servings)/float64(n)*10) / 10)
+	}
+	id := r.ID
+	item := DiaryItem{
+		RecipeID: &id,
+		Name:     r.Name,
+		Grams:    scale(grams),
+		Calories: scale(kcal),
+		ProteinG: scale(protein),
+		FatG:     scale(fat),
+		CarbsG:   scale(carbs),
+	}
+	for k, v := range item.Micronutrients.Fields() {
+		if tracked[k] {
+			total := scale(micros[k])
+			*v = &total
+		}
+	}
+	return item
+}
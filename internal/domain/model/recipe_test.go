@@ -0,0 +1,96 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+func TestRecipePortion(t *testing.T) {
+	oats := model.Recipe{
+		ID:       7,
+		Name:     "Overnight oats",
+		Servings: 2,
+		Ingredients: []model.RecipeIngredient{
+			{Name: "Oats", Grams: 80, Calories: 303, ProteinG: 10.6, FatG: 5.5, CarbsG: 53},
+			{Name: "Milk", Grams: 300, Calories: 150, ProteinG: 9.9, FatG: 7.5, CarbsG: 14.1},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		recipe   func(model.Recipe) model.Recipe
+		servings float32
+		want     model.DiaryItem
+	}{
+		{
+			name:     "one serving",
+			recipe:   func(r model.Recipe) model.Recipe { return r },
+			servings: 1,
+			want:     model.DiaryItem{Name: "Overnight oats", Grams: 190, Calories: 226.5, ProteinG: 10.3, FatG: 6.5, CarbsG: 33.6},
+		},
+		{
+			name:     "portion multiplier",
+			recipe:   func(r model.Recipe) model.Recipe { return r },
+			servings: 1.5,
+			want:     model.DiaryItem{Name: "Overnight oats", Grams: 285, Calories: 339.8, ProteinG: 15.4, FatG: 9.8, CarbsG: 50.3},
+		},
+		{
+			name: "cooked yield",
+			recipe: func(r model.Recipe) model.Recipe {
+				r.YieldGrams = 500
+				return r
+			},
+			servings: 1,
+			want:     model.DiaryItem{Name: "Overnight oats", Grams: 250, Calories: 226.5, ProteinG: 10.3, FatG: 6.5, CarbsG: 33.6},
+		},
+		{
+			name: "saved meal without servings",
+			recipe: func(r model.Recipe) model.Recipe {
+				r.Servings = 0
+				return r
+			},
+			servings: 1,
+			want:     model.DiaryItem{Name: "Overnight oats", Grams: 380, Calories: 453, ProteinG: 20.5, FatG: 13, CarbsG: 67.1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.recipe(oats).Portion(tt.servings)
+			if got.RecipeID == nil || *got.RecipeID != 7 {
+				t.Fatalf("RecipeID = %v, want 7", got.RecipeID)
+			}
+			got.RecipeID = nil
+			if got != tt.want {
+				t.Errorf("Portion(%v) = %+v, want %+v", tt.servings, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecipePortionMicronutrients(t *testing.T) {
+	f := func(v float32) *float32 { return &v }
+	r := model.Recipe{
+		ID:       7,
+		Servings: 2,
+		Ingredients: []model.RecipeIngredient{
+			{Name: "Oats", Grams: 80, Micronutrients: model.Micronutrients{FibreG: f(8), SodiumMg: f(5)}},
+			{Name: "Milk", Grams: 300, Micronutrients: model.Micronutrients{SodiumMg: f(130), WaterMl: f(264)}},
+		},
+	}
+
+	got := r.Portion(1).Micronutrients
+	if got.FibreG == nil || *got.FibreG != 4 {
+		t.Errorf("FibreG = %v, want 4", got.FibreG)
+	}
+	if got.SodiumMg == nil || *got.SodiumMg != 67.5 {
+		t.Errorf("SodiumMg = %v, want 67.5", got.SodiumMg)
+	}
+	if got.WaterMl == nil || *got.WaterMl != 132 {
+		t.Errorf("WaterMl = %v, want 132", got.WaterMl)
+	}
+	if got.SugarG != nil || got.PotassiumMg != nil || got.CaffeineMg != nil {
+		t.Errorf("untracked micronutrients set: %+v", got)
+	}
+}
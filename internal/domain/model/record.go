@@ -0,0 +1,144 @@
+package model
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+type RecordKind string
+
+const (
+	RecordHeaviestWeight RecordKind = "heaviest_weight"
+	RecordMostReps       RecordKind = "most_reps"
+	RecordBestOneRM      RecordKind = "best_e1rm"
+	RecordSessionVolume  RecordKind = "session_volume"
+)
+
+// PersonalRecord is a performance of an exercise that beat everything the
+// athlete logged for it before. Value and PreviousValue are in kilograms,
+// except for RecordMostReps where they count reps at WeightKg. Reps and
+// WeightKg describe the record set and are zero for RecordSessionVolume.
+type PersonalRecord struct {
+	ID            int        `json:"id,omitempty" gorm:"primaryKey"`
+	UserID        int        `json:"userId" gorm:"index:idx_record_user_exercise;not null"`
+	ExerciseID    int        `json:"exerciseId" gorm:"index:idx_record_user_exercise;not null"`
+	Kind          RecordKind `json:"kind" gorm:"type:enum('heaviest_weight','most_reps','best_e1rm','session_volume');not null"`
+	Value         float64    `json:"value"`
+	PreviousValue float64    `json:"previousValue"`
+	Reps          int        `json:"reps,omitempty"`
+	WeightKg      float64    `json:"weightKg,omitempty"`
+	SessionID     *int       `json:"sessionId,omitempty" gorm:"index"`
+	ProgressID    *int       `json:"progressId,omitempty" gorm:"index"`
+	AchievedAt    time.Time  `json:"achievedAt" gorm:"index;not null"`
+
+	mysql.Model
+}
+
+// DetectRecords compares the sets of one workout with the athlete's history
+// and returns the records they set, at most one of each kind per series,
+// ordered by exercise. Program exercises that share a catalog entry are one
+// series, so history from any program counts; a record is attributed to the
+// program exercise of the workout. Sets of a workout share their Date, which
+// is how history is split into sessions for RecordSessionVolume. Only series
+// and, for RecordMostReps, weights that appear in history can set records: a
+// first performance has nothing to beat. Estimated 1RMs use the Epley formula.
+func DetectRecords(history, sets []PerformedSet) []PersonalRecord {
+	type volumeKey struct {
+		series SeriesKey
+		date   time.Time
+	}
+	type best struct {
+		heaviest, oneRM, volume float64
+		reps                    map[float64]int
+	}
+	bests := map[SeriesKey]*best{}
+	volumes := map[volumeKey]float64{}
+	for _, s := range history {
+		if s.Reps <= 0 {
+			continue
+		}
+		b, ok := bests[s.Series()]
+		if !ok {
+			b = &best{reps: map[float64]int{}}
+			bests[s.Series()] = b
+		}
+		b.heaviest = math.Max(b.heaviest, s.WeightKg)
+		b.oneRM = math.Max(b.oneRM, EstimateOneRM(FormulaEpley, s.WeightKg, s.Reps))
+		if s.Reps > b.reps[s.WeightKg] {
+			b.reps[s.WeightKg] = s.Reps
+		}
+		volumes[volumeKey{s.Series(), s.Date.UTC()}] += float64(s.Reps) * s.WeightKg
+	}
+	for k, v := range volumes {
+		bests[k.series].volume = math.Max(bests[k.series].volume, v)
+	}
+
+	type candidate struct {
+		heaviest, oneRM, mostReps *PersonalRecord
+		volume                    float64
+		exercise                  int
+		best                      *best
+		date                      time.Time
+	}
+	candidates := map[SeriesKey]*candidate{}
+	var order []*candidate
+	for _, s := range sets {
+		b, ok := bests[s.Series()]
+		if !ok || s.Reps <= 0 {
+			continue
+		}
+		c, ok := candidates[s.Series()]
+		if !ok {
+			c = &candidate{exercise: s.ExerciseID, best: b, date: s.Date}
+			candidates[s.Series()] = c
+			order = append(order, c)
+		}
+		c.volume += float64(s.Reps) * s.WeightKg
+		if s.WeightKg > b.heaviest && (c.heaviest == nil || s.WeightKg > c.heaviest.Value) {
+			c.heaviest = setRecord(RecordHeaviestWeight, s, s.WeightKg, b.heaviest)
+		}
+		if est := EstimateOneRM(FormulaEpley, s.WeightKg, s.Reps); est > b.oneRM && (c.oneRM == nil || est > c.oneRM.Value) {
+			c.oneRM = setRecord(RecordBestOneRM, s, est, b.oneRM)
+		}
+		prev, ok := b.reps[s.WeightKg]
+		if ok && s.Reps > prev && (c.mostReps == nil || s.Reps > c.mostReps.Reps) {
+			c.mostReps = setRecord(RecordMostReps, s, float64(s.Reps), float64(prev))
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool { return order[i].exercise < order[j].exercise })
+	var res []PersonalRecord
+	for _, c := range order {
+		for _, r := range []*PersonalRecord{c.heaviest, c.mostReps, c.oneRM} {
+			if r != nil {
+				res = append(res, *r)
+			}
+		}
+		volume, prev := math.Round(c.volume*10)/10, math.Round(c.best.volume*10)/10
+		if volume > prev {
+			res = append(res, PersonalRecord{
+				ExerciseID:    c.exercise,
+				Kind:          RecordSessionVolume,
+				Value:         volume,
+				PreviousValue: prev,
+				AchievedAt:    c.date,
+			})
+		}
+	}
+	return res
+}
+
+func setRecord(kind RecordKind, s PerformedSet, value, previous float64) *PersonalRecord {
+	return &PersonalRecord{
+		ExerciseID:    s.ExerciseID,
+		Kind:          kind,
+		Value:         value,
+		PreviousValue: previous,
+		Reps:          s.Reps,
+		WeightKg:      s.WeightKg,
+		AchievedAt:    s.Date,
+	}
+}
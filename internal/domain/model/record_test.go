@@ -0,0 +1,107 @@
+package model_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+func TestDetectRecords(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 3, d, 18, 0, 0, 0, time.UTC) }
+	history := []model.PerformedSet{
+		{ExerciseID: 5, Date: day(12), Reps: 5, WeightKg: 100},
+		{ExerciseID: 5, Date: day(12), Reps: 5, WeightKg: 100},
+		{ExerciseID: 5, Date: day(12), Reps: 3, WeightKg: 110},
+		{ExerciseID: 5, Date: day(14), Reps: 8, WeightKg: 90},
+	}
+
+	tests := []struct {
+		name string
+		sets []model.PerformedSet
+		want []model.PersonalRecord
+	}{
+		{
+			name: "no improvement",
+			sets: []model.PerformedSet{
+				{ExerciseID: 5, Date: day(19), Reps: 5, WeightKg: 100},
+				{ExerciseID: 5, Date: day(19), Reps: 5, WeightKg: 100},
+			},
+		},
+		{
+			name: "heavier single",
+			sets: []model.PerformedSet{
+				{ExerciseID: 5, Date: day(19), Reps: 1, WeightKg: 120},
+			},
+			want: []model.PersonalRecord{
+				{ExerciseID: 5, Kind: model.RecordHeaviestWeight, Value: 120, PreviousValue: 110, Reps: 1, WeightKg: 120, AchievedAt: day(19)},
+			},
+		},
+		{
+			name: "more reps at a known weight",
+			sets: []model.PerformedSet{
+				{ExerciseID: 5, Date: day(19), Reps: 7, WeightKg: 100},
+				{ExerciseID: 5, Date: day(19), Reps: 6, WeightKg: 100},
+				{ExerciseID: 5, Date: day(19), Reps: 5, WeightKg: 100},
+			},
+			want: []model.PersonalRecord{
+				{ExerciseID: 5, Kind: model.RecordMostReps, Value: 7, PreviousValue: 5, Reps: 7, WeightKg: 100, AchievedAt: day(19)},
+				{ExerciseID: 5, Kind: model.RecordBestOneRM, Value: 123.3, PreviousValue: 121, Reps: 7, WeightKg: 100, AchievedAt: day(19)},
+				{ExerciseID: 5, Kind: model.RecordSessionVolume, Value: 1800, PreviousValue: 1330, AchievedAt: day(19)},
+			},
+		},
+		{
+			name: "first performance of an exercise",
+			sets: []model.PerformedSet{
+				{ExerciseID: 6, Date: day(19), Reps: 10, WeightKg: 40},
+			},
+		},
+		{
+			name: "new weight is not a reps record",
+			sets: []model.PerformedSet{
+				{ExerciseID: 5, Date: day(19), Reps: 2, WeightKg: 105},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := model.DetectRecords(history, tt.sets)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DetectRecords() =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectRecords_SharesCatalogHistory(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 3, d, 18, 0, 0, 0, time.UTC) }
+	// Bench press from the athlete's own program and, later, from the copy a
+	// coach assigned: both are catalog entry 1.
+	history := []model.PerformedSet{
+		{ExerciseID: 5, CatalogID: 1, Date: day(12), Reps: 5, WeightKg: 80},
+	}
+	sets := []model.PerformedSet{
+		{ExerciseID: 9, CatalogID: 1, Date: day(19), Reps: 5, WeightKg: 75},
+		{ExerciseID: 9, CatalogID: 1, Date: day(19), Reps: 5, WeightKg: 85},
+	}
+
+	got := model.DetectRecords(history, sets)
+	want := []model.PersonalRecord{
+		{ExerciseID: 9, Kind: model.RecordHeaviestWeight, Value: 85, PreviousValue: 80, Reps: 5, WeightKg: 85, AchievedAt: day(19)},
+		{ExerciseID: 9, Kind: model.RecordBestOneRM, Value: 99.2, PreviousValue: 93.3, Reps: 5, WeightKg: 85, AchievedAt: day(19)},
+		{ExerciseID: 9, Kind: model.RecordSessionVolume, Value: 800, PreviousValue: 400, AchievedAt: day(19)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DetectRecords() = %+v\nwant %+v", got, want)
+	}
+
+	// Without the catalog the copy has no history to beat.
+	for i := range sets {
+		sets[i].CatalogID = 0
+	}
+	if got := model.DetectRecords(history, sets); len(got) != 0 {
+		t.Errorf("DetectRecords() without catalog = %+v, want none", got)
+	}
+}
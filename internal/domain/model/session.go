@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+type WorkoutSessionStatus string
+
+const (
+	SessionInProgress WorkoutSessionStatus = "in_progress"
+	SessionFinished   WorkoutSessionStatus = "finished"
+	SessionAbandoned  WorkoutSessionStatus = "abandoned"
+)
+
+// WorkoutSession is one performance of a program day. It is started when the
+// athlete begins training and closed by finishing it, which saves the sets
+// performed, or by abandoning it. An athlete has at most one session in
+// progress.
+type WorkoutSession struct {
+	ID        int                  `json:"id,omitempty" gorm:"primaryKey"`
+	UserID    int                  `json:"userId" gorm:"index;not null"`
+	ProgramID int                  `json:"programId" gorm:"index;not null"`
+	DayID     int                  `json:"dayId" gorm:"index;not null"`
+	Status    WorkoutSessionStatus `json:"status" gorm:"type:enum('in_progress','finished','abandoned');default:'in_progress';not null;index"`
+	StartedAt time.Time            `json:"startedAt" gorm:"index;not null"`
+	EndedAt   *time.Time           `json:"endedAt,omitempty"`
+	Notes     string               `json:"notes,omitempty" gorm:"type:text"`
+	Sets      []WorkoutSet         `json:"sets,omitempty" gorm:"foreignKey:SessionID;constraint:OnDelete:CASCADE"`
+	Records   []PersonalRecord     `json:"records,omitempty" gorm:"foreignKey:SessionID;constraint:OnDelete:CASCADE"`
+
+	mysql.Model
+}
+
+// WorkoutSet is one set of a program exercise performed in a session.
+// SetNumber counts from 1 per exercise. RPE is the rate of perceived exertion
+// on the 1-10 scale and Tempo the eccentric-pause-concentric-pause seconds,
+// e.g. "3-1-1-0".
+type WorkoutSet struct {
+	ID          int      `json:"id,omitempty" gorm:"primaryKey"`
+	SessionID   int      `json:"sessionId" gorm:"index;not null"`
+	ExerciseID  int      `json:"exerciseId" gorm:"index;not null"`
+	SetNumber   int      `json:"setNumber"`
+	Reps        int      `json:"reps"`
+	WeightKg    float64  `json:"weightKg" gorm:"type:decimal(6,2)"`
+	RPE         *float64 `json:"rpe,omitempty"`
+	Tempo       string   `json:"tempo,omitempty" gorm:"type:varchar(16)"`
+	RestSeconds *int     `json:"restSeconds,omitempty"`
+	Completed   bool     `json:"completed"`
+
+	mysql.Model
+}
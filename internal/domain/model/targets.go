@@ -0,0 +1,143 @@
+package model
+
+import (
+	"math"
+
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+type Sex string
+
+const (
+	SexMale   Sex = "male"
+	SexFemale Sex = "female"
+)
+
+type ActivityLevel string
+
+const (
+	ActivitySedentary  ActivityLevel = "sedentary"
+	ActivityLight      ActivityLevel = "light"
+	ActivityModerate   ActivityLevel = "moderate"
+	ActivityActive     ActivityLevel = "active"
+	ActivityVeryActive ActivityLevel = "very_active"
+)
+
+// activityFactors are the usual multipliers from BMR to total daily energy
+// expenditure.
+var activityFactors = map[ActivityLevel]float64{
+	ActivitySedentary:  1.2,
+	ActivityLight:      1.375,
+	ActivityModerate:   1.55,
+	ActivityActive:     1.725,
+	ActivityVeryActive: 1.9,
+}
+
+// goalSplit adjusts TDEE for a goal and fixes protein per kilogram of body
+// weight and the share of calories from fat; carbs take the rest.
+type goalSplit struct {
+	calorieFactor float64
+	proteinPerKg  float64
+	fatShare      float64
+}
+
+var goalSplits = map[Goal]goalSplit{
+	GoalLoseWeight:  {calorieFactor: 0.8, proteinPerKg: 2.0, fatShare: 0.25},
+	GoalGainWeight:  {calorieFactor: 1.1, proteinPerKg: 1.8, fatShare: 0.25},
+	GoalRehab:       {calorieFactor: 1.0, proteinPerKg: 1.6, fatShare: 0.3},
+	GoalKeepFit:     {calorieFactor: 1.0, proteinPerKg: 1.6, fatShare: 0.3},
+	GoalCompetition: {calorieFactor: 1.05, proteinPerKg: 2.0, fatShare: 0.2},
+}
+
+type TargetsSource string
+
+const (
+	TargetsComputed TargetsSource = "computed"
+	TargetsCoach    TargetsSource = "coach"
+)
+
+// NutritionTargets is a daily calorie and macro goal. BMR and TDEE are only
+// set when the targets were computed from the profile.
+type NutritionTargets struct {
+	Source   TargetsSource `json:"source" example:"computed"`
+	BMR      float64       `json:"bmr,omitempty" example:"1700"`
+	TDEE     float64       `json:"tdee,omitempty" example:"2635"`
+	Calories float64       `json:"calories" example:"2108"`
+	ProteinG float64       `json:"proteinG" example:"150"`
+	FatG     float64       `json:"fatG" example:"59"`
+	CarbsG   float64       `json:"carbsG" example:"240"`
+	SetBy    int           `json:"setBy,omitempty"`
+}
+
+// ComputeTargets derives daily targets from the profile: BMR by the
+// Mifflin-St Jeor equation, TDEE from the activity level, then calories and
+// macros from the goal. It reports false when the profile lacks any of the
+// inputs.
+func ComputeTargets(p UserProfile) (NutritionTargets, bool) {
+	factor, ok := activityFactors[p.ActivityLevel]
+	if !ok {
+		return NutritionTargets{}, false
+	}
+	split, ok := goalSplits[p.Goal]
+	if !ok || p.Age <= 0 || p.WeightKg <= 0 || p.HeightCm <= 0 {
+		return NutritionTargets{}, false
+	}
+
+	weight, height := float64(p.WeightKg), float64(p.HeightCm)
+	bmr := 10*weight + 6.25*height - 5*float64(p.Age)
+	switch p.Sex {
+	case SexMale:
+		bmr += 5
+	case SexFemale:
+		bmr -= 161
+	default:
+		return NutritionTargets{}, false
+	}
+
+	tdee := bmr * factor
+	calories := tdee * split.calorieFactor
+	protein := split.proteinPerKg * weight
+	fat := calories * split.fatShare / 9
+	carbs := math.Max(0, (calories-protein*4-fat*9)/4)
+
+	return NutritionTargets{
+		Source:   TargetsComputed,
+		BMR:      math.Round(bmr),
+		TDEE:     math.Round(tdee),
+		Calories: math.Round(calories),
+		ProteinG: math.Round(protein),
+		FatG:     math.Round(fat),
+		CarbsG:   math.Round(carbs),
+	}, true
+}
+
+// NutritionTargetOverride replaces a client's computed targets with values set
+// by their coach.
+type NutritionTargetOverride struct {
+	ID       int     `json:"id,omitempty" gorm:"primaryKey"`
+	UserID   int     `json:"userId" gorm:"uniqueIndex;not null"`
+	CoachID  int     `json:"coachId" gorm:"index;not null"`
+	Calories float64 `json:"calories"`
+	ProteinG float64 `json:"proteinG"`
+	FatG     float64 `json:"fatG"`
+	CarbsG   float64 `json:"carbsG"`
+
+	mysql.Model
+}
+
+// Totals returns the calorie and macro goals as totals to compare intake with.
+func (t NutritionTargets) Totals() NutritionTotals {
+	return NutritionTotals{Calories: t.Calories, ProteinG: t.ProteinG, FatG: t.FatG, CarbsG: t.CarbsG}
+}
+
+// Targets returns the override as nutrition targets.
+func (o NutritionTargetOverride) Targets() NutritionTargets {
+	return NutritionTargets{
+		Source:   TargetsCoach,
+		Calories: o.Calories,
+		ProteinG: o.ProteinG,
+		FatG:     o.FatG,
+		CarbsG:   o.CarbsG,
+		SetBy:    o.CoachID,
+	}
+}
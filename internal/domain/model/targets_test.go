@@ -0,0 +1,89 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+func TestComputeTargets(t *testing.T) {
+	base := model.UserProfile{
+		Age:           30,
+		WeightKg:      80,
+		HeightCm:      180,
+		Sex:           model.SexMale,
+		ActivityLevel: model.ActivityModerate,
+		Goal:          model.GoalKeepFit,
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(*model.UserProfile)
+		want   model.NutritionTargets
+		wantOK bool
+	}{
+		{
+			name:   "keep fit male",
+			mutate: func(*model.UserProfile) {},
+			want: model.NutritionTargets{
+				Source: model.TargetsComputed, BMR: 1780, TDEE: 2759,
+				Calories: 2759, ProteinG: 128, FatG: 92, CarbsG: 355,
+			},
+			wantOK: true,
+		},
+		{
+			name: "lose weight female",
+			mutate: func(p *model.UserProfile) {
+				p.Sex = model.SexFemale
+				p.WeightKg = 60
+				p.HeightCm = 165
+				p.ActivityLevel = model.ActivitySedentary
+				p.Goal = model.GoalLoseWeight
+			},
+			want: model.NutritionTargets{
+				Source: model.TargetsComputed, BMR: 1320, TDEE: 1584,
+				Calories: 1267, ProteinG: 120, FatG: 35, CarbsG: 118,
+			},
+			wantOK: true,
+		},
+		{
+			name:   "missing height",
+			mutate: func(p *model.UserProfile) { p.HeightCm = 0 },
+		},
+		{
+			name:   "missing sex",
+			mutate: func(p *model.UserProfile) { p.Sex = "" },
+		},
+		{
+			name:   "missing activity level",
+			mutate: func(p *model.UserProfile) { p.ActivityLevel = "" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := base
+			tt.mutate(&p)
+			got, ok := model.ComputeTargets(p)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if got != tt.want {
+				t.Errorf("targets = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNutritionTotalsAgainst(t *testing.T) {
+	eaten := model.NutritionTotals{Calories: 2500, ProteinG: 100, FatG: 90, CarbsG: 300}
+	target := model.NutritionTotals{Calories: 2200, ProteinG: 150, FatG: 70, CarbsG: 300}
+
+	remaining, exceeded := eaten.Against(target)
+	if want := (model.NutritionTotals{ProteinG: 50}); remaining != want {
+		t.Errorf("remaining = %+v, want %+v", remaining, want)
+	}
+	if want := (model.NutritionTotals{Calories: 300, FatG: 20}); exceeded != want {
+		t.Errorf("exceeded = %+v, want %+v", exceeded, want)
+	}
+}
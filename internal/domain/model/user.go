@@ -7,10 +7,20 @@ type User struct {
 	FullName string `json:"fullName,omitempty"`
 	Email    string `json:"email,omitempty" gorm:"not null;unique;index"`
 	Password string `json:"password,omitempty"`
+	Role     Role   `json:"role,omitempty" gorm:"type:enum('user','coach','admin');default:'user';not null;index"`
+	Disabled bool   `json:"disabled" gorm:"not null;default:false"`
 
 	mysql.Model
 }
 
+type Role string
+
+const (
+	RoleUser  Role = "user"
+	RoleCoach Role = "coach"
+	RoleAdmin Role = "admin"
+)
+
 type UserToken struct {
 	ID    int    `json:"id,omitempty" gorm:"primaryKey"`
 	Email string `json:"email,omitempty" gorm:"index"`
@@ -26,3 +36,14 @@ type RevokedToken struct {
 
 	mysql.Model
 }
+
+type RefreshToken struct {
+	ID        int    `json:"id,omitempty" gorm:"primaryKey"`
+	UserID    int    `json:"userId" gorm:"index;not null"`
+	FamilyID  string `json:"familyId" gorm:"index;size:64;not null"`
+	TokenHash string `json:"-" gorm:"uniqueIndex;size:64;not null"`
+	ExpiresAt int64  `json:"expiresAt"`
+	RevokedAt *int64 `json:"revokedAt,omitempty"`
+
+	mysql.Model
+}
@@ -10,4 +10,23 @@ import (
 type CalendarRepository interface {
 	AddAvailability(ctx context.Context, rows []model.CoachAvailability) error
 	ListAvailability(ctx context.Context, coachID int, from, to time.Time) ([]model.CoachAvailability, error)
+	ListOpenAvailability(ctx context.Context, coachID int, from, to time.Time) ([]model.CoachAvailability, error)
+	GetAvailabilityByID(ctx context.Context, id int) (*model.CoachAvailability, error)
+	MaterializeSlot(ctx context.Context, slot model.CoachAvailability) (*model.CoachAvailability, error)
+	CreateAvailabilityRule(ctx context.Context, rule model.AvailabilityRule) (*model.AvailabilityRule, error)
+	GetAvailabilityRuleByID(ctx context.Context, id int) (*model.AvailabilityRule, error)
+	ListAvailabilityRules(ctx context.Context, coachID int) ([]model.AvailabilityRule, error)
+	DeleteAvailabilityRule(ctx context.Context, id, coachID int) error
+	CreateAvailabilityException(ctx context.Context, ex model.AvailabilityException) (*model.AvailabilityException, error)
+	ListAvailabilityExceptions(ctx context.Context, coachID int) ([]model.AvailabilityException, error)
+	DeleteAvailabilityException(ctx context.Context, id, coachID int) error
+	CreateBooking(ctx context.Context, b model.Booking) (*model.Booking, error)
+	RescheduleBooking(ctx context.Context, id, availabilityID int) (*model.Booking, error)
+	GetBookingByID(ctx context.Context, id int) (*model.Booking, error)
+	CancelBooking(ctx context.Context, id, cancelledBy int, at time.Time) (*model.Booking, error)
+	ListUpcomingBookings(ctx context.Context, userID int, from time.Time) ([]model.Booking, error)
+	ListCoachBookings(ctx context.Context, coachID int, from, to time.Time) ([]model.Booking, error)
+	GetEventLink(ctx context.Context, userID int, source model.CalendarSource, sourceID int) (*model.CalendarEventLink, error)
+	SaveEventLink(ctx context.Context, link model.CalendarEventLink) error
+	DeleteEventLink(ctx context.Context, id int) error
 }
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type CardioRepository interface {
+	UpsertSessions(ctx context.Context, rows []model.CardioSession) error
+	ListSessions(ctx context.Context, userID int, from, to time.Time) ([]model.CardioSession, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type CoachingRepository interface {
+	CreateRelationship(ctx context.Context, rel model.CoachingRelationship) (*model.CoachingRelationship, error)
+	GetRelationshipByID(ctx context.Context, id int) (*model.CoachingRelationship, error)
+	UpdateRelationship(ctx context.Context, id int, rel model.CoachingRelationship) (*model.CoachingRelationship, error)
+	FindOpenRelationship(ctx context.Context, coachID int, clientEmail string) (*model.CoachingRelationship, error)
+	ListByCoach(ctx context.Context, coachID int, status model.CoachingStatus) ([]model.CoachingRelationship, error)
+	ListPendingByEmail(ctx context.Context, clientEmail string) ([]model.CoachingRelationship, error)
+	GetActiveByClient(ctx context.Context, clientID int) (*model.CoachingRelationship, error)
+	IsActiveCoach(ctx context.Context, coachID, clientID int) (bool, error)
+}
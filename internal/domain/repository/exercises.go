@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// ExerciseFilter narrows a catalog search. Zero fields do not filter; Muscle
+// matches primary and secondary muscle groups alike.
+type ExerciseFilter struct {
+	Query     string
+	Muscle    model.MuscleGroup
+	Equipment model.Equipment
+	Pattern   model.MovementPattern
+	Limit     int
+}
+
+type ExercisesRepository interface {
+	SearchExercises(ctx context.Context, f ExerciseFilter) ([]model.Exercise, error)
+	GetExerciseByID(ctx context.Context, id int) (*model.Exercise, error)
+	// GetExercisesByIDs returns the entries by the ID asked for. IDs of
+	// entries merged away resolve to the entry they were merged into; unknown
+	// IDs are left out.
+	GetExercisesByIDs(ctx context.Context, ids []int) (map[int]model.Exercise, error)
+	// FindExercisesByNames returns the entries the names refer to, by name or
+	// alias, keyed by the lower-cased name. Names matching nothing are left
+	// out.
+	FindExercisesByNames(ctx context.Context, names []string) (map[string]model.Exercise, error)
+	// InsertExercises adds the entries whose slug is not in the catalog yet
+	// and leaves the others alone.
+	InsertExercises(ctx context.Context, exercises []model.Exercise) error
+	// MergeExercises folds the entry id into intoID in one transaction: its
+	// name and aliases become aliases of intoID, program exercises referring
+	// to it are repointed and the entry is deleted.
+	MergeExercises(ctx context.Context, id, intoID int) (*model.Exercise, error)
+	// LinkProgramExercises links program exercises without a catalog entry to
+	// the entry their name refers to and returns how many were linked. It is
+	// safe to run repeatedly.
+	LinkProgramExercises(ctx context.Context) (int, error)
+}
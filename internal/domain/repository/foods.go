@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type FoodsRepository interface {
+	SearchFoods(ctx context.Context, query string, limit int) ([]model.Food, error)
+	GetFoodsByIDs(ctx context.Context, ids []int) ([]model.Food, error)
+	UpsertFoods(ctx context.Context, foods []model.Food) error
+	GetFoodByBarcode(ctx context.Context, barcode string) (*model.Food, error)
+
+	CreateFoodSubmission(ctx context.Context, s model.FoodSubmission) (*model.FoodSubmission, error)
+	HasPendingSubmission(ctx context.Context, userID int, barcode string) (bool, error)
+	ListFoodSubmissions(ctx context.Context, status model.FoodSubmissionStatus, limit, offset int) ([]model.FoodSubmission, int64, error)
+	// ApproveFoodSubmission adds the submitted food to the catalog and marks
+	// the submission approved in one transaction.
+	ApproveFoodSubmission(ctx context.Context, id, reviewerID int) (*model.FoodSubmission, error)
+	RejectFoodSubmission(ctx context.Context, id, reviewerID int, reason string) (*model.FoodSubmission, error)
+}
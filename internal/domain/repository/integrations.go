@@ -9,4 +9,9 @@ import (
 type IntegrationsRepository interface {
 	Upsert(ctx context.Context, row model.UserIntegration) (*model.UserIntegration, error)
 	GetByUserAndProvider(ctx context.Context, userID int, provider model.Provider) (*model.UserIntegration, error)
+	ListByUser(ctx context.Context, userID int) ([]model.UserIntegration, error)
+	UpdateStatus(ctx context.Context, userID int, provider model.Provider, status model.IntegrationStatus, lastError string) error
+	UpdateSyncCursor(ctx context.Context, userID int, provider model.Provider, cursor int64) error
+	UpdateCalendar(ctx context.Context, userID int, provider model.Provider, calendarID, timezone string) error
+	Delete(ctx context.Context, userID int, provider model.Provider) error
 }
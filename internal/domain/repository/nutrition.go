@@ -9,8 +9,33 @@ import (
 
 type NutritionRepository interface {
 	CreateEntry(ctx context.Context, e model.DiaryEntry) (*model.DiaryEntry, error)
+	CreateEntries(ctx context.Context, es []model.DiaryEntry) ([]model.DiaryEntry, error)
 	GetEntryByID(ctx context.Context, id, userID int) (*model.DiaryEntry, error)
 	ListEntriesByDate(ctx context.Context, userID int, date time.Time) ([]model.DiaryEntry, error)
+	// UpdateEntry replaces the entry's meal type and items in one transaction.
 	UpdateEntry(ctx context.Context, id, userID int, e model.DiaryEntry) (*model.DiaryEntry, error)
+	// AddItem, UpdateItem and DeleteItem change a single item of one of the
+	// user's entries in a transaction and return the updated entry. UpdateItem
+	// applies patch to the item as stored once the entry is locked, so
+	// concurrent edits of one entry are applied one after another.
+	AddItem(ctx context.Context, entryID, userID int, it model.DiaryItem) (*model.DiaryEntry, error)
+	UpdateItem(ctx context.Context, entryID, itemID, userID int, patch func(*model.DiaryItem) error) (*model.DiaryEntry, error)
+	DeleteItem(ctx context.Context, entryID, itemID, userID int) (*model.DiaryEntry, error)
 	DeleteEntry(ctx context.Context, id, userID int) error
+	// SummarizeEntries sums item macros per day and meal for diary dates in
+	// [from, to). Days without entries are left out.
+	SummarizeEntries(ctx context.Context, userID int, from, to time.Time) (*model.NutritionSummary, error)
+	// GetTargetOverride returns nil when the user's coach has not set targets.
+	GetTargetOverride(ctx context.Context, userID int) (*model.NutritionTargetOverride, error)
+	UpsertTargetOverride(ctx context.Context, o model.NutritionTargetOverride) (*model.NutritionTargetOverride, error)
+	DeleteTargetOverride(ctx context.Context, userID int) error
+	CreateHydrationEntry(ctx context.Context, h model.HydrationEntry) (*model.HydrationEntry, error)
+	ListHydrationEntries(ctx context.Context, userID int, date time.Time) ([]model.HydrationEntry, error)
+	DeleteHydrationEntry(ctx context.Context, id, userID int) error
+	// SummarizeHydration sums the hydration log per day for diary dates in
+	// [from, to). Days without drinks are left out.
+	SummarizeHydration(ctx context.Context, userID int, from, to time.Time) ([]model.HydrationDay, error)
+	// GetLimits returns nil when the user has not set limits.
+	GetLimits(ctx context.Context, userID int) (*model.NutritionLimits, error)
+	UpsertLimits(ctx context.Context, l model.NutritionLimits) (*model.NutritionLimits, error)
 }
@@ -2,14 +2,43 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/msskobelina/fit-profi/internal/domain/model"
 )
 
 type ProgramsRepository interface {
 	CreateProgram(ctx context.Context, p model.TrainingProgram) (*model.TrainingProgram, error)
+	CreatePrograms(ctx context.Context, ps []model.TrainingProgram) ([]model.TrainingProgram, error)
 	GetProgramByID(ctx context.Context, id int) (*model.TrainingProgram, error)
+	GetProgramByExerciseID(ctx context.Context, exerciseID int) (*model.TrainingProgram, error)
 	ListProgramsByUserID(ctx context.Context, userID int) ([]model.TrainingProgram, error)
 	DeleteProgram(ctx context.Context, id, userID int) error
 	TrackProgress(ctx context.Context, prog model.ExerciseProgress) (*model.ExerciseProgress, error)
+	ListProgressByProgram(ctx context.Context, programID, coachID int) ([]model.ExerciseProgress, error)
+	// ListProgress returns the user's progress rows, newest first. A zero
+	// exerciseID or time bound does not filter.
+	ListProgress(ctx context.Context, userID, exerciseID int, from, to time.Time) ([]model.ExerciseProgress, error)
+	// ListPerformedSets returns every set the user performed in [from, to),
+	// from finished sessions and from aggregate progress alike. An exerciseID
+	// also matches the program exercises of the same catalog entry; a zero
+	// exerciseID or time bound does not filter.
+	ListPerformedSets(ctx context.Context, userID, exerciseID int, from, to time.Time) ([]model.PerformedSet, error)
+	CreateScheduledWorkout(ctx context.Context, w model.ScheduledWorkout) (*model.ScheduledWorkout, error)
+	GetScheduledWorkoutByID(ctx context.Context, id int) (*model.ScheduledWorkout, error)
+	UpdateScheduledWorkout(ctx context.Context, id int, start, end time.Time) (*model.ScheduledWorkout, error)
+	DeleteScheduledWorkout(ctx context.Context, id, userID int) error
+	ListScheduledWorkouts(ctx context.Context, userID int, from, to time.Time) ([]model.ScheduledWorkout, error)
+	CreateSession(ctx context.Context, s model.WorkoutSession) (*model.WorkoutSession, error)
+	GetSessionByID(ctx context.Context, id int) (*model.WorkoutSession, error)
+	// GetActiveSession returns nil when the user has no session in progress.
+	GetActiveSession(ctx context.Context, userID int) (*model.WorkoutSession, error)
+	// CloseSession sets the status, end time and notes of a session in
+	// progress and saves its sets and personal records in one transaction.
+	CloseSession(ctx context.Context, s model.WorkoutSession) (*model.WorkoutSession, error)
+	ListSessions(ctx context.Context, userID int, from, to time.Time) ([]model.WorkoutSession, error)
+	// ListRecords returns the user's personal records, newest first. An
+	// exerciseID also matches the program exercises of the same catalog entry;
+	// a zero exerciseID, kind or time bound does not filter.
+	ListRecords(ctx context.Context, userID, exerciseID int, kind model.RecordKind, from, to time.Time) ([]model.PersonalRecord, error)
 }
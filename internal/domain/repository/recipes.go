@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+type RecipesRepository interface {
+	CreateRecipe(ctx context.Context, r model.Recipe) (*model.Recipe, error)
+	CreateRecipes(ctx context.Context, rs []model.Recipe) ([]model.Recipe, error)
+	GetRecipeByID(ctx context.Context, id, userID int) (*model.Recipe, error)
+	ListRecipes(ctx context.Context, userID int) ([]model.Recipe, error)
+	// UpdateRecipe replaces the recipe's fields and ingredients in one
+	// transaction.
+	UpdateRecipe(ctx context.Context, id, userID int, r model.Recipe) (*model.Recipe, error)
+	DeleteRecipe(ctx context.Context, id, userID int) error
+}
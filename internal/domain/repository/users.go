@@ -15,4 +15,15 @@ type UsersRepository interface {
 	SaveRevokedToken(ctx context.Context, jti string, exp int64) error
 	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
 	ResetPassword(ctx context.Context, token, passwordHash string) error
+	GetUserByID(ctx context.Context, id int) (*model.User, error)
+	CreateRefreshToken(ctx context.Context, rt model.RefreshToken) error
+	GetRefreshToken(ctx context.Context, tokenHash string) (*model.RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, oldID int, next model.RefreshToken) (bool, error)
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) error
+	RevokeUserRefreshTokens(ctx context.Context, userID int) error
+	ListUsers(ctx context.Context, search string, role model.Role, limit, offset int) ([]model.User, int64, error)
+	CountUsersByRole(ctx context.Context, role model.Role) (int64, error)
+	UpdateUserRole(ctx context.Context, id int, role model.Role) error
+	SetUserDisabled(ctx context.Context, id int, disabled bool) error
+	DeleteUser(ctx context.Context, id int) error
 }
@@ -0,0 +1,304 @@
+package calendarsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	googlecalendar "google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/providers"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+	"github.com/msskobelina/fit-profi/pkg/logger"
+)
+
+// Syncer mirrors bookings and scheduled workouts into the Google Calendars of
+// the users involved. SyncBooking and SyncWorkout only enqueue work; Run
+// processes the queue and retries failed calls with exponential backoff, so
+// callers never wait on or fail because of the Calendar API.
+type Syncer interface {
+	SyncBooking(bookingID int)
+	SyncWorkout(userID, workoutID int)
+	Run(ctx context.Context)
+}
+
+type Config struct {
+	Providers *providers.Registry
+	// Endpoint overrides the Calendar API base URL.
+	Endpoint    string
+	MaxAttempts int
+	Backoff     time.Duration
+	QueueSize   int
+}
+
+type job struct {
+	source  model.CalendarSource
+	id      int
+	userID  int
+	attempt int
+}
+
+type syncer struct {
+	calendar     repository.CalendarRepository
+	programs     repository.ProgramsRepository
+	integrations repository.IntegrationsRepository
+	cfg          Config
+	log          logger.Interface
+	jobs         chan job
+}
+
+func NewSyncer(
+	calendar repository.CalendarRepository,
+	programs repository.ProgramsRepository,
+	integrations repository.IntegrationsRepository,
+	cfg Config,
+	l logger.Interface,
+) Syncer {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 5
+	}
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = 2 * time.Second
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 256
+	}
+	return &syncer{
+		calendar:     calendar,
+		programs:     programs,
+		integrations: integrations,
+		cfg:          cfg,
+		log:          l,
+		jobs:         make(chan job, cfg.QueueSize),
+	}
+}
+
+func (s *syncer) SyncBooking(bookingID int) {
+	s.enqueue(job{source: model.CalendarSourceBooking, id: bookingID})
+}
+
+func (s *syncer) SyncWorkout(userID, workoutID int) {
+	s.enqueue(job{source: model.CalendarSourceWorkout, id: workoutID, userID: userID})
+}
+
+func (s *syncer) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case j := <-s.jobs:
+			s.process(ctx, j)
+		}
+	}
+}
+
+func (s *syncer) enqueue(j job) {
+	select {
+	case s.jobs <- j:
+	default:
+		s.log.Error("calendar sync queue is full, dropping job", "source", j.source, "id", j.id)
+	}
+}
+
+func (s *syncer) process(ctx context.Context, j job) {
+	err := s.sync(ctx, j)
+	if err == nil {
+		return
+	}
+	j.attempt++
+	if !retryable(err) || j.attempt >= s.cfg.MaxAttempts {
+		s.log.Error("calendar sync failed", "source", j.source, "id", j.id, "attempts", j.attempt, "err", err)
+		return
+	}
+	delay := s.cfg.Backoff << (j.attempt - 1)
+	s.log.Warn("calendar sync failed, retrying", "source", j.source, "id", j.id, "in", delay, "err", err)
+	time.AfterFunc(delay, func() { s.enqueue(j) })
+}
+
+func (s *syncer) sync(ctx context.Context, j job) error {
+	switch j.source {
+	case model.CalendarSourceBooking:
+		b, err := s.calendar.GetBookingByID(ctx, j.id)
+		if err != nil {
+			return err
+		}
+		for _, userID := range []int{b.CoachID, b.ClientID} {
+			if b.Status == model.BookingBooked {
+				err = s.upsert(ctx, userID, j.source, b.ID, bookingEvent(b))
+			} else {
+				err = s.remove(ctx, userID, j.source, b.ID)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case model.CalendarSourceWorkout:
+		w, err := s.programs.GetScheduledWorkoutByID(ctx, j.id)
+		if utilsErrors.IsNotFound(err) {
+			return s.remove(ctx, j.userID, j.source, j.id)
+		}
+		if err != nil {
+			return err
+		}
+		p, err := s.programs.GetProgramByID(ctx, w.ProgramID)
+		if err != nil {
+			return err
+		}
+		return s.upsert(ctx, w.UserID, j.source, w.ID, workoutEvent(w, p))
+	}
+	return fmt.Errorf("unknown calendar source %q", j.source)
+}
+
+// upsert creates or updates the source's event. Event IDs are derived from the
+// source, so an insert repeated after a lost response updates the same event.
+func (s *syncer) upsert(ctx context.Context, userID int, source model.CalendarSource, sourceID int, ev *googlecalendar.Event) error {
+	svc, integ, err := s.service(ctx, userID)
+	if err != nil || svc == nil {
+		return err
+	}
+	ev.Start.TimeZone = integ.Timezone
+	ev.End.TimeZone = integ.Timezone
+	ev.Status = "confirmed"
+
+	link, err := s.calendar.GetEventLink(ctx, userID, source, sourceID)
+	if err != nil {
+		return err
+	}
+	if link != nil {
+		_, err = svc.Events.Update(link.CalendarID, link.EventID, ev).Context(ctx).Do()
+		if !hasStatus(err, http.StatusNotFound, http.StatusGone) {
+			return err
+		}
+	}
+
+	calID := integ.CalendarID
+	if calID == "" {
+		calID = "primary"
+	}
+	ev.Id = eventID(userID, source, sourceID)
+	out, err := svc.Events.Insert(calID, ev).Context(ctx).Do()
+	if hasStatus(err, http.StatusConflict) {
+		out, err = svc.Events.Update(calID, ev.Id, ev).Context(ctx).Do()
+	}
+	if err != nil {
+		return err
+	}
+	return s.calendar.SaveEventLink(ctx, model.CalendarEventLink{
+		UserID:     userID,
+		SourceType: source,
+		SourceID:   sourceID,
+		CalendarID: calID,
+		EventID:    out.Id,
+	})
+}
+
+func (s *syncer) remove(ctx context.Context, userID int, source model.CalendarSource, sourceID int) error {
+	link, err := s.calendar.GetEventLink(ctx, userID, source, sourceID)
+	if err != nil || link == nil {
+		return err
+	}
+	svc, _, err := s.service(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if svc != nil {
+		err = svc.Events.Delete(link.CalendarID, link.EventID).Context(ctx).Do()
+		if err != nil && !hasStatus(err, http.StatusNotFound, http.StatusGone) {
+			return err
+		}
+	}
+	return s.calendar.DeleteEventLink(ctx, link.ID)
+}
+
+// service returns a nil service when the user hasn't connected Google.
+func (s *syncer) service(ctx context.Context, userID int) (*googlecalendar.Service, *model.UserIntegration, error) {
+	integ, err := s.integrations.GetByUserAndProvider(ctx, userID, model.ProviderGoogle)
+	if utilsErrors.IsNotFound(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ts, err := s.cfg.Providers.TokenSource(ctx, s.integrations, integ)
+	if err != nil {
+		return nil, nil, err
+	}
+	opts := []option.ClientOption{option.WithTokenSource(ts)}
+	if s.cfg.Endpoint != "" {
+		opts = append(opts, option.WithEndpoint(s.cfg.Endpoint))
+	}
+	svc, err := googlecalendar.NewService(ctx, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return svc, integ, nil
+}
+
+func bookingEvent(b *model.Booking) *googlecalendar.Event {
+	return &googlecalendar.Event{
+		Summary:     "Coaching session",
+		Description: b.Notes,
+		Start:       &googlecalendar.EventDateTime{DateTime: b.StartTime.Format(time.RFC3339)},
+		End:         &googlecalendar.EventDateTime{DateTime: b.EndTime.Format(time.RFC3339)},
+	}
+}
+
+func workoutEvent(w *model.ScheduledWorkout, p *model.TrainingProgram) *googlecalendar.Event {
+	summary := p.Title
+	for _, d := range p.Days {
+		if d.ID == w.DayID && d.Title != "" {
+			summary = p.Title + ": " + d.Title
+		}
+	}
+	return &googlecalendar.Event{
+		Summary:     summary,
+		Description: w.Notes,
+		Start:       &googlecalendar.EventDateTime{DateTime: w.StartTime.Format(time.RFC3339)},
+		End:         &googlecalendar.EventDateTime{DateTime: w.EndTime.Format(time.RFC3339)},
+	}
+}
+
+// eventID builds a Google event ID (base32hex: a-v and 0-9) unique per user
+// and source. Every letter, including the kind, must stay within a-v.
+func eventID(userID int, source model.CalendarSource, sourceID int) string {
+	kind := "b"
+	if source == model.CalendarSourceWorkout {
+		kind = "p"
+	}
+	return fmt.Sprintf("fitprofi%s%010d%010d", kind, userID, sourceID)
+}
+
+func hasStatus(err error, codes ...int) bool {
+	var gerr *googleapi.Error
+	if !errors.As(err, &gerr) {
+		return false
+	}
+	for _, c := range codes {
+		if gerr.Code == c {
+			return true
+		}
+	}
+	return false
+}
+
+// retryable reports whether a failed sync may succeed later: Google outages,
+// rate limits and network errors are retried, other API errors and revoked
+// grants are not.
+func retryable(err error) bool {
+	if providers.IsReconnectRequired(err) {
+		return false
+	}
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) {
+		return gerr.Code == http.StatusTooManyRequests || gerr.Code >= 500
+	}
+	return !utilsErrors.IsNotFound(err)
+}
@@ -0,0 +1,307 @@
+package calendarsync_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/calendarsync"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/providers"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+	"github.com/msskobelina/fit-profi/pkg/logger"
+)
+
+type fakeCalendarRepo struct {
+	repository.CalendarRepository
+
+	mu       sync.Mutex
+	bookings map[int]*model.Booking
+	links    map[int]*model.CalendarEventLink
+}
+
+func (f *fakeCalendarRepo) GetBookingByID(_ context.Context, id int) (*model.Booking, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	b := *f.bookings[id]
+	return &b, nil
+}
+
+func (f *fakeCalendarRepo) GetEventLink(_ context.Context, userID int, source model.CalendarSource, sourceID int) (*model.CalendarEventLink, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, l := range f.links {
+		if l.UserID == userID && l.SourceType == source && l.SourceID == sourceID {
+			cp := *l
+			return &cp, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeCalendarRepo) SaveEventLink(_ context.Context, link model.CalendarEventLink) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	link.ID = len(f.links) + 1
+	f.links[link.ID] = &link
+	return nil
+}
+
+func (f *fakeCalendarRepo) DeleteEventLink(_ context.Context, id int) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.links, id)
+	return nil
+}
+
+func (f *fakeCalendarRepo) linkCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.links)
+}
+
+type fakeIntegrationsRepo struct {
+	repository.IntegrationsRepository
+	connected map[int]bool
+}
+
+func (f *fakeIntegrationsRepo) GetByUserAndProvider(_ context.Context, userID int, provider model.Provider) (*model.UserIntegration, error) {
+	if !f.connected[userID] {
+		return nil, &utilsErrors.Error{Message: "Integration not found", Status: http.StatusNotFound}
+	}
+	return &model.UserIntegration{
+		UserID:      userID,
+		Provider:    provider,
+		AccessToken: "token",
+		ExpiryUnix:  time.Now().Add(time.Hour).Unix(),
+		CalendarID:  "primary",
+		Timezone:    "Europe/Kyiv",
+	}, nil
+}
+
+type fakeProgramsRepo struct {
+	repository.ProgramsRepository
+	workouts map[int]*model.ScheduledWorkout
+}
+
+func (f *fakeProgramsRepo) GetScheduledWorkoutByID(_ context.Context, id int) (*model.ScheduledWorkout, error) {
+	w, ok := f.workouts[id]
+	if !ok {
+		return nil, &utilsErrors.Error{Message: "Scheduled workout not found", Status: http.StatusNotFound}
+	}
+	return w, nil
+}
+
+func (f *fakeProgramsRepo) GetProgramByID(_ context.Context, id int) (*model.TrainingProgram, error) {
+	return &model.TrainingProgram{ID: id, Title: "Full Body", Days: []model.ProgramDay{{ID: 3, Title: "Day A"}}}, nil
+}
+
+// validEventID matches what the Calendar API accepts as an event ID:
+// at least five base32hex characters.
+var validEventID = regexp.MustCompile(`^[a-v0-9]{5,}$`)
+
+// calendarStandIn imitates the Calendar API events endpoints. The first
+// failures requests are answered with 503; inserts with an invalid event ID
+// are rejected with 400 like the real API does.
+type calendarStandIn struct {
+	mu       sync.Mutex
+	failures int
+	requests []string
+	ids      []string
+}
+
+func (c *calendarStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.requests = append(c.requests, r.Method+" "+r.URL.Path)
+	if c.failures > 0 {
+		c.failures--
+		http.Error(w, `{"error":{"code":503,"message":"backend error"}}`, http.StatusServiceUnavailable)
+		return
+	}
+	if r.Method == http.MethodDelete {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	var ev map[string]any
+	_ = json.NewDecoder(r.Body).Decode(&ev)
+	if ev == nil {
+		ev = map[string]any{}
+	}
+	if id, ok := ev["id"].(string); ok && r.Method == http.MethodPost {
+		c.ids = append(c.ids, id)
+		if !validEventID.MatchString(id) {
+			http.Error(w, `{"error":{"code":400,"message":"Invalid resource id value."}}`, http.StatusBadRequest)
+			return
+		}
+	}
+	if _, ok := ev["id"]; !ok {
+		parts := strings.Split(r.URL.Path, "/")
+		ev["id"] = parts[len(parts)-1]
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(ev)
+}
+
+func (c *calendarStandIn) seen() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.requests...)
+}
+
+func (c *calendarStandIn) insertedIDs() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string(nil), c.ids...)
+}
+
+func newSyncer(t *testing.T, cal *fakeCalendarRepo, programs repository.ProgramsRepository, integ *fakeIntegrationsRepo, api *calendarStandIn) calendarsync.Syncer {
+	t.Helper()
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+
+	s := calendarsync.NewSyncer(cal, programs, integ, calendarsync.Config{
+		Providers:   providers.NewRegistry(providers.NewGoogle(providers.Config{})),
+		Endpoint:    srv.URL + "/",
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+	}, logger.New("error"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go s.Run(ctx)
+	return s
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met before deadline")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func booking(status model.BookingStatus) *model.Booking {
+	start := time.Date(2030, 3, 18, 7, 0, 0, 0, time.UTC)
+	return &model.Booking{ID: 1, CoachID: 10, ClientID: 20, StartTime: start, EndTime: start.Add(time.Hour), Status: status}
+}
+
+func TestSyncBooking_CreatesEventForConnectedUsers(t *testing.T) {
+	cal := &fakeCalendarRepo{
+		bookings: map[int]*model.Booking{1: booking(model.BookingBooked)},
+		links:    map[int]*model.CalendarEventLink{},
+	}
+	integ := &fakeIntegrationsRepo{connected: map[int]bool{20: true}}
+	api := &calendarStandIn{}
+
+	newSyncer(t, cal, nil, integ, api).SyncBooking(1)
+	waitFor(t, func() bool { return cal.linkCount() == 1 })
+
+	link, _ := cal.GetEventLink(context.Background(), 20, model.CalendarSourceBooking, 1)
+	if link == nil || link.EventID == "" {
+		t.Fatalf("link = %+v, want stored event ID", link)
+	}
+	if got := api.seen(); len(got) != 1 || got[0] != "POST /calendars/primary/events" {
+		t.Errorf("requests = %v, want a single insert", got)
+	}
+}
+
+func TestSyncBooking_RetriesOutage(t *testing.T) {
+	cal := &fakeCalendarRepo{
+		bookings: map[int]*model.Booking{1: booking(model.BookingBooked)},
+		links:    map[int]*model.CalendarEventLink{},
+	}
+	integ := &fakeIntegrationsRepo{connected: map[int]bool{20: true}}
+	api := &calendarStandIn{failures: 2}
+
+	newSyncer(t, cal, nil, integ, api).SyncBooking(1)
+	waitFor(t, func() bool { return cal.linkCount() == 1 })
+
+	if got := len(api.seen()); got != 3 {
+		t.Errorf("requests = %d, want 3 (two failures and a success)", got)
+	}
+}
+
+func TestSyncBooking_UpdatesExistingEvent(t *testing.T) {
+	cal := &fakeCalendarRepo{
+		bookings: map[int]*model.Booking{1: booking(model.BookingBooked)},
+		links: map[int]*model.CalendarEventLink{
+			1: {ID: 1, UserID: 20, SourceType: model.CalendarSourceBooking, SourceID: 1, CalendarID: "primary", EventID: "evt1"},
+		},
+	}
+	integ := &fakeIntegrationsRepo{connected: map[int]bool{20: true}}
+	api := &calendarStandIn{}
+
+	newSyncer(t, cal, nil, integ, api).SyncBooking(1)
+	waitFor(t, func() bool { return len(api.seen()) == 1 })
+
+	if got := api.seen()[0]; got != "PUT /calendars/primary/events/evt1" {
+		t.Errorf("request = %q, want update of the linked event", got)
+	}
+}
+
+func TestSyncBooking_DeletesEventOnCancel(t *testing.T) {
+	cal := &fakeCalendarRepo{
+		bookings: map[int]*model.Booking{1: booking(model.BookingCancelled)},
+		links: map[int]*model.CalendarEventLink{
+			1: {ID: 1, UserID: 20, SourceType: model.CalendarSourceBooking, SourceID: 1, CalendarID: "primary", EventID: "evt1"},
+		},
+	}
+	integ := &fakeIntegrationsRepo{connected: map[int]bool{20: true}}
+	api := &calendarStandIn{}
+
+	newSyncer(t, cal, nil, integ, api).SyncBooking(1)
+	waitFor(t, func() bool { return cal.linkCount() == 0 })
+
+	if got := api.seen(); len(got) != 1 || got[0] != "DELETE /calendars/primary/events/evt1" {
+		t.Errorf("requests = %v, want a single delete", got)
+	}
+}
+
+func TestSyncWorkout_InsertsEventWithValidID(t *testing.T) {
+	start := time.Date(2030, 3, 18, 7, 0, 0, 0, time.UTC)
+	cal := &fakeCalendarRepo{links: map[int]*model.CalendarEventLink{}}
+	programs := &fakeProgramsRepo{workouts: map[int]*model.ScheduledWorkout{
+		7: {ID: 7, UserID: 20, ProgramID: 1, DayID: 3, StartTime: start, EndTime: start.Add(time.Hour)},
+	}}
+	integ := &fakeIntegrationsRepo{connected: map[int]bool{20: true}}
+	api := &calendarStandIn{}
+
+	newSyncer(t, cal, programs, integ, api).SyncWorkout(20, 7)
+	waitFor(t, func() bool { return cal.linkCount() == 1 })
+
+	ids := api.insertedIDs()
+	if len(ids) != 1 || !validEventID.MatchString(ids[0]) {
+		t.Fatalf("inserted IDs = %v, want one valid base32hex ID", ids)
+	}
+	link, _ := cal.GetEventLink(context.Background(), 20, model.CalendarSourceWorkout, 7)
+	if link == nil || link.EventID != ids[0] {
+		t.Errorf("link = %+v, want event %s", link, ids[0])
+	}
+}
+
+func TestSyncWorkout_RemovesEventOfDeletedWorkout(t *testing.T) {
+	cal := &fakeCalendarRepo{links: map[int]*model.CalendarEventLink{
+		1: {ID: 1, UserID: 20, SourceType: model.CalendarSourceWorkout, SourceID: 7, CalendarID: "primary", EventID: "evt7"},
+	}}
+	programs := &fakeProgramsRepo{workouts: map[int]*model.ScheduledWorkout{}}
+	integ := &fakeIntegrationsRepo{connected: map[int]bool{20: true}}
+	api := &calendarStandIn{}
+
+	newSyncer(t, cal, programs, integ, api).SyncWorkout(20, 7)
+	waitFor(t, func() bool { return cal.linkCount() == 0 })
+
+	if got := api.seen(); len(got) != 1 || got[0] != "DELETE /calendars/primary/events/evt7" {
+		t.Errorf("requests = %v, want a single delete", got)
+	}
+}
@@ -0,0 +1,62 @@
+// Package exercisecatalog seeds the exercise catalog from the bundled
+// exercises.json.
+package exercisecatalog
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+//go:embed exercises.json
+var bundled []byte
+
+// Bundled returns the entries of the bundled seed file. It fails on unknown
+// fields, duplicate slugs and values outside the catalog enums, so a bad edit
+// to the file is caught by the tests rather than at startup.
+func Bundled() ([]model.Exercise, error) {
+	dec := json.NewDecoder(bytes.NewReader(bundled))
+	dec.DisallowUnknownFields()
+	var exercises []model.Exercise
+	if err := dec.Decode(&exercises); err != nil {
+		return nil, fmt.Errorf("exercises.json: %w", err)
+	}
+	slugs := make(map[string]bool, len(exercises))
+	for _, e := range exercises {
+		switch {
+		case e.Slug == "" || e.Name == "":
+			return nil, fmt.Errorf("exercises.json: entry without slug or name: %+v", e)
+		case slugs[e.Slug]:
+			return nil, fmt.Errorf("exercises.json: duplicate slug %q", e.Slug)
+		case len(e.PrimaryMuscles) == 0:
+			return nil, fmt.Errorf("exercises.json: %s: no primary muscle group", e.Slug)
+		case !e.Equipment.Valid():
+			return nil, fmt.Errorf("exercises.json: %s: unknown equipment %q", e.Slug, e.Equipment)
+		case !e.MovementPattern.Valid():
+			return nil, fmt.Errorf("exercises.json: %s: unknown movement pattern %q", e.Slug, e.MovementPattern)
+		}
+		for _, m := range append(append(model.MuscleGroups{}, e.PrimaryMuscles...), e.SecondaryMuscles...) {
+			if !m.Valid() {
+				return nil, fmt.Errorf("exercises.json: %s: unknown muscle group %q", e.Slug, m)
+			}
+		}
+		slugs[e.Slug] = true
+	}
+	return exercises, nil
+}
+
+// Seed adds the bundled entries missing from the catalog. Entries already
+// there are left as they are, so edits and merges made by admins survive
+// restarts.
+func Seed(ctx context.Context, repo repository.ExercisesRepository) error {
+	exercises, err := Bundled()
+	if err != nil {
+		return err
+	}
+	return repo.InsertExercises(ctx, exercises)
+}
@@ -0,0 +1,34 @@
+package exercisecatalog_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/msskobelina/fit-profi/internal/infrastructure/exercisecatalog"
+)
+
+func TestBundled(t *testing.T) {
+	exercises, err := exercisecatalog.Bundled()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exercises) == 0 {
+		t.Fatal("bundled catalog is empty")
+	}
+
+	// Aliases are stored joined by "|" and searched case-insensitively; a
+	// name shared by two entries would make search ambiguous.
+	names := map[string]string{}
+	for _, e := range exercises {
+		for _, n := range append([]string{e.Name}, e.Aliases...) {
+			if strings.Contains(n, "|") {
+				t.Errorf("%s: name %q contains the list separator", e.Slug, n)
+			}
+			key := strings.ToLower(n)
+			if other, ok := names[key]; ok && other != e.Slug {
+				t.Errorf("%q names both %s and %s", n, other, e.Slug)
+			}
+			names[key] = e.Slug
+		}
+	}
+}
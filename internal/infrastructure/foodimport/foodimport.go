@@ -0,0 +1,287 @@
+// Package foodimport loads food catalog dumps into the foods table. It reads
+// the CSV download of USDA FoodData Central and the CSV export of Open Food
+// Facts.
+package foodimport
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/pkg/barcode"
+)
+
+// ReadFunc streams foods from a dump, calling emit for each one.
+type ReadFunc func(emit func(model.Food) error) error
+
+// Load writes the foods produced by read to the repository in batches and
+// returns how many were written.
+func Load(ctx context.Context, repo repository.FoodsRepository, batchSize int, read ReadFunc) (int, error) {
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+	var (
+		batch = make([]model.Food, 0, batchSize)
+		total int
+	)
+	flush := func() error {
+		if err := repo.UpsertFoods(ctx, batch); err != nil {
+			return err
+		}
+		total += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+	err := read(func(f model.Food) error {
+		batch = append(batch, f)
+		if len(batch) == batchSize {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return total, err
+	}
+	if len(batch) > 0 {
+		if err := flush(); err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// OpenFoodFacts reads the tab-separated products export. Products without a
+// name or energy value are skipped; codes that fail the checksum are imported
+// without a barcode.
+func OpenFoodFacts(r io.Reader) ReadFunc {
+	return func(emit func(model.Food) error) error {
+		rows, err := newReader(r, '\t')
+		if err != nil {
+			return err
+		}
+		for {
+			row, err := rows.next()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			name := row.get("product_name")
+			kcal, ok := row.float("energy-kcal_100g")
+			if !ok {
+				// Older exports only carry energy in kJ.
+				if kj, found := row.float("energy_100g"); found {
+					kcal, ok = kj/4.184, true
+				}
+			}
+			if name == "" || row.get("code") == "" || !ok {
+				continue
+			}
+			protein, _ := row.float("proteins_100g")
+			fat, _ := row.float("fat_100g")
+			carbs, _ := row.float("carbohydrates_100g")
+			serving, _ := row.float("serving_quantity")
+			code, _ := barcode.Normalize(row.get("code"))
+			if err := emit(model.Food{
+				Source:          model.FoodSourceOpenFoodFacts,
+				ExternalID:      row.get("code"),
+				Name:            truncate(name),
+				Brand:           truncate(firstBrand(row.get("brands"))),
+				Barcode:         code,
+				ServingGrams:    serving,
+				CaloriesPer100g: kcal,
+				ProteinPer100g:  protein,
+				FatPer100g:      fat,
+				CarbsPer100g:    carbs,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// FoodData Central nutrient IDs. Foundation foods report energy only as
+// Atwater factors, so those are used when 1008 is missing.
+const (
+	nutrientProtein        = 1003
+	nutrientFat            = 1004
+	nutrientCarbs          = 1005
+	nutrientEnergy         = 1008
+	nutrientEnergyGeneral  = 2047
+	nutrientEnergySpecific = 2048
+)
+
+// USDA reads food.csv and food_nutrient.csv from a FoodData Central download.
+// Nutrient amounts there are already per 100 g. Foods without energy are
+// skipped.
+func USDA(foods, nutrients io.Reader) ReadFunc {
+	return func(emit func(model.Food) error) error {
+		type entry struct {
+			food   model.Food
+			energy map[int]float64
+		}
+		var order []string
+		byID := map[string]*entry{}
+
+		rows, err := newReader(foods, ',')
+		if err != nil {
+			return fmt.Errorf("food.csv: %w", err)
+		}
+		for {
+			row, err := rows.next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("food.csv: %w", err)
+			}
+			id, name := row.get("fdc_id"), row.get("description")
+			if id == "" || name == "" {
+				continue
+			}
+			order = append(order, id)
+			byID[id] = &entry{
+				food: model.Food{
+					Source:     model.FoodSourceUSDA,
+					ExternalID: id,
+					Name:       truncate(name),
+				},
+				energy: map[int]float64{},
+			}
+		}
+
+		rows, err = newReader(nutrients, ',')
+		if err != nil {
+			return fmt.Errorf("food_nutrient.csv: %w", err)
+		}
+		for {
+			row, err := rows.next()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("food_nutrient.csv: %w", err)
+			}
+			e, ok := byID[row.get("fdc_id")]
+			if !ok {
+				continue
+			}
+			nutrientID, err := strconv.Atoi(row.get("nutrient_id"))
+			if err != nil {
+				continue
+			}
+			amount, ok := row.float("amount")
+			if !ok {
+				continue
+			}
+			switch nutrientID {
+			case nutrientProtein:
+				e.food.ProteinPer100g = amount
+			case nutrientFat:
+				e.food.FatPer100g = amount
+			case nutrientCarbs:
+				e.food.CarbsPer100g = amount
+			case nutrientEnergy, nutrientEnergyGeneral, nutrientEnergySpecific:
+				e.energy[nutrientID] = amount
+			}
+		}
+
+		for _, id := range order {
+			e := byID[id]
+			kcal, ok := firstOf(e.energy, nutrientEnergy, nutrientEnergySpecific, nutrientEnergyGeneral)
+			if !ok {
+				continue
+			}
+			e.food.CaloriesPer100g = kcal
+			if err := emit(e.food); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+type csvRows struct {
+	r       *csv.Reader
+	columns map[string]int
+}
+
+type csvRow struct {
+	fields  []string
+	columns map[string]int
+}
+
+func newReader(r io.Reader, comma rune) (*csvRows, error) {
+	cr := csv.NewReader(r)
+	cr.Comma = comma
+	cr.LazyQuotes = true
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[strings.TrimPrefix(strings.TrimSpace(h), "\ufeff")] = i
+	}
+	return &csvRows{r: cr, columns: columns}, nil
+}
+
+func (c *csvRows) next() (csvRow, error) {
+	fields, err := c.r.Read()
+	if err != nil {
+		return csvRow{}, err
+	}
+	return csvRow{fields: fields, columns: c.columns}, nil
+}
+
+func (r csvRow) get(column string) string {
+	i, ok := r.columns[column]
+	if !ok || i >= len(r.fields) {
+		return ""
+	}
+	return strings.TrimSpace(r.fields[i])
+}
+
+func (r csvRow) float(column string) (float64, bool) {
+	v := r.get(column)
+	if v == "" {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < 0 {
+		return 0, false
+	}
+	return f, true
+}
+
+func firstOf(m map[int]float64, keys ...int) (float64, bool) {
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+func firstBrand(brands string) string {
+	b, _, _ := strings.Cut(brands, ",")
+	return strings.TrimSpace(b)
+}
+
+// truncate keeps values within the varchar(255) columns.
+func truncate(s string) string {
+	r := []rune(s)
+	if len(r) > 255 {
+		return string(r[:255])
+	}
+	return s
+}
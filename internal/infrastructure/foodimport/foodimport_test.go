@@ -0,0 +1,98 @@
+package foodimport_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/foodimport"
+)
+
+type fakeFoods struct {
+	repository.FoodsRepository
+	batches [][]model.Food
+}
+
+func (f *fakeFoods) UpsertFoods(_ context.Context, foods []model.Food) error {
+	f.batches = append(f.batches, append([]model.Food(nil), foods...))
+	return nil
+}
+
+func TestOpenFoodFacts(t *testing.T) {
+	dump := "code\tproduct_name\tbrands\tserving_quantity\tenergy-kcal_100g\tenergy_100g\tproteins_100g\tfat_100g\tcarbohydrates_100g\n" +
+		"3017620422003\tNutella\tFerrero,Nutella\t15\t539\t2252\t6.3\t30.9\t57.5\n" +
+		"5449000000997\tCoca-Cola\tCoca-Cola\t\t\t180\t0\t0\t10.6\n" +
+		"0000000000001\t\tNo name\t\t100\t\t1\t1\t1\n" +
+		"0000000000002\tNo energy\t\t\t\t\t1\t1\t1\n"
+
+	repo := &fakeFoods{}
+	n, err := foodimport.Load(context.Background(), repo, 10, foodimport.OpenFoodFacts(strings.NewReader(dump)))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if n != 2 || len(repo.batches) != 1 {
+		t.Fatalf("imported %d foods in %d batches, want 2 in 1", n, len(repo.batches))
+	}
+
+	nutella := repo.batches[0][0]
+	want := model.Food{
+		Source:          model.FoodSourceOpenFoodFacts,
+		ExternalID:      "3017620422003",
+		Name:            "Nutella",
+		Brand:           "Ferrero",
+		Barcode:         "3017620422003",
+		ServingGrams:    15,
+		CaloriesPer100g: 539,
+		ProteinPer100g:  6.3,
+		FatPer100g:      30.9,
+		CarbsPer100g:    57.5,
+	}
+	if nutella != want {
+		t.Errorf("food = %+v, want %+v", nutella, want)
+	}
+	cola := repo.batches[0][1]
+	if cola.CaloriesPer100g < 43 || cola.CaloriesPer100g > 43.1 {
+		t.Errorf("kJ fallback = %v kcal, want ~43.02", cola.CaloriesPer100g)
+	}
+	if cola.ExternalID != "5449000000997" || cola.Barcode != "" {
+		t.Errorf("bad checksum: externalId = %q, barcode = %q; want code kept, barcode empty", cola.ExternalID, cola.Barcode)
+	}
+}
+
+func TestUSDA(t *testing.T) {
+	foods := `"fdc_id","data_type","description","food_category_id","publication_date"
+"1001","sr_legacy_food","Oats, raw","20","2019-04-01"
+"1002","foundation_food","Apples, fuji, with skin, raw","9","2020-10-30"
+"1003","sr_legacy_food","Salt, table","2","2019-04-01"
+`
+	nutrients := `"id","fdc_id","nutrient_id","amount"
+"1","1001","1008","379"
+"2","1001","1003","13.2"
+"3","1001","1004","6.52"
+"4","1001","1005","67.7"
+"5","1002","2047","64.7"
+"6","1002","1003","0.15"
+"7","1003","1003","0"
+"8","9999","1008","1"
+`
+	repo := &fakeFoods{}
+	n, err := foodimport.Load(context.Background(), repo, 1,
+		foodimport.USDA(strings.NewReader(foods), strings.NewReader(nutrients)))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if n != 2 || len(repo.batches) != 2 {
+		t.Fatalf("imported %d foods in %d batches, want 2 in 2", n, len(repo.batches))
+	}
+
+	oats := repo.batches[0][0]
+	if oats.ExternalID != "1001" || oats.CaloriesPer100g != 379 || oats.ProteinPer100g != 13.2 ||
+		oats.FatPer100g != 6.52 || oats.CarbsPer100g != 67.7 {
+		t.Errorf("oats = %+v", oats)
+	}
+	if apple := repo.batches[1][0]; apple.ExternalID != "1002" || apple.CaloriesPer100g != 64.7 {
+		t.Errorf("apple = %+v, want Atwater energy 64.7", apple)
+	}
+}
@@ -0,0 +1,31 @@
+package providers
+
+import (
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	googlecalendar "google.golang.org/api/calendar/v3"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// GoogleRevokeURL is Google's OAuth 2.0 token revocation endpoint.
+const GoogleRevokeURL = "https://oauth2.googleapis.com/revoke"
+
+// NewGoogle connects Google Calendar. Consent is forced so Google returns a
+// refresh token on every connect, not just the first one.
+func NewGoogle(cfg Config) IntegrationProvider {
+	return &oauthProvider{
+		name: model.ProviderGoogle,
+		cfg: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       []string{googlecalendar.CalendarScope},
+			Endpoint:     endpoint(google.Endpoint, cfg),
+		},
+		authParams:  []oauth2.AuthCodeOption{oauth2.AccessTypeOffline, oauth2.ApprovalForce},
+		caps:        []Capability{CapabilityCalendar},
+		revokeURL:   orDefault(cfg.RevokeURL, GoogleRevokeURL),
+		revokeParam: "token",
+	}
+}
@@ -0,0 +1,124 @@
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/oauth2"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// oauthProvider implements IntegrationProvider for a standard OAuth 2.0
+// authorization-code flow; providers differ only in their settings.
+type oauthProvider struct {
+	name       model.Provider
+	cfg        *oauth2.Config
+	authParams []oauth2.AuthCodeOption
+	caps       []Capability
+
+	revokeURL   string
+	revokeParam string
+	// revokeAccess revokes with a current access token instead of the refresh
+	// token.
+	revokeAccess bool
+}
+
+func (p *oauthProvider) Name() model.Provider {
+	return p.name
+}
+
+func (p *oauthProvider) Scope() string {
+	return strings.Join(p.cfg.Scopes, " ")
+}
+
+func (p *oauthProvider) Capabilities() []Capability {
+	return p.caps
+}
+
+func (p *oauthProvider) AuthURL(state string) string {
+	return p.cfg.AuthCodeURL(state, p.authParams...)
+}
+
+func (p *oauthProvider) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
+	return p.cfg.Exchange(ctx, code)
+}
+
+func (p *oauthProvider) TokenSource(ctx context.Context, tok *oauth2.Token) oauth2.TokenSource {
+	return p.cfg.TokenSource(ctx, tok)
+}
+
+func (p *oauthProvider) Revoke(ctx context.Context, stored *oauth2.Token, ts oauth2.TokenSource) error {
+	token, err := p.revokeToken(stored, ts)
+	if IsReconnectRequired(err) {
+		// The provider has already dropped the grant.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	form := url.Values{p.revokeParam: {token}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.revokeURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	var body struct {
+		Error string `json:"error"`
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&body)
+	if resp.StatusCode == http.StatusBadRequest && body.Error == "invalid_token" {
+		// RFC 7009 answer for a token that is already invalid.
+		return nil
+	}
+	return fmt.Errorf("%s: revoke token: status %d %s", p.name, resp.StatusCode, body.Error)
+}
+
+// revokeToken picks the token sent to the revoke endpoint: the refresh token,
+// which ends the whole grant, or for providers that only accept access tokens
+// a current one from ts. Older rows may only have an access token.
+func (p *oauthProvider) revokeToken(stored *oauth2.Token, ts oauth2.TokenSource) (string, error) {
+	if !p.revokeAccess {
+		if stored.RefreshToken != "" {
+			return stored.RefreshToken, nil
+		}
+		return stored.AccessToken, nil
+	}
+	tok, err := ts.Token()
+	if err != nil {
+		return "", err
+	}
+	return tok.AccessToken, nil
+}
+
+func endpoint(def oauth2.Endpoint, cfg Config) oauth2.Endpoint {
+	if cfg.AuthURL != "" {
+		def.AuthURL = cfg.AuthURL
+	}
+	if cfg.TokenURL != "" {
+		def.TokenURL = cfg.TokenURL
+	}
+	return def
+}
+
+func orDefault(v, def string) string {
+	if v != "" {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,109 @@
+// Package providers describes the OAuth services users can connect and keeps
+// their tokens fresh. Each provider is configured once in bootstrap and
+// looked up by name, so the connect, callback and disconnect flows don't need
+// provider-specific code.
+package providers
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"golang.org/x/oauth2"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+// Capability names a feature a connected provider unlocks.
+type Capability string
+
+const (
+	// CapabilityCalendar lets sessions and workouts be written to the user's
+	// calendar.
+	CapabilityCalendar Capability = "calendar"
+	// CapabilityActivities lets recorded activities be imported as cardio
+	// sessions.
+	CapabilityActivities Capability = "activities"
+)
+
+type IntegrationProvider interface {
+	Name() model.Provider
+	Scope() string
+	Capabilities() []Capability
+	AuthURL(state string) string
+	Exchange(ctx context.Context, code string) (*oauth2.Token, error)
+	// TokenSource refreshes tok when it expires.
+	TokenSource(ctx context.Context, tok *oauth2.Token) oauth2.TokenSource
+	// Revoke invalidates the grant behind the stored token at the provider,
+	// using ts where the provider needs a current access token. A grant the
+	// provider no longer recognises counts as revoked.
+	Revoke(ctx context.Context, stored *oauth2.Token, ts oauth2.TokenSource) error
+}
+
+// Config holds the OAuth client of a provider. The URL fields override the
+// provider's production endpoints, e.g. to point at a fake server in tests.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+
+	AuthURL   string
+	TokenURL  string
+	RevokeURL string
+}
+
+type Registry struct {
+	providers map[model.Provider]IntegrationProvider
+}
+
+func NewRegistry(ps ...IntegrationProvider) *Registry {
+	r := &Registry{providers: make(map[model.Provider]IntegrationProvider, len(ps))}
+	for _, p := range ps {
+		r.Register(p)
+	}
+	return r
+}
+
+func (r *Registry) Register(p IntegrationProvider) {
+	r.providers[p.Name()] = p
+}
+
+func (r *Registry) Get(name model.Provider) (IntegrationProvider, error) {
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, &utilsErrors.Error{Message: "Integration provider not found", Status: http.StatusNotFound}
+	}
+	return p, nil
+}
+
+// List returns the registered providers ordered by name.
+func (r *Registry) List() []IntegrationProvider {
+	out := make([]IntegrationProvider, 0, len(r.providers))
+	for _, p := range r.providers {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name() < out[j].Name() })
+	return out
+}
+
+// TokenSource returns a persisting token source (see NewTokenSource) using
+// the integration's provider.
+func (r *Registry) TokenSource(ctx context.Context, repo repository.IntegrationsRepository, integ *model.UserIntegration) (oauth2.TokenSource, error) {
+	p, err := r.Get(integ.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return NewTokenSource(ctx, p, repo, integ), nil
+}
+
+// Has reports whether p offers capability c.
+func Has(p IntegrationProvider, c Capability) bool {
+	for _, pc := range p.Capabilities() {
+		if pc == c {
+			return true
+		}
+	}
+	return false
+}
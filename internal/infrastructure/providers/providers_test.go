@@ -0,0 +1,239 @@
+package providers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/providers"
+)
+
+type fakeIntegrationsRepo struct {
+	repository.IntegrationsRepository
+
+	saved  []model.UserIntegration
+	status model.IntegrationStatus
+}
+
+func (f *fakeIntegrationsRepo) Upsert(_ context.Context, row model.UserIntegration) (*model.UserIntegration, error) {
+	f.saved = append(f.saved, row)
+	return &row, nil
+}
+
+func (f *fakeIntegrationsRepo) UpdateStatus(_ context.Context, _ int, _ model.Provider, status model.IntegrationStatus, _ string) error {
+	f.status = status
+	return nil
+}
+
+func tokenServer(t *testing.T, status int, body string) providers.IntegrationProvider {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return providers.NewGoogle(providers.Config{ClientID: "id", ClientSecret: "secret", TokenURL: srv.URL})
+}
+
+func expired() *model.UserIntegration {
+	return &model.UserIntegration{
+		UserID:       7,
+		Provider:     model.ProviderGoogle,
+		AccessToken:  "old",
+		RefreshToken: "refresh",
+		ExpiryUnix:   time.Now().Add(-time.Hour).Unix(),
+		Status:       model.IntegrationActive,
+	}
+}
+
+func TestTokenSource(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		integ      func() *model.UserIntegration
+		wantErr    bool
+		wantSaved  int
+		wantStatus model.IntegrationStatus
+	}{
+		{
+			name:      "persists refreshed token",
+			status:    http.StatusOK,
+			body:      `{"access_token":"new","token_type":"Bearer","expires_in":3600}`,
+			integ:     expired,
+			wantSaved: 1,
+		},
+		{
+			name: "valid token is not refreshed",
+			body: `{}`,
+			integ: func() *model.UserIntegration {
+				i := expired()
+				i.ExpiryUnix = time.Now().Add(time.Hour).Unix()
+				return i
+			},
+		},
+		{
+			name:       "invalid_grant marks needs reconnect",
+			status:     http.StatusBadRequest,
+			body:       `{"error":"invalid_grant","error_description":"Token has been expired or revoked."}`,
+			integ:      expired,
+			wantErr:    true,
+			wantStatus: model.IntegrationNeedsReconnect,
+		},
+		{
+			name: "needs reconnect fails fast",
+			body: `{}`,
+			integ: func() *model.UserIntegration {
+				i := expired()
+				i.Status = model.IntegrationNeedsReconnect
+				return i
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeIntegrationsRepo{}
+			p := tokenServer(t, tt.status, tt.body)
+			ts := providers.NewTokenSource(context.Background(), p, repo, tt.integ())
+
+			tok, err := ts.Token()
+			if tt.wantErr {
+				if !providers.IsReconnectRequired(err) {
+					t.Fatalf("expected reconnect error, got %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(repo.saved) != tt.wantSaved {
+				t.Fatalf("expected %d saved tokens, got %d", tt.wantSaved, len(repo.saved))
+			}
+			if tt.wantSaved > 0 {
+				got := repo.saved[0]
+				if got.AccessToken != tok.AccessToken || got.RefreshToken != "refresh" {
+					t.Errorf("unexpected saved token: %+v", got)
+				}
+			}
+			if repo.status != tt.wantStatus {
+				t.Errorf("expected status %q, got %q", tt.wantStatus, repo.status)
+			}
+		})
+	}
+}
+
+func withoutRefresh() *model.UserIntegration {
+	i := expired()
+	i.RefreshToken = ""
+	return i
+}
+
+func TestRevoke(t *testing.T) {
+	const refreshed = `{"access_token":"fresh","refresh_token":"refresh","token_type":"Bearer","expires_in":21600}`
+	tests := []struct {
+		name        string
+		provider    func(providers.Config) providers.IntegrationProvider
+		integ       func() *model.UserIntegration
+		tokenStatus int
+		tokenBody   string
+		param       string
+		status      int
+		body        string
+		wantToken   string
+		wantErr     bool
+	}{
+		{name: "google", provider: providers.NewGoogle, integ: expired, param: "token", status: http.StatusOK, body: `{}`, wantToken: "refresh"},
+		{name: "google without refresh token", provider: providers.NewGoogle, integ: withoutRefresh, param: "token", status: http.StatusOK, body: `{}`, wantToken: "old"},
+		{name: "google already revoked", provider: providers.NewGoogle, integ: expired, param: "token", status: http.StatusBadRequest, body: `{"error":"invalid_token"}`, wantToken: "refresh"},
+		{name: "google outage", provider: providers.NewGoogle, integ: expired, param: "token", status: http.StatusServiceUnavailable, body: `{}`, wantToken: "refresh", wantErr: true},
+		{
+			name:        "strava refreshes the access token",
+			provider:    providers.NewStrava,
+			integ:       expired,
+			tokenStatus: http.StatusOK,
+			tokenBody:   refreshed,
+			param:       "access_token",
+			status:      http.StatusOK,
+			body:        `{"access_token":"fresh"}`,
+			wantToken:   "fresh",
+		},
+		{
+			name:        "strava rejects the token",
+			provider:    providers.NewStrava,
+			integ:       expired,
+			tokenStatus: http.StatusOK,
+			tokenBody:   refreshed,
+			param:       "access_token",
+			status:      http.StatusUnauthorized,
+			body:        `{"message":"Authorization Error"}`,
+			wantToken:   "fresh",
+			wantErr:     true,
+		},
+		{
+			name:        "strava grant already revoked",
+			provider:    providers.NewStrava,
+			integ:       expired,
+			tokenStatus: http.StatusBadRequest,
+			tokenBody:   `{"error":"invalid_grant"}`,
+			param:       "access_token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path == "/token" {
+					w.WriteHeader(tt.tokenStatus)
+					_, _ = w.Write([]byte(tt.tokenBody))
+					return
+				}
+				got = r.FormValue(tt.param)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			p := tt.provider(providers.Config{ClientID: "id", ClientSecret: "secret", TokenURL: srv.URL + "/token", RevokeURL: srv.URL + "/revoke"})
+			integ := tt.integ()
+			stored := &oauth2.Token{AccessToken: integ.AccessToken, RefreshToken: integ.RefreshToken}
+			ts := providers.NewTokenSource(context.Background(), p, &fakeIntegrationsRepo{}, integ)
+
+			err := p.Revoke(context.Background(), stored, ts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.wantToken {
+				t.Errorf("posted token = %q, want %q", got, tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	r := providers.NewRegistry(providers.NewStrava(providers.Config{}), providers.NewGoogle(providers.Config{}))
+
+	p, err := r.Get(model.ProviderStrava)
+	if err != nil || !providers.Has(p, providers.CapabilityActivities) || providers.Has(p, providers.CapabilityCalendar) {
+		t.Fatalf("unexpected strava provider: %v, %v", p, err)
+	}
+	if _, err = r.Get("dropbox"); err == nil {
+		t.Error("expected unknown provider error")
+	}
+	if list := r.List(); len(list) != 2 || list[0].Name() != model.ProviderGoogle {
+		t.Errorf("unexpected provider list: %v", list)
+	}
+
+	u, err := url.Parse(p.AuthURL("state"))
+	if err != nil || u.Query().Get("state") != "state" || u.Query().Get("scope") != "activity:read_all" {
+		t.Errorf("unexpected auth URL: %s", u)
+	}
+}
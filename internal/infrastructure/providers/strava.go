@@ -0,0 +1,33 @@
+package providers
+
+import (
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/endpoints"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// StravaRevokeURL is Strava's deauthorization endpoint; it revokes every
+// token issued to the app for the athlete. It only accepts a valid access
+// token.
+const StravaRevokeURL = "https://www.strava.com/oauth/deauthorize"
+
+// NewStrava connects Strava with read access to all activities, including
+// private ones.
+func NewStrava(cfg Config) IntegrationProvider {
+	return &oauthProvider{
+		name: model.ProviderStrava,
+		cfg: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       []string{"activity:read_all"},
+			Endpoint:     endpoint(endpoints.Strava, cfg),
+		},
+		authParams:   []oauth2.AuthCodeOption{oauth2.SetAuthURLParam("approval_prompt", "force")},
+		caps:         []Capability{CapabilityActivities},
+		revokeURL:    orDefault(cfg.RevokeURL, StravaRevokeURL),
+		revokeParam:  "access_token",
+		revokeAccess: true,
+	}
+}
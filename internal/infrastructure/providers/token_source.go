@@ -0,0 +1,94 @@
+package providers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+// ErrReconnectRequired is returned once the provider has rejected the stored
+// refresh token.
+var ErrReconnectRequired = &utilsErrors.Error{
+	Code:    "integration_reconnect_required",
+	Message: "Integration needs to be reconnected",
+	Status:  http.StatusConflict,
+}
+
+type tokenSource struct {
+	ctx   context.Context
+	base  oauth2.TokenSource
+	repo  repository.IntegrationsRepository
+	integ model.UserIntegration
+
+	mu   sync.Mutex
+	last string
+}
+
+// NewTokenSource returns a token source for the integration that writes
+// refreshed tokens back through the repository, so the next request reuses
+// them, and marks the integration as needing reconnect on invalid_grant.
+func NewTokenSource(ctx context.Context, p IntegrationProvider, repo repository.IntegrationsRepository, integ *model.UserIntegration) oauth2.TokenSource {
+	tok := &oauth2.Token{
+		AccessToken:  integ.AccessToken,
+		RefreshToken: integ.RefreshToken,
+		Expiry:       time.Unix(integ.ExpiryUnix, 0),
+	}
+	return &tokenSource{
+		ctx:   ctx,
+		base:  oauth2.ReuseTokenSource(tok, p.TokenSource(ctx, tok)),
+		repo:  repo,
+		integ: *integ,
+		last:  integ.AccessToken,
+	}
+}
+
+func (s *tokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.integ.Status == model.IntegrationNeedsReconnect {
+		return nil, ErrReconnectRequired
+	}
+
+	tok, err := s.base.Token()
+	if err != nil {
+		var re *oauth2.RetrieveError
+		if errors.As(err, &re) && re.ErrorCode == "invalid_grant" {
+			s.integ.Status = model.IntegrationNeedsReconnect
+			if uerr := s.repo.UpdateStatus(s.ctx, s.integ.UserID, s.integ.Provider, model.IntegrationNeedsReconnect, re.Error()); uerr != nil {
+				return nil, uerr
+			}
+			return nil, ErrReconnectRequired
+		}
+		return nil, err
+	}
+
+	if tok.AccessToken != s.last {
+		row := s.integ
+		row.AccessToken = tok.AccessToken
+		if tok.RefreshToken != "" {
+			row.RefreshToken = tok.RefreshToken
+		}
+		row.ExpiryUnix = tok.Expiry.Unix()
+		if _, err = s.repo.Upsert(s.ctx, row); err != nil {
+			return nil, err
+		}
+		s.integ = row
+		s.last = tok.AccessToken
+	}
+	return tok, nil
+}
+
+// IsReconnectRequired reports whether err means the user has to reconnect.
+func IsReconnectRequired(err error) bool {
+	var se *utilsErrors.Error
+	return errors.As(err, &se) && se.Code == ErrReconnectRequired.Code
+}
@@ -0,0 +1,201 @@
+package recordnotify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/email"
+	"github.com/msskobelina/fit-profi/pkg/analytics"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+	"github.com/msskobelina/fit-profi/pkg/logger"
+)
+
+// Notifier emits a "Personal Record" analytics event for every record an
+// athlete sets and emails the athlete's active coach about them. Notify only
+// enqueues; Run delivers, so logging a workout never waits on or fails
+// because of mail delivery. When ctx ends, Run delivers what is still queued
+// before returning.
+type Notifier interface {
+	Notify(userID int, records []model.PersonalRecord)
+	Run(ctx context.Context)
+}
+
+const drainTimeout = 10 * time.Second
+
+type event struct {
+	userID  int
+	records []model.PersonalRecord
+}
+
+type notifier struct {
+	users     repository.UsersRepository
+	coaching  repository.CoachingRepository
+	programs  repository.ProgramsRepository
+	email     email.Sender
+	analytics analytics.Client
+	log       logger.Interface
+	events    chan event
+}
+
+func NewNotifier(
+	users repository.UsersRepository,
+	coaching repository.CoachingRepository,
+	programs repository.ProgramsRepository,
+	sender email.Sender,
+	a analytics.Client,
+	l logger.Interface,
+) Notifier {
+	return &notifier{
+		users:     users,
+		coaching:  coaching,
+		programs:  programs,
+		email:     sender,
+		analytics: a,
+		log:       l,
+		events:    make(chan event, 256),
+	}
+}
+
+func (n *notifier) Notify(userID int, records []model.PersonalRecord) {
+	if len(records) == 0 {
+		return
+	}
+	select {
+	case n.events <- event{userID: userID, records: records}:
+	default:
+		n.log.Error("record notification queue is full, dropping records", "userId", userID, "count", len(records))
+	}
+}
+
+func (n *notifier) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			n.drain(ctx)
+			return
+		case ev := <-n.events:
+			if err := n.deliver(ctx, ev); err != nil {
+				n.log.Error("record notification failed", "userId", ev.userID, "err", err)
+			}
+		}
+	}
+}
+
+// drain delivers the events queued at shutdown, giving up after drainTimeout so
+// a slow mail server cannot hold the process open.
+func (n *notifier) drain(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), drainTimeout)
+	defer cancel()
+	for {
+		select {
+		case ev := <-n.events:
+			if err := n.deliver(ctx, ev); err != nil {
+				n.log.Error("record notification failed", "userId", ev.userID, "err", err)
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (n *notifier) deliver(ctx context.Context, ev event) error {
+	names := n.exerciseNames(ctx, ev.records)
+	for _, r := range ev.records {
+		err := n.analytics.Track(ctx, "Personal Record", strconv.Itoa(ev.userID), map[string]any{
+			"user_id":        ev.userID,
+			"exercise_id":    r.ExerciseID,
+			"exercise":       names[r.ExerciseID],
+			"kind":           string(r.Kind),
+			"value":          r.Value,
+			"previous_value": r.PreviousValue,
+		})
+		if err != nil {
+			n.log.Warn("record analytics failed", "userId", ev.userID, "err", err)
+		}
+	}
+
+	rel, err := n.coaching.GetActiveByClient(ctx, ev.userID)
+	if err != nil {
+		var ue *utilsErrors.Error
+		if errors.As(err, &ue) {
+			// No active coach to tell.
+			return nil
+		}
+		return err
+	}
+	coach, err := n.users.GetUserByID(ctx, rel.CoachID)
+	if err != nil {
+		return err
+	}
+	client, err := n.users.GetUserByID(ctx, ev.userID)
+	if err != nil {
+		return err
+	}
+	who := client.FullName
+	if who == "" {
+		who = client.Email
+	}
+	// the name ends up in a mail header; a line break would start a new one
+	who = strings.Join(strings.Fields(who), " ")
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "<h2>FitProfi: new personal records</h2><p>%s just set:</p><ul>", html.EscapeString(who))
+	for _, r := range ev.records {
+		fmt.Fprintf(&b, "<li><b>%s</b>: %s</li>", html.EscapeString(names[r.ExerciseID]), describe(r))
+	}
+	b.WriteString("</ul>")
+	return n.email.Send(ctx, email.SendInput{
+		To:          coach.Email,
+		Subject:     "FitProfi: " + who + " set a personal record",
+		ContentType: "text/html",
+		Body:        b.String(),
+	})
+}
+
+// exerciseNames looks up the program exercises of the records. Exercises that
+// cannot be found keep a generic name rather than holding up the notification.
+func (n *notifier) exerciseNames(ctx context.Context, records []model.PersonalRecord) map[int]string {
+	names := map[int]string{}
+	for _, r := range records {
+		if _, ok := names[r.ExerciseID]; ok {
+			continue
+		}
+		names[r.ExerciseID] = "Exercise #" + strconv.Itoa(r.ExerciseID)
+		p, err := n.programs.GetProgramByExerciseID(ctx, r.ExerciseID)
+		if err == nil {
+			p, err = n.programs.GetProgramByID(ctx, p.ID)
+		}
+		if err != nil {
+			n.log.Warn("record exercise lookup failed", "exerciseId", r.ExerciseID, "err", err)
+			continue
+		}
+		for _, d := range p.Days {
+			for _, e := range d.Exercises {
+				if e.ID == r.ExerciseID {
+					names[e.ID] = e.Name
+				}
+			}
+		}
+	}
+	return names
+}
+
+func describe(r model.PersonalRecord) string {
+	switch r.Kind {
+	case model.RecordHeaviestWeight:
+		return fmt.Sprintf("heaviest weight %g kg (was %g kg)", r.Value, r.PreviousValue)
+	case model.RecordMostReps:
+		return fmt.Sprintf("%g reps at %g kg (was %g)", r.Value, r.WeightKg, r.PreviousValue)
+	case model.RecordBestOneRM:
+		return fmt.Sprintf("estimated 1RM %g kg from %d × %g kg (was %g kg)", r.Value, r.Reps, r.WeightKg, r.PreviousValue)
+	default:
+		return fmt.Sprintf("session volume %g kg (was %g kg)", r.Value, r.PreviousValue)
+	}
+}
@@ -0,0 +1,185 @@
+package recordnotify_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/email"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/recordnotify"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+	"github.com/msskobelina/fit-profi/pkg/logger"
+)
+
+type fakeUsersRepo struct {
+	repository.UsersRepository
+	users map[int]*model.User
+}
+
+func (f *fakeUsersRepo) GetUserByID(_ context.Context, id int) (*model.User, error) {
+	return f.users[id], nil
+}
+
+type fakeCoachingRepo struct {
+	repository.CoachingRepository
+	coachOf map[int]int
+}
+
+func (f *fakeCoachingRepo) GetActiveByClient(_ context.Context, clientID int) (*model.CoachingRelationship, error) {
+	coachID, ok := f.coachOf[clientID]
+	if !ok {
+		return nil, &utilsErrors.Error{Message: "No active coach"}
+	}
+	return &model.CoachingRelationship{CoachID: coachID, ClientID: clientID, Status: model.CoachingActive}, nil
+}
+
+type fakeProgramsRepo struct {
+	repository.ProgramsRepository
+}
+
+func (f *fakeProgramsRepo) GetProgramByExerciseID(_ context.Context, _ int) (*model.TrainingProgram, error) {
+	return &model.TrainingProgram{ID: 1}, nil
+}
+
+func (f *fakeProgramsRepo) GetProgramByID(_ context.Context, id int) (*model.TrainingProgram, error) {
+	return &model.TrainingProgram{ID: id, Days: []model.ProgramDay{
+		{ID: 1, Exercises: []model.ProgramExercise{{ID: 5, Name: "Back squat"}}},
+	}}, nil
+}
+
+type fakeSender struct {
+	mu   sync.Mutex
+	sent []email.SendInput
+}
+
+func (f *fakeSender) Send(_ context.Context, inp email.SendInput) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sent = append(f.sent, inp)
+	return nil
+}
+
+func (f *fakeSender) mails() []email.SendInput {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]email.SendInput(nil), f.sent...)
+}
+
+type fakeAnalytics struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (f *fakeAnalytics) Track(_ context.Context, event, distinctID string, _ map[string]any) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(f.events, event+" "+distinctID)
+	return nil
+}
+
+func (f *fakeAnalytics) tracked() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.events...)
+}
+
+func newNotifier(t *testing.T, coachOf map[int]int, sender *fakeSender, a *fakeAnalytics) recordnotify.Notifier {
+	t.Helper()
+	users := &fakeUsersRepo{users: map[int]*model.User{
+		10: {ID: 10, Email: "coach@example.com"},
+		20: {ID: 20, FullName: "Anna", Email: "anna@example.com"},
+	}}
+	n := recordnotify.NewNotifier(users, &fakeCoachingRepo{coachOf: coachOf}, &fakeProgramsRepo{}, sender, a, logger.New("error"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go n.Run(ctx)
+	return n
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met before deadline")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+var records = []model.PersonalRecord{
+	{UserID: 20, ExerciseID: 5, Kind: model.RecordHeaviestWeight, Value: 120, PreviousValue: 110, Reps: 1, WeightKg: 120},
+	{UserID: 20, ExerciseID: 5, Kind: model.RecordSessionVolume, Value: 1800, PreviousValue: 1330},
+}
+
+func TestNotify_EmailsActiveCoach(t *testing.T) {
+	sender, a := &fakeSender{}, &fakeAnalytics{}
+
+	newNotifier(t, map[int]int{20: 10}, sender, a).Notify(20, records)
+	waitFor(t, func() bool { return len(sender.mails()) == 1 })
+
+	mail := sender.mails()[0]
+	if mail.To != "coach@example.com" || !strings.Contains(mail.Subject, "Anna") {
+		t.Errorf("mail = %+v, want one to the coach about Anna", mail)
+	}
+	if !strings.Contains(mail.Body, "Back squat") || !strings.Contains(mail.Body, "heaviest weight 120 kg (was 110 kg)") {
+		t.Errorf("body = %s", mail.Body)
+	}
+	if got := a.tracked(); len(got) != 2 || got[0] != "Personal Record 20" {
+		t.Errorf("events = %v, want one per record", got)
+	}
+}
+
+func TestNotify_WithoutCoachOnlyTracks(t *testing.T) {
+	sender, a := &fakeSender{}, &fakeAnalytics{}
+
+	newNotifier(t, map[int]int{}, sender, a).Notify(20, records)
+	waitFor(t, func() bool { return len(a.tracked()) == 2 })
+
+	time.Sleep(20 * time.Millisecond)
+	if got := sender.mails(); len(got) != 0 {
+		t.Errorf("mails = %+v, want none", got)
+	}
+}
+
+func TestNotify_KeepsSubjectOnOneLine(t *testing.T) {
+	sender := &fakeSender{}
+	users := &fakeUsersRepo{users: map[int]*model.User{
+		10: {ID: 10, Email: "coach@example.com"},
+		20: {ID: 20, FullName: "Anna\r\nBcc: someone@example.com", Email: "anna@example.com"},
+	}}
+	n := recordnotify.NewNotifier(users, &fakeCoachingRepo{coachOf: map[int]int{20: 10}}, &fakeProgramsRepo{}, sender, &fakeAnalytics{}, logger.New("error"))
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go n.Run(ctx)
+
+	n.Notify(20, records)
+	waitFor(t, func() bool { return len(sender.mails()) == 1 })
+
+	if subject := sender.mails()[0].Subject; strings.ContainsAny(subject, "\r\n") {
+		t.Errorf("subject = %q, want no line breaks", subject)
+	}
+}
+
+func TestRun_DeliversQueuedOnShutdown(t *testing.T) {
+	sender, a := &fakeSender{}, &fakeAnalytics{}
+	users := &fakeUsersRepo{users: map[int]*model.User{
+		10: {ID: 10, Email: "coach@example.com"},
+		20: {ID: 20, FullName: "Anna", Email: "anna@example.com"},
+	}}
+	n := recordnotify.NewNotifier(users, &fakeCoachingRepo{coachOf: map[int]int{20: 10}}, &fakeProgramsRepo{}, sender, a, logger.New("error"))
+
+	n.Notify(20, records)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	n.Run(ctx)
+
+	if got := sender.mails(); len(got) != 1 {
+		t.Errorf("mails = %+v, want the queued one delivered", got)
+	}
+}
@@ -41,3 +41,53 @@ func (r *gormRepo) IsTokenRevoked(ctx context.Context, jti string) (bool, error)
 	}
 	return n > 0, nil
 }
+
+func (r *gormRepo) GetUserByID(ctx context.Context, id int) (*model.User, error) {
+	var u model.User
+	err := r.db.WithContext(ctx).First(&u, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &utilsErrors.Error{Message: "User not found"}
+		}
+		return nil, err
+	}
+	return &u, nil
+}
+
+func (r *gormRepo) GetRefreshToken(ctx context.Context, tokenHash string) (*model.RefreshToken, error) {
+	var rt model.RefreshToken
+	err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&rt).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &utilsErrors.Error{Message: "Invalid refresh token"}
+		}
+		return nil, err
+	}
+	return &rt, nil
+}
+
+func (r *gormRepo) ListUsers(ctx context.Context, search string, role model.Role, limit, offset int) ([]model.User, int64, error) {
+	q := r.db.WithContext(ctx).Model(&model.User{})
+	if search != "" {
+		like := "%" + search + "%"
+		q = q.Where("full_name LIKE ? OR email LIKE ?", like, like)
+	}
+	if role != "" {
+		q = q.Where("role = ?", role)
+	}
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var users []model.User
+	err := q.Order("id asc").Limit(limit).Offset(offset).Find(&users).Error
+	return users, total, err
+}
+
+func (r *gormRepo) CountUsersByRole(ctx context.Context, role model.Role) (int64, error) {
+	var n int64
+	err := r.db.WithContext(ctx).Model(&model.User{}).Where("role = ?", role).Count(&n).Error
+	return n, err
+}
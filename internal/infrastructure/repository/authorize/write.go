@@ -2,6 +2,7 @@ package authorize
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -23,7 +24,7 @@ func (r *gormRepo) CreateUser(ctx context.Context, fullName, email, passwordHash
 	if u, _ := r.GetUserByEmail(ctx, email); u != nil {
 		return nil, &utilsErrors.Error{Message: "User with this email already exists"}
 	}
-	u := &model.User{FullName: fullName, Email: email, Password: passwordHash}
+	u := &model.User{FullName: fullName, Email: email, Password: passwordHash, Role: model.RoleUser}
 	if err := r.db.WithContext(ctx).Create(u).Error; err != nil {
 		return nil, err
 	}
@@ -49,3 +50,76 @@ func (r *gormRepo) ResetPassword(ctx context.Context, token, passwordHash string
 	}
 	return r.db.WithContext(ctx).Model(&model.User{}).Where("email = ?", ut.Email).Update("password", passwordHash).Error
 }
+
+func (r *gormRepo) CreateRefreshToken(ctx context.Context, rt model.RefreshToken) error {
+	return r.db.WithContext(ctx).Create(&rt).Error
+}
+
+// RotateRefreshToken revokes the token with oldID and stores next in its place.
+// It reports false when oldID had already been revoked, i.e. the token was reused.
+func (r *gormRepo) RotateRefreshToken(ctx context.Context, oldID int, next model.RefreshToken) (bool, error) {
+	rotated := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&model.RefreshToken{}).
+			Where("id = ? AND revoked_at IS NULL", oldID).
+			Update("revoked_at", time.Now().Unix())
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil
+		}
+		if err := tx.Create(&next).Error; err != nil {
+			return err
+		}
+		rotated = true
+		return nil
+	})
+	return rotated, err
+}
+
+func (r *gormRepo) RevokeRefreshTokenFamily(ctx context.Context, familyID string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now().Unix()).Error
+}
+
+func (r *gormRepo) RevokeUserRefreshTokens(ctx context.Context, userID int) error {
+	return r.db.WithContext(ctx).
+		Model(&model.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now().Unix()).Error
+}
+
+func (r *gormRepo) UpdateUserRole(ctx context.Context, id int, role model.Role) error {
+	return r.updateUser(ctx, id, "role", role)
+}
+
+func (r *gormRepo) SetUserDisabled(ctx context.Context, id int, disabled bool) error {
+	return r.updateUser(ctx, id, "disabled", disabled)
+}
+
+func (r *gormRepo) DeleteUser(ctx context.Context, id int) error {
+	res := r.db.WithContext(ctx).Delete(&model.User{}, id)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return &utilsErrors.Error{Message: "User not found"}
+	}
+	return nil
+}
+
+func (r *gormRepo) updateUser(ctx context.Context, id int, column string, value any) error {
+	res := r.db.WithContext(ctx).Model(&model.User{}).Where("id = ?", id).Update(column, value)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		if _, err := r.GetUserByID(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -2,9 +2,14 @@ package calendar
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"time"
 
+	"gorm.io/gorm"
+
 	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
 )
 
 func (r *gormRepo) ListAvailability(ctx context.Context, coachID int, from, to time.Time) ([]model.CoachAvailability, error) {
@@ -15,3 +20,102 @@ func (r *gormRepo) ListAvailability(ctx context.Context, coachID int, from, to t
 		Find(&res).Error
 	return res, err
 }
+
+// ListOpenAvailability is ListAvailability without the slots that already
+// have an active booking.
+func (r *gormRepo) ListOpenAvailability(ctx context.Context, coachID int, from, to time.Time) ([]model.CoachAvailability, error) {
+	var res []model.CoachAvailability
+	err := r.db.WithContext(ctx).
+		Where("coach_id = ? AND start_time >= ? AND end_time <= ?", coachID, from.UTC(), to.UTC()).
+		Where("NOT EXISTS (SELECT 1 FROM bookings b WHERE b.availability_id = coach_availabilities.id AND b.status = ? AND b.deleted_at IS NULL)", model.BookingBooked).
+		Order("start_time asc").
+		Find(&res).Error
+	return res, err
+}
+
+func (r *gormRepo) GetAvailabilityByID(ctx context.Context, id int) (*model.CoachAvailability, error) {
+	var a model.CoachAvailability
+	if err := r.db.WithContext(ctx).First(&a, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &utilsErrors.Error{Message: "Availability slot not found", Status: http.StatusNotFound}
+		}
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (r *gormRepo) GetBookingByID(ctx context.Context, id int) (*model.Booking, error) {
+	var b model.Booking
+	if err := r.db.WithContext(ctx).First(&b, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &utilsErrors.Error{Message: "Booking not found", Status: http.StatusNotFound}
+		}
+		return nil, err
+	}
+	return &b, nil
+}
+
+// ListUpcomingBookings returns active bookings that haven't ended yet where
+// the user is either the coach or the client.
+func (r *gormRepo) ListUpcomingBookings(ctx context.Context, userID int, from time.Time) ([]model.Booking, error) {
+	var res []model.Booking
+	err := r.db.WithContext(ctx).
+		Where("(coach_id = ? OR client_id = ?) AND status = ? AND end_time > ?", userID, userID, model.BookingBooked, from.UTC()).
+		Order("start_time asc").
+		Find(&res).Error
+	return res, err
+}
+
+// ListCoachBookings returns the coach's active bookings overlapping the window.
+func (r *gormRepo) ListCoachBookings(ctx context.Context, coachID int, from, to time.Time) ([]model.Booking, error) {
+	var res []model.Booking
+	err := r.db.WithContext(ctx).
+		Where("coach_id = ? AND status = ? AND start_time < ? AND end_time > ?", coachID, model.BookingBooked, to.UTC(), from.UTC()).
+		Order("start_time asc").
+		Find(&res).Error
+	return res, err
+}
+
+func (r *gormRepo) GetAvailabilityRuleByID(ctx context.Context, id int) (*model.AvailabilityRule, error) {
+	var rule model.AvailabilityRule
+	if err := r.db.WithContext(ctx).First(&rule, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &utilsErrors.Error{Message: "Availability rule not found", Status: http.StatusNotFound}
+		}
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *gormRepo) ListAvailabilityRules(ctx context.Context, coachID int) ([]model.AvailabilityRule, error) {
+	var res []model.AvailabilityRule
+	err := r.db.WithContext(ctx).
+		Where("coach_id = ?", coachID).
+		Order("id asc").
+		Find(&res).Error
+	return res, err
+}
+
+func (r *gormRepo) ListAvailabilityExceptions(ctx context.Context, coachID int) ([]model.AvailabilityException, error) {
+	var res []model.AvailabilityException
+	err := r.db.WithContext(ctx).
+		Where("coach_id = ?", coachID).
+		Order("date asc").
+		Find(&res).Error
+	return res, err
+}
+
+// GetEventLink returns nil when the source has no event in the user's calendar.
+func (r *gormRepo) GetEventLink(ctx context.Context, userID int, source model.CalendarSource, sourceID int) (*model.CalendarEventLink, error) {
+	var link model.CalendarEventLink
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND source_type = ? AND source_id = ?", userID, source, sourceID).
+		First(&link).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &link, nil
+}
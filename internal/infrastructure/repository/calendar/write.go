@@ -2,11 +2,16 @@ package calendar
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/msskobelina/fit-profi/internal/domain/model"
 	domainRepo "github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
 	"github.com/msskobelina/fit-profi/pkg/mysql"
 )
 
@@ -18,6 +23,214 @@ func NewRepository(sql *mysql.MySQL) domainRepo.CalendarRepository {
 	return &gormRepo{db: sql.DB}
 }
 
+// AddAvailability stores the slots unless one of them overlaps a slot the
+// coach has already published.
 func (r *gormRepo) AddAvailability(ctx context.Context, rows []model.CoachAvailability) error {
-	return r.db.WithContext(ctx).Create(&rows).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, row := range rows {
+			var n int64
+			if err := tx.Model(&model.CoachAvailability{}).
+				Where("coach_id = ? AND start_time < ? AND end_time > ?", row.CoachID, row.EndTime.UTC(), row.StartTime.UTC()).
+				Count(&n).Error; err != nil {
+				return err
+			}
+			if n > 0 {
+				return &utilsErrors.Error{Message: "Availability overlaps an existing slot", Status: http.StatusConflict}
+			}
+		}
+		return tx.Create(&rows).Error
+	})
+}
+
+// MaterializeSlot returns the stored slot for a rule occurrence, creating it
+// the first time the occurrence is booked.
+func (r *gormRepo) MaterializeSlot(ctx context.Context, slot model.CoachAvailability) (*model.CoachAvailability, error) {
+	err := r.db.WithContext(ctx).
+		Where("coach_id = ? AND rule_id = ? AND start_time = ?", slot.CoachID, slot.RuleID, slot.StartTime.UTC()).
+		FirstOrCreate(&slot).Error
+	if err != nil {
+		return nil, err
+	}
+	return &slot, nil
+}
+
+func (r *gormRepo) CreateAvailabilityRule(ctx context.Context, rule model.AvailabilityRule) (*model.AvailabilityRule, error) {
+	if err := r.db.WithContext(ctx).Create(&rule).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// DeleteAvailabilityRule removes a rule together with its exceptions and the
+// slots materialised from it that were never booked.
+func (r *gormRepo) DeleteAvailabilityRule(ctx context.Context, id, coachID int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("id = ? AND coach_id = ?", id, coachID).Delete(&model.AvailabilityRule{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return &utilsErrors.Error{Message: "Availability rule not found", Status: http.StatusNotFound}
+		}
+		if err := tx.Where("rule_id = ?", id).Delete(&model.AvailabilityException{}).Error; err != nil {
+			return err
+		}
+		return tx.
+			Where("rule_id = ?", id).
+			Where("NOT EXISTS (SELECT 1 FROM bookings b WHERE b.availability_id = coach_availabilities.id AND b.status = ? AND b.deleted_at IS NULL)", model.BookingBooked).
+			Delete(&model.CoachAvailability{}).Error
+	})
+}
+
+func (r *gormRepo) CreateAvailabilityException(ctx context.Context, ex model.AvailabilityException) (*model.AvailabilityException, error) {
+	if err := r.db.WithContext(ctx).Create(&ex).Error; err != nil {
+		return nil, err
+	}
+	return &ex, nil
+}
+
+func (r *gormRepo) DeleteAvailabilityException(ctx context.Context, id, coachID int) error {
+	res := r.db.WithContext(ctx).
+		Where("id = ? AND coach_id = ?", id, coachID).
+		Delete(&model.AvailabilityException{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return &utilsErrors.Error{Message: "Availability exception not found", Status: http.StatusNotFound}
+	}
+	return nil
+}
+
+// CreateBooking reserves a slot. Both participants' user rows are locked for
+// the duration of the transaction so concurrent bookings involving either of
+// them are serialised before the overlap checks run.
+func (r *gormRepo) CreateBooking(ctx context.Context, b model.Booking) (*model.Booking, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		slot, err := reserveSlot(tx, b.AvailabilityID, b.CoachID, b.ClientID, 0)
+		if err != nil {
+			return err
+		}
+		b.StartTime = slot.StartTime
+		b.EndTime = slot.EndTime
+		b.Status = model.BookingBooked
+		return tx.Create(&b).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// RescheduleBooking moves an active booking to another slot of the same coach
+// under the same locking and overlap rules as CreateBooking.
+func (r *gormRepo) RescheduleBooking(ctx context.Context, id, availabilityID int) (*model.Booking, error) {
+	var b model.Booking
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&b, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &utilsErrors.Error{Message: "Booking not found", Status: http.StatusNotFound}
+			}
+			return err
+		}
+		slot, err := reserveSlot(tx, availabilityID, b.CoachID, b.ClientID, b.ID)
+		if err != nil {
+			return err
+		}
+		res := tx.Model(&model.Booking{}).
+			Where("id = ? AND status = ?", b.ID, model.BookingBooked).
+			Updates(map[string]any{
+				"availability_id": slot.ID,
+				"start_time":      slot.StartTime,
+				"end_time":        slot.EndTime,
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return &utilsErrors.Error{Message: "Booking is already cancelled", Status: http.StatusConflict}
+		}
+		return tx.First(&b, b.ID).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// reserveSlot locks the participants and the slot and checks that the slot
+// belongs to the coach, is free and doesn't overlap another active session of
+// either participant. excludeID skips the booking being rescheduled.
+func reserveSlot(tx *gorm.DB, availabilityID, coachID, clientID, excludeID int) (*model.CoachAvailability, error) {
+	var users []model.User
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", []int{coachID, clientID}).
+		Order("id").
+		Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	var slot model.CoachAvailability
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&slot, availabilityID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &utilsErrors.Error{Message: "Availability slot not found", Status: http.StatusNotFound}
+		}
+		return nil, err
+	}
+	if slot.CoachID != coachID {
+		return nil, &utilsErrors.Error{Message: "Availability slot not found", Status: http.StatusNotFound}
+	}
+
+	var n int64
+	if err := tx.Model(&model.Booking{}).
+		Where("availability_id = ? AND status = ? AND id <> ?", slot.ID, model.BookingBooked, excludeID).
+		Count(&n).Error; err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		return nil, &utilsErrors.Error{Message: "Slot is already booked", Status: http.StatusConflict}
+	}
+
+	participants := []int{coachID, clientID}
+	if err := tx.Model(&model.Booking{}).
+		Where("status = ? AND (coach_id IN ? OR client_id IN ?) AND id <> ?", model.BookingBooked, participants, participants, excludeID).
+		Where("start_time < ? AND end_time > ?", slot.EndTime, slot.StartTime).
+		Count(&n).Error; err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		return nil, &utilsErrors.Error{Message: "Booking overlaps another session", Status: http.StatusConflict}
+	}
+	return &slot, nil
+}
+
+func (r *gormRepo) CancelBooking(ctx context.Context, id, cancelledBy int, at time.Time) (*model.Booking, error) {
+	res := r.db.WithContext(ctx).
+		Model(&model.Booking{}).
+		Where("id = ? AND status = ?", id, model.BookingBooked).
+		Updates(map[string]any{
+			"status":       model.BookingCancelled,
+			"cancelled_at": at,
+			"cancelled_by": cancelledBy,
+		})
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, &utilsErrors.Error{Message: "Booking is already cancelled", Status: http.StatusConflict}
+	}
+	return r.GetBookingByID(ctx, id)
+}
+
+// SaveEventLink creates or updates the link for the user and source.
+func (r *gormRepo) SaveEventLink(ctx context.Context, link model.CalendarEventLink) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND source_type = ? AND source_id = ?", link.UserID, link.SourceType, link.SourceID).
+		Assign(model.CalendarEventLink{CalendarID: link.CalendarID, EventID: link.EventID}).
+		FirstOrCreate(&link).Error
+}
+
+func (r *gormRepo) DeleteEventLink(ctx context.Context, id int) error {
+	// hard delete: a soft-deleted row would still hold the unique source key
+	return r.db.WithContext(ctx).Unscoped().Delete(&model.CalendarEventLink{}, id).Error
 }
@@ -0,0 +1,17 @@
+package cardio
+
+import (
+	"context"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+func (r *gormRepo) ListSessions(ctx context.Context, userID int, from, to time.Time) ([]model.CardioSession, error) {
+	var res []model.CardioSession
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND start_time >= ? AND start_time < ?", userID, from, to).
+		Order("start_time DESC").
+		Find(&res).Error
+	return res, err
+}
@@ -0,0 +1,37 @@
+package cardio
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	domainRepo "github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(sql *mysql.MySQL) domainRepo.CardioRepository {
+	return &gormRepo{db: sql.DB}
+}
+
+// UpsertSessions inserts new activities and refreshes the ones imported
+// before, since activities can be edited at the source after upload.
+func (r *gormRepo) UpsertSessions(ctx context.Context, rows []model.CardioSession) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "source"}, {Name: "external_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"sport_type", "name", "start_time", "distance_meters", "moving_seconds",
+				"elapsed_seconds", "avg_heart_rate", "max_heart_rate", "elevation_gain_meters", "updated_at",
+			}),
+		}).
+		Create(&rows).Error
+}
@@ -0,0 +1,80 @@
+package coaching
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+func (r *gormRepo) GetRelationshipByID(ctx context.Context, id int) (*model.CoachingRelationship, error) {
+	var rel model.CoachingRelationship
+	err := r.db.WithContext(ctx).First(&rel, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &utilsErrors.Error{Message: "Coaching relationship not found"}
+		}
+		return nil, err
+	}
+	return &rel, nil
+}
+
+func (r *gormRepo) FindOpenRelationship(ctx context.Context, coachID int, clientEmail string) (*model.CoachingRelationship, error) {
+	var rel model.CoachingRelationship
+	err := r.db.WithContext(ctx).
+		Where("coach_id = ? AND client_email = ? AND status IN ?", coachID, clientEmail,
+			[]model.CoachingStatus{model.CoachingPending, model.CoachingActive}).
+		First(&rel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &rel, nil
+}
+
+func (r *gormRepo) ListByCoach(ctx context.Context, coachID int, status model.CoachingStatus) ([]model.CoachingRelationship, error) {
+	var res []model.CoachingRelationship
+	q := r.db.WithContext(ctx).Where("coach_id = ?", coachID)
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	err := q.Order("invited_at desc").Find(&res).Error
+	return res, err
+}
+
+func (r *gormRepo) ListPendingByEmail(ctx context.Context, clientEmail string) ([]model.CoachingRelationship, error) {
+	var res []model.CoachingRelationship
+	err := r.db.WithContext(ctx).
+		Where("client_email = ? AND status = ?", clientEmail, model.CoachingPending).
+		Order("invited_at desc").
+		Find(&res).Error
+	return res, err
+}
+
+func (r *gormRepo) GetActiveByClient(ctx context.Context, clientID int) (*model.CoachingRelationship, error) {
+	var rel model.CoachingRelationship
+	err := r.db.WithContext(ctx).
+		Where("client_id = ? AND status = ?", clientID, model.CoachingActive).
+		First(&rel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &utilsErrors.Error{Message: "No active coach"}
+		}
+		return nil, err
+	}
+	return &rel, nil
+}
+
+func (r *gormRepo) IsActiveCoach(ctx context.Context, coachID, clientID int) (bool, error) {
+	var n int64
+	err := r.db.WithContext(ctx).
+		Model(&model.CoachingRelationship{}).
+		Where("coach_id = ? AND client_id = ? AND status = ?", coachID, clientID, model.CoachingActive).
+		Count(&n).Error
+	return n > 0, err
+}
@@ -0,0 +1,36 @@
+package coaching
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	domainRepo "github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(sql *mysql.MySQL) domainRepo.CoachingRepository {
+	return &gormRepo{db: sql.DB}
+}
+
+func (r *gormRepo) CreateRelationship(ctx context.Context, rel model.CoachingRelationship) (*model.CoachingRelationship, error) {
+	if err := r.db.WithContext(ctx).Create(&rel).Error; err != nil {
+		return nil, err
+	}
+	return &rel, nil
+}
+
+func (r *gormRepo) UpdateRelationship(ctx context.Context, id int, rel model.CoachingRelationship) (*model.CoachingRelationship, error) {
+	if err := r.db.WithContext(ctx).
+		Model(&model.CoachingRelationship{}).
+		Where("id = ?", id).
+		Updates(&rel).Error; err != nil {
+		return nil, err
+	}
+	return r.GetRelationshipByID(ctx, id)
+}
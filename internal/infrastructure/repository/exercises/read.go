@@ -0,0 +1,153 @@
+package exercises
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	domainRepo "github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+var errExerciseNotFound = &utilsErrors.Error{Message: "Exercise not found", Status: http.StatusNotFound}
+
+// SearchExercises matches every word of the query as a prefix against the
+// name and aliases, best matches first. Queries without indexable words fall
+// back to a substring match; without a query entries are listed by name.
+func (r *gormRepo) SearchExercises(ctx context.Context, f domainRepo.ExerciseFilter) ([]model.Exercise, error) {
+	db := r.db.WithContext(ctx).Limit(f.Limit)
+	if f.Muscle != "" {
+		db = db.Where("CONCAT('|', primary_muscles, '|', secondary_muscles, '|') LIKE ?", "%|"+mysql.EscapeLike(string(f.Muscle))+"|%")
+	}
+	if f.Equipment != "" {
+		db = db.Where("equipment = ?", f.Equipment)
+	}
+	if f.Pattern != "" {
+		db = db.Where("movement_pattern = ?", f.Pattern)
+	}
+
+	var res []model.Exercise
+	query := strings.TrimSpace(f.Query)
+	if terms := mysql.BooleanTerms(query); terms != "" {
+		match := "MATCH(name, aliases) AGAINST(? IN BOOLEAN MODE)"
+		err := db.Where(match, terms).
+			Order(clause.OrderBy{Expression: clause.Expr{SQL: match + " DESC", Vars: []any{terms}}}).
+			Find(&res).Error
+		return res, err
+	}
+	if query != "" {
+		like := "%" + mysql.EscapeLike(query) + "%"
+		db = db.Where("name LIKE ? OR aliases LIKE ?", like, like)
+	}
+	err := db.Order("name").Find(&res).Error
+	return res, err
+}
+
+func (r *gormRepo) GetExerciseByID(ctx context.Context, id int) (*model.Exercise, error) {
+	var e model.Exercise
+	if err := r.db.WithContext(ctx).First(&e, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errExerciseNotFound
+		}
+		return nil, err
+	}
+	return &e, nil
+}
+
+// GetExercisesByIDs follows entries merged away to the entry they were merged
+// into. MergeExercises keeps merged_into_id pointing at a live entry, so one
+// hop is enough.
+func (r *gormRepo) GetExercisesByIDs(ctx context.Context, ids []int) (map[int]model.Exercise, error) {
+	res := make(map[int]model.Exercise, len(ids))
+	if len(ids) == 0 {
+		return res, nil
+	}
+	var rows []model.Exercise
+	if err := r.db.WithContext(ctx).Unscoped().Where("id IN ?", ids).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	merged := map[int]int{}
+	var intoIDs []int
+	for _, e := range rows {
+		switch {
+		case !e.DeletedAt.Valid:
+			res[e.ID] = e
+		case e.MergedIntoID != nil:
+			merged[e.ID] = *e.MergedIntoID
+			intoIDs = append(intoIDs, *e.MergedIntoID)
+		}
+	}
+	if len(intoIDs) == 0 {
+		return res, nil
+	}
+	var into []model.Exercise
+	if err := r.db.WithContext(ctx).Where("id IN ?", intoIDs).Find(&into).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[int]model.Exercise, len(into))
+	for _, e := range into {
+		byID[e.ID] = e
+	}
+	for id, intoID := range merged {
+		if e, ok := byID[intoID]; ok {
+			res[id] = e
+		}
+	}
+	return res, nil
+}
+
+// FindExercisesByNames looks names up by entry name first and then by alias,
+// ignoring case. An alias shared by several entries matches none of them.
+func (r *gormRepo) FindExercisesByNames(ctx context.Context, names []string) (map[string]model.Exercise, error) {
+	res := make(map[string]model.Exercise, len(names))
+	if len(names) == 0 {
+		return res, nil
+	}
+	var byName []model.Exercise
+	if err := r.db.WithContext(ctx).Where("name IN ?", names).Find(&byName).Error; err != nil {
+		return nil, err
+	}
+	for _, e := range byName {
+		res[strings.ToLower(e.Name)] = e
+	}
+
+	wanted := map[string]bool{}
+	var conds []string
+	var args []any
+	for _, n := range names {
+		if key := strings.ToLower(n); !wanted[key] {
+			if _, ok := res[key]; !ok {
+				wanted[key] = true
+				conds = append(conds, "LOCATE(?, CONCAT('|', aliases, '|')) > 0")
+				args = append(args, "|"+n+"|")
+			}
+		}
+	}
+	if len(conds) == 0 {
+		return res, nil
+	}
+	var byAlias []model.Exercise
+	if err := r.db.WithContext(ctx).Where(strings.Join(conds, " OR "), args...).Find(&byAlias).Error; err != nil {
+		return nil, err
+	}
+	matches := map[string][]model.Exercise{}
+	for _, e := range byAlias {
+		for _, a := range e.Aliases {
+			if key := strings.ToLower(a); wanted[key] {
+				matches[key] = append(matches[key], e)
+			}
+		}
+	}
+	for key, es := range matches {
+		if len(es) == 1 {
+			res[key] = es[0]
+		}
+	}
+	return res, nil
+}
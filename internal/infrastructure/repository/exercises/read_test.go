@@ -0,0 +1,95 @@
+package exercises
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	domainRepo "github.com/msskobelina/fit-profi/internal/domain/repository"
+)
+
+// Searching by the name of an entry merged away finds the entry it was merged
+// into, since the old name became one of its aliases. "BB" is too short to be
+// indexed and is dropped from the query.
+func TestSearchExercises_ByMergedName(t *testing.T) {
+	repo, mock := newMockRepo(t)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exercises` WHERE MATCH(name, aliases) AGAINST(? IN BOOLEAN MODE)")).
+		WithArgs("+squat*", "+squat*", 20).
+		WillReturnRows(sqlmock.NewRows(exerciseColumns).
+			AddRow(1, "barbell-back-squat", "Barbell back squat", "Back squat|BB squat|Squat", "barbell", "squat", nil, now, now, nil))
+
+	res, err := repo.SearchExercises(context.Background(), domainRepo.ExerciseFilter{Query: "BB squat", Limit: 20})
+	if err != nil {
+		t.Fatalf("SearchExercises: %v", err)
+	}
+	if len(res) != 1 || res[0].ID != 1 {
+		t.Errorf("found %+v, want the surviving entry", res)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetExercisesByIDs_FollowsMerges(t *testing.T) {
+	repo, mock := newMockRepo(t)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exercises` WHERE id IN (?,?,?)")).
+		WithArgs(1, 2, 3).
+		WillReturnRows(sqlmock.NewRows(exerciseColumns).
+			AddRow(1, "barbell-back-squat", "Barbell back squat", "", "barbell", "squat", nil, now, now, nil).
+			AddRow(2, "bb-squat", "BB squat", "", "barbell", "squat", 1, now, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exercises` WHERE id IN (?) AND `exercises`.`deleted_at` IS NULL")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows(exerciseColumns).
+			AddRow(1, "barbell-back-squat", "Barbell back squat", "", "barbell", "squat", nil, now, now, nil))
+
+	res, err := repo.GetExercisesByIDs(context.Background(), []int{1, 2, 3})
+	if err != nil {
+		t.Fatalf("GetExercisesByIDs: %v", err)
+	}
+	if res[1].ID != 1 || res[2].ID != 1 {
+		t.Errorf("got %+v, want 1 and 2 resolved to 1", res)
+	}
+	if _, ok := res[3]; ok {
+		t.Error("unknown ID resolved")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFindExercisesByNames(t *testing.T) {
+	repo, mock := newMockRepo(t)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exercises` WHERE name IN (?,?,?)")).
+		WithArgs("Barbell back squat", "bb squat", "Row").
+		WillReturnRows(sqlmock.NewRows(exerciseColumns).
+			AddRow(1, "barbell-back-squat", "Barbell back squat", "BB squat", "barbell", "squat", nil, now, now, nil))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exercises` WHERE (LOCATE(?, CONCAT('|', aliases, '|')) > 0 OR LOCATE(?, CONCAT('|', aliases, '|')) > 0)")).
+		WithArgs("|bb squat|", "|Row|").
+		WillReturnRows(sqlmock.NewRows(exerciseColumns).
+			AddRow(1, "barbell-back-squat", "Barbell back squat", "BB squat", "barbell", "squat", nil, now, now, nil).
+			AddRow(5, "barbell-row", "Barbell row", "Row", "barbell", "horizontal_pull", nil, now, now, nil).
+			AddRow(6, "cable-row", "Cable row", "Row", "cable", "horizontal_pull", nil, now, now, nil))
+
+	res, err := repo.FindExercisesByNames(context.Background(), []string{"Barbell back squat", "bb squat", "Row"})
+	if err != nil {
+		t.Fatalf("FindExercisesByNames: %v", err)
+	}
+	if res["barbell back squat"].ID != 1 || res["bb squat"].ID != 1 {
+		t.Errorf("got %+v, want the name and the alias resolved to 1", res)
+	}
+	if _, ok := res["row"]; ok {
+		t.Error("alias shared by two entries resolved")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
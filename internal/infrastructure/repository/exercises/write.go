@@ -0,0 +1,114 @@
+package exercises
+
+import (
+	"context"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	domainRepo "github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(sql *mysql.MySQL) domainRepo.ExercisesRepository {
+	return &gormRepo{db: sql.DB}
+}
+
+// InsertExercises skips entries whose slug exists, even when deleted by a
+// merge, so that seeding on startup never undoes an admin's merges.
+func (r *gormRepo) InsertExercises(ctx context.Context, exercises []model.Exercise) error {
+	if len(exercises) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "slug"}}, DoNothing: true}).
+		Create(&exercises).Error
+}
+
+const linkBatchSize = 500
+
+// LinkProgramExercises goes through the names of program exercises without a
+// catalog entry in batches and links those FindExercisesByNames resolves.
+func (r *gormRepo) LinkProgramExercises(ctx context.Context) (int, error) {
+	var names []string
+	err := r.db.WithContext(ctx).
+		Model(&model.ProgramExercise{}).
+		Where("catalog_id IS NULL AND name <> ''").
+		Distinct().
+		Pluck("name", &names).Error
+	if err != nil {
+		return 0, err
+	}
+
+	linked := 0
+	for start := 0; start < len(names); start += linkBatchSize {
+		batch := names[start:min(start+linkBatchSize, len(names))]
+		found, err := r.FindExercisesByNames(ctx, batch)
+		if err != nil {
+			return linked, err
+		}
+		for _, name := range batch {
+			e, ok := found[strings.ToLower(name)]
+			if !ok {
+				continue
+			}
+			res := r.db.WithContext(ctx).
+				Model(&model.ProgramExercise{}).
+				Where("catalog_id IS NULL AND name = ?", name).
+				Update("catalog_id", e.ID)
+			if res.Error != nil {
+				return linked, res.Error
+			}
+			linked += int(res.RowsAffected)
+		}
+	}
+	return linked, nil
+}
+
+// MergeExercises locks both entries, in ID order to avoid deadlocks, so that
+// concurrent merges of the same entries cannot lose aliases. Entries merged
+// into id before are pointed at intoID as well.
+func (r *gormRepo) MergeExercises(ctx context.Context, id, intoID int) (*model.Exercise, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var rows []model.Exercise
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", []int{id, intoID}).
+			Order("id").
+			Find(&rows).Error
+		if err != nil {
+			return err
+		}
+		if len(rows) != 2 {
+			return errExerciseNotFound
+		}
+		src, dst := rows[0], rows[1]
+		if src.ID != id {
+			src, dst = dst, src
+		}
+
+		dst.AddAliases(append([]string{src.Name}, src.Aliases...)...)
+		if err := tx.Model(&dst).Select("aliases", "updated_at").Updates(&model.Exercise{Aliases: dst.Aliases}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&model.ProgramExercise{}).Where("catalog_id = ?", src.ID).Update("catalog_id", dst.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Model(&model.Exercise{}).Where("merged_into_id = ?", src.ID).Update("merged_into_id", dst.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&src).Update("merged_into_id", dst.ID).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&src).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetExerciseByID(ctx, intoID)
+}
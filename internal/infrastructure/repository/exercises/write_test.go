@@ -0,0 +1,94 @@
+package exercises
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	gormMysql "gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockRepo(t *testing.T) (*gormRepo, sqlmock.Sqlmock) {
+	t.Helper()
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	db, err := gorm.Open(gormMysql.New(gormMysql.Config{Conn: conn, SkipInitializeWithVersion: true}),
+		&gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &gormRepo{db: db}, mock
+}
+
+var exerciseColumns = []string{"id", "slug", "name", "aliases", "equipment", "movement_pattern", "merged_into_id", "created_at", "updated_at", "deleted_at"}
+
+func TestMergeExercises(t *testing.T) {
+	repo, mock := newMockRepo(t)
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exercises` WHERE id IN (?,?) AND `exercises`.`deleted_at` IS NULL ORDER BY id FOR UPDATE")).
+		WithArgs(2, 1).
+		WillReturnRows(sqlmock.NewRows(exerciseColumns).
+			AddRow(1, "barbell-back-squat", "Barbell back squat", "Back squat", "barbell", "squat", nil, now, now, nil).
+			AddRow(2, "bb-squat", "BB squat", "Squat|back squat", "barbell", "squat", nil, now, now, nil))
+	// The old name and the aliases it brings along are folded in, without
+	// repeating ones the surviving entry already has.
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `exercises` SET `aliases`=?,`updated_at`=?")).
+		WithArgs("Back squat|BB squat|Squat", sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `program_exercises` SET `catalog_id`=?,`updated_at`=? WHERE catalog_id = ?")).
+		WithArgs(1, sqlmock.AnyArg(), 2).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	// Unscoped: entries merged into the source before are deleted already.
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `exercises` SET `merged_into_id`=?,`updated_at`=? WHERE merged_into_id = ?")).
+		WithArgs(1, sqlmock.AnyArg(), 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `exercises` SET `merged_into_id`=?,`updated_at`=? WHERE `exercises`.`deleted_at` IS NULL AND `id` = ?")).
+		WithArgs(1, sqlmock.AnyArg(), 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `exercises` SET `deleted_at`=? WHERE `exercises`.`id` = ?")).
+		WithArgs(sqlmock.AnyArg(), 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exercises` WHERE `exercises`.`id` = ?")).
+		WithArgs(1, 1).
+		WillReturnRows(sqlmock.NewRows(exerciseColumns).
+			AddRow(1, "barbell-back-squat", "Barbell back squat", "Back squat|BB squat|Squat", "barbell", "squat", nil, now, now, nil))
+
+	e, err := repo.MergeExercises(context.Background(), 2, 1)
+	if err != nil {
+		t.Fatalf("MergeExercises: %v", err)
+	}
+	if e.ID != 1 {
+		t.Errorf("merged into %d, want 1", e.ID)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMergeExercises_NotFound(t *testing.T) {
+	repo, mock := newMockRepo(t)
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `exercises`")).
+		WillReturnRows(sqlmock.NewRows(exerciseColumns).
+			AddRow(1, "barbell-back-squat", "Barbell back squat", "", "barbell", "squat", nil, now, now, nil))
+	mock.ExpectRollback()
+
+	if _, err := repo.MergeExercises(context.Background(), 2, 1); err != errExerciseNotFound {
+		t.Errorf("err = %v, want %v", err, errExerciseNotFound)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
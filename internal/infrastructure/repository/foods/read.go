@@ -0,0 +1,58 @@
+package foods
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+// SearchFoods matches every word of the query as a prefix against the food
+// name and brand, best matches first. Queries without indexable words fall
+// back to a name prefix match.
+func (r *gormRepo) SearchFoods(ctx context.Context, query string, limit int) ([]model.Food, error) {
+	var res []model.Food
+	db := r.db.WithContext(ctx).Limit(limit)
+	if terms := mysql.BooleanTerms(query); terms != "" {
+		match := "MATCH(name, brand) AGAINST(? IN BOOLEAN MODE)"
+		err := db.Where(match, terms).
+			Order(clause.OrderBy{Expression: clause.Expr{SQL: match + " DESC", Vars: []any{terms}}}).
+			Find(&res).Error
+		return res, err
+	}
+	err := db.Where("name LIKE ?", mysql.EscapeLike(strings.TrimSpace(query))+"%").
+		Order("name").
+		Find(&res).Error
+	return res, err
+}
+
+func (r *gormRepo) GetFoodByBarcode(ctx context.Context, barcode string) (*model.Food, error) {
+	var f model.Food
+	err := r.db.WithContext(ctx).
+		Where("barcode = ?", barcode).
+		Order("id").
+		First(&f).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &utilsErrors.Error{Message: "Food not found", Status: http.StatusNotFound}
+		}
+		return nil, err
+	}
+	return &f, nil
+}
+
+func (r *gormRepo) GetFoodsByIDs(ctx context.Context, ids []int) ([]model.Food, error) {
+	var res []model.Food
+	if len(ids) == 0 {
+		return res, nil
+	}
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&res).Error
+	return res, err
+}
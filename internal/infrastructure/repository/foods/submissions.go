@@ -0,0 +1,112 @@
+package foods
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+func (r *gormRepo) CreateFoodSubmission(ctx context.Context, s model.FoodSubmission) (*model.FoodSubmission, error) {
+	s.Status = model.FoodSubmissionPending
+	if err := r.db.WithContext(ctx).Create(&s).Error; err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *gormRepo) HasPendingSubmission(ctx context.Context, userID int, barcode string) (bool, error) {
+	var n int64
+	err := r.db.WithContext(ctx).
+		Model(&model.FoodSubmission{}).
+		Where("user_id = ? AND barcode = ? AND status = ?", userID, barcode, model.FoodSubmissionPending).
+		Count(&n).Error
+	return n > 0, err
+}
+
+// ListFoodSubmissions returns the oldest submissions first so the queue is
+// reviewed in order. An empty status lists all of them.
+func (r *gormRepo) ListFoodSubmissions(ctx context.Context, status model.FoodSubmissionStatus, limit, offset int) ([]model.FoodSubmission, int64, error) {
+	q := r.db.WithContext(ctx).Model(&model.FoodSubmission{})
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var res []model.FoodSubmission
+	err := q.Order("created_at, id").Limit(limit).Offset(offset).Find(&res).Error
+	return res, total, err
+}
+
+func (r *gormRepo) ApproveFoodSubmission(ctx context.Context, id, reviewerID int) (*model.FoodSubmission, error) {
+	var s model.FoodSubmission
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockPending(tx, id, &s); err != nil {
+			return err
+		}
+		var n int64
+		if err := tx.Model(&model.Food{}).Where("barcode = ?", s.Barcode).Count(&n).Error; err != nil {
+			return err
+		}
+		if n > 0 {
+			return &utilsErrors.Error{Message: "Food with this barcode already exists", Status: http.StatusConflict}
+		}
+		food := s.Food()
+		if err := tx.Create(&food).Error; err != nil {
+			return err
+		}
+		now := time.Now()
+		s.Status = model.FoodSubmissionApproved
+		s.ReviewedBy = &reviewerID
+		s.ReviewedAt = &now
+		s.FoodID = &food.ID
+		return tx.Model(&s).Select("status", "reviewed_by", "reviewed_at", "food_id").Updates(&s).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *gormRepo) RejectFoodSubmission(ctx context.Context, id, reviewerID int, reason string) (*model.FoodSubmission, error) {
+	var s model.FoodSubmission
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockPending(tx, id, &s); err != nil {
+			return err
+		}
+		now := time.Now()
+		s.Status = model.FoodSubmissionRejected
+		s.ReviewedBy = &reviewerID
+		s.ReviewedAt = &now
+		s.RejectReason = reason
+		return tx.Model(&s).Select("status", "reviewed_by", "reviewed_at", "reject_reason").Updates(&s).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// lockPending loads a submission FOR UPDATE so two admins cannot review it at
+// the same time, and fails unless it is still pending.
+func lockPending(tx *gorm.DB, id int, s *model.FoodSubmission) error {
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(s, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &utilsErrors.Error{Message: "Food submission not found", Status: http.StatusNotFound}
+		}
+		return err
+	}
+	if s.Status != model.FoodSubmissionPending {
+		return &utilsErrors.Error{Message: "Food submission is already reviewed", Status: http.StatusConflict}
+	}
+	return nil
+}
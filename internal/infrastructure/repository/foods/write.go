@@ -0,0 +1,36 @@
+package foods
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	domainRepo "github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(sql *mysql.MySQL) domainRepo.FoodsRepository {
+	return &gormRepo{db: sql.DB}
+}
+
+// UpsertFoods inserts new foods and refreshes the ones imported before from
+// the same source.
+func (r *gormRepo) UpsertFoods(ctx context.Context, foods []model.Food) error {
+	if len(foods) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "source"}, {Name: "external_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"name", "brand", "barcode", "serving_grams", "calories_per100g", "protein_per100g", "fat_per100g", "carbs_per100g", "updated_at",
+			}),
+		}).
+		Create(&foods).Error
+}
@@ -2,14 +2,38 @@ package integrations
 
 import (
 	"context"
+	"errors"
+	"net/http"
+
+	"gorm.io/gorm"
 
 	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
 )
 
 func (r *gormRepo) GetByUserAndProvider(ctx context.Context, userID int, provider model.Provider) (*model.UserIntegration, error) {
 	var u model.UserIntegration
 	if err := r.db.WithContext(ctx).Where("user_id = ? AND provider = ?", userID, provider).First(&u).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &utilsErrors.Error{Message: "Integration not found", Status: http.StatusNotFound}
+		}
+		return nil, err
+	}
+	if err := r.open(&u); err != nil {
 		return nil, err
 	}
 	return &u, nil
 }
+
+func (r *gormRepo) ListByUser(ctx context.Context, userID int) ([]model.UserIntegration, error) {
+	var res []model.UserIntegration
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("provider").Find(&res).Error; err != nil {
+		return nil, err
+	}
+	for i := range res {
+		if err := r.open(&res[i]); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
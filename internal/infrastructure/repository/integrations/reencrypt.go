@@ -0,0 +1,54 @@
+package integrations
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/pkg/envelope"
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+const reencryptBatchSize = 100
+
+// Reencrypt seals every token that is still plaintext or sealed with a retired
+// key using the primary key, and returns the number of rows rewritten. It is
+// safe to run repeatedly and while the API is serving: a row whose tokens were
+// refreshed since it was read already holds tokens sealed with the primary
+// key and is left alone.
+func Reencrypt(ctx context.Context, sql *mysql.MySQL, keys *envelope.Keyring) (int, error) {
+	r := &gormRepo{db: sql.DB, keys: keys}
+	updated := 0
+
+	var rows []model.UserIntegration
+	res := r.db.WithContext(ctx).Unscoped().FindInBatches(&rows, reencryptBatchSize, func(_ *gorm.DB, _ int) error {
+		for i := range rows {
+			row := rows[i]
+			if !keys.NeedsRotation(row.AccessToken) && !keys.NeedsRotation(row.RefreshToken) {
+				continue
+			}
+			sealedAccess, sealedRefresh := row.AccessToken, row.RefreshToken
+			if err := r.open(&row); err != nil {
+				return err
+			}
+			if err := r.seal(&row); err != nil {
+				return err
+			}
+			upd := r.db.WithContext(ctx).
+				Model(&model.UserIntegration{}).
+				Unscoped().
+				Where("id = ? AND access_token = ? AND refresh_token = ?", row.ID, sealedAccess, sealedRefresh).
+				Updates(map[string]any{"access_token": row.AccessToken, "refresh_token": row.RefreshToken})
+			if upd.Error != nil {
+				return upd.Error
+			}
+			if upd.RowsAffected > 0 {
+				updated++
+			}
+		}
+		return nil
+	})
+
+	return updated, res.Error
+}
@@ -2,26 +2,91 @@ package integrations
 
 import (
 	"context"
+	"net/http"
 
 	"gorm.io/gorm"
 
 	"github.com/msskobelina/fit-profi/internal/domain/model"
 	domainRepo "github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/pkg/envelope"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
 	"github.com/msskobelina/fit-profi/pkg/mysql"
 )
 
 type gormRepo struct {
-	db *gorm.DB
+	db   *gorm.DB
+	keys *envelope.Keyring
 }
 
-func NewRepository(sql *mysql.MySQL) domainRepo.IntegrationsRepository {
-	return &gormRepo{db: sql.DB}
+// NewRepository stores OAuth tokens sealed with keys; callers only ever see
+// plaintext tokens.
+func NewRepository(sql *mysql.MySQL, keys *envelope.Keyring) domainRepo.IntegrationsRepository {
+	return &gormRepo{db: sql.DB, keys: keys}
 }
 
 func (r *gormRepo) Upsert(ctx context.Context, row model.UserIntegration) (*model.UserIntegration, error) {
+	if err := r.seal(&row); err != nil {
+		return nil, err
+	}
 	err := r.db.WithContext(ctx).
 		Where("user_id = ? AND provider = ?", row.UserID, row.Provider).
 		Assign(&row).
 		FirstOrCreate(&row).Error
-	return &row, err
+	if err != nil {
+		return nil, err
+	}
+	if err = r.open(&row); err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+func (r *gormRepo) UpdateStatus(ctx context.Context, userID int, provider model.Provider, status model.IntegrationStatus, lastError string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.UserIntegration{}).
+		Where("user_id = ? AND provider = ?", userID, provider).
+		Updates(map[string]any{"status": status, "last_error": lastError}).Error
+}
+
+func (r *gormRepo) seal(row *model.UserIntegration) (err error) {
+	if row.AccessToken, err = r.keys.Encrypt(row.AccessToken); err != nil {
+		return err
+	}
+	row.RefreshToken, err = r.keys.Encrypt(row.RefreshToken)
+	return err
+}
+
+func (r *gormRepo) open(row *model.UserIntegration) (err error) {
+	if row.AccessToken, err = r.keys.Decrypt(row.AccessToken); err != nil {
+		return err
+	}
+	row.RefreshToken, err = r.keys.Decrypt(row.RefreshToken)
+	return err
+}
+
+func (r *gormRepo) UpdateSyncCursor(ctx context.Context, userID int, provider model.Provider, cursor int64) error {
+	return r.db.WithContext(ctx).
+		Model(&model.UserIntegration{}).
+		Where("user_id = ? AND provider = ?", userID, provider).
+		Update("sync_cursor", cursor).Error
+}
+
+func (r *gormRepo) UpdateCalendar(ctx context.Context, userID int, provider model.Provider, calendarID, timezone string) error {
+	return r.db.WithContext(ctx).
+		Model(&model.UserIntegration{}).
+		Where("user_id = ? AND provider = ?", userID, provider).
+		Updates(map[string]any{"calendar_id": calendarID, "timezone": timezone}).Error
+}
+
+func (r *gormRepo) Delete(ctx context.Context, userID int, provider model.Provider) error {
+	res := r.db.WithContext(ctx).
+		Where("user_id = ? AND provider = ?", userID, provider).
+		Delete(&model.UserIntegration{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return &utilsErrors.Error{Message: "Integration not found", Status: http.StatusNotFound}
+	}
+	return nil
 }
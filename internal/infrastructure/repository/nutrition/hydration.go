@@ -0,0 +1,52 @@
+package nutrition
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+func (r *gormRepo) CreateHydrationEntry(ctx context.Context, h model.HydrationEntry) (*model.HydrationEntry, error) {
+	if err := r.db.WithContext(ctx).Create(&h).Error; err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+func (r *gormRepo) ListHydrationEntries(ctx context.Context, userID int, date time.Time) ([]model.HydrationEntry, error) {
+	var entries []model.HydrationEntry
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND date >= ? AND date < ?", userID, start, start.Add(24*time.Hour)).
+		Order("id").
+		Find(&entries).Error
+	return entries, err
+}
+
+func (r *gormRepo) DeleteHydrationEntry(ctx context.Context, id, userID int) error {
+	res := r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		Delete(&model.HydrationEntry{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return &utilsErrors.Error{Message: "Hydration entry not found", Status: http.StatusNotFound}
+	}
+	return nil
+}
+
+func (r *gormRepo) SummarizeHydration(ctx context.Context, userID int, from, to time.Time) ([]model.HydrationDay, error) {
+	var days []model.HydrationDay
+	err := r.db.WithContext(ctx).
+		Model(&model.HydrationEntry{}).
+		Select("date, SUM(amount_ml) AS amount_ml, SUM(caffeine_mg) AS caffeine_mg").
+		Where("user_id = ? AND date >= ? AND date < ?", userID, from, to).
+		Group("date").
+		Order("date").
+		Scan(&days).Error
+	return days, err
+}
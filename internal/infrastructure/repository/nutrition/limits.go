@@ -0,0 +1,41 @@
+package nutrition
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+func (r *gormRepo) GetLimits(ctx context.Context, userID int) (*model.NutritionLimits, error) {
+	var l model.NutritionLimits
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&l).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &l, nil
+}
+
+// UpsertLimits keeps one row per user and overwrites every limit, so a nil
+// field turns that check off.
+func (r *gormRepo) UpsertLimits(ctx context.Context, l model.NutritionLimits) (*model.NutritionLimits, error) {
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"set_by", "sugar_max_g", "sodium_max_mg", "caffeine_max_mg",
+				"fibre_min_g", "potassium_min_mg", "water_min_ml", "updated_at",
+			}),
+		}).
+		Create(&l).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.GetLimits(ctx, l.UserID)
+}
@@ -0,0 +1,90 @@
+package nutrition
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// summaryRow is one line of the ROLLUP result: a meal of a day, a day subtotal
+// (MealType nil) or the grand total (Date and MealType nil).
+type summaryRow struct {
+	Date        *time.Time
+	MealType    *string
+	Calories    float64
+	ProteinG    float64
+	FatG        float64
+	CarbsG      float64
+	FibreG      float64
+	SugarG      float64
+	SodiumMg    float64
+	PotassiumMg float64
+	CaffeineMg  float64
+	WaterMl     float64
+}
+
+func (r *gormRepo) SummarizeEntries(ctx context.Context, userID int, from, to time.Time) (*model.NutritionSummary, error) {
+	var rows []summaryRow
+	// Entry dates are stored as midnight of the diary day, so grouping by the
+	// raw column groups by day without depending on the session time zone.
+	err := r.db.WithContext(ctx).
+		Table("diary_entries AS e").
+		Select(`e.date AS date, e.meal_type AS meal_type,
+			COALESCE(SUM(i.calories), 0) AS calories,
+			COALESCE(SUM(i.protein_g), 0) AS protein_g,
+			COALESCE(SUM(i.fat_g), 0) AS fat_g,
+			COALESCE(SUM(i.carbs_g), 0) AS carbs_g,
+			COALESCE(SUM(i.fibre_g), 0) AS fibre_g,
+			COALESCE(SUM(i.sugar_g), 0) AS sugar_g,
+			COALESCE(SUM(i.sodium_mg), 0) AS sodium_mg,
+			COALESCE(SUM(i.potassium_mg), 0) AS potassium_mg,
+			COALESCE(SUM(i.caffeine_mg), 0) AS caffeine_mg,
+			COALESCE(SUM(i.water_ml), 0) AS water_ml`).
+		Joins("JOIN diary_items AS i ON i.entry_id = e.id AND i.deleted_at IS NULL").
+		Where("e.user_id = ? AND e.date >= ? AND e.date < ? AND e.deleted_at IS NULL", userID, from, to).
+		Group("e.date, e.meal_type WITH ROLLUP").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &model.NutritionSummary{Days: []model.NutritionDay{}}
+	days := map[string]*model.NutritionDay{}
+	for _, row := range rows {
+		totals := model.NutritionTotals{
+			Calories:    row.Calories,
+			ProteinG:    row.ProteinG,
+			FatG:        row.FatG,
+			CarbsG:      row.CarbsG,
+			FibreG:      row.FibreG,
+			SugarG:      row.SugarG,
+			SodiumMg:    row.SodiumMg,
+			PotassiumMg: row.PotassiumMg,
+			CaffeineMg:  row.CaffeineMg,
+			WaterMl:     row.WaterMl,
+		}
+		if row.Date == nil {
+			summary.Totals = totals
+			continue
+		}
+		key := row.Date.UTC().Format("2006-01-02")
+		day, ok := days[key]
+		if !ok {
+			day = &model.NutritionDay{Date: key, Meals: map[string]model.NutritionTotals{}}
+			days[key] = day
+		}
+		if row.MealType == nil {
+			day.Totals = totals
+		} else {
+			day.Meals[*row.MealType] = totals
+		}
+	}
+	for _, day := range days {
+		summary.Days = append(summary.Days, *day)
+	}
+	sort.Slice(summary.Days, func(i, j int) bool { return summary.Days[i].Date < summary.Days[j].Date })
+	summary.LoggedDays = len(summary.Days)
+	return summary, nil
+}
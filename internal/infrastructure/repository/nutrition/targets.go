@@ -0,0 +1,55 @@
+package nutrition
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+func (r *gormRepo) GetTargetOverride(ctx context.Context, userID int) (*model.NutritionTargetOverride, error) {
+	var o model.NutritionTargetOverride
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&o).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &o, nil
+}
+
+// UpsertTargetOverride keeps one override per user; a new one from any coach
+// replaces the previous values.
+func (r *gormRepo) UpsertTargetOverride(ctx context.Context, o model.NutritionTargetOverride) (*model.NutritionTargetOverride, error) {
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"coach_id", "calories", "protein_g", "fat_g", "carbs_g", "updated_at"}),
+		}).
+		Create(&o).Error
+	if err != nil {
+		return nil, err
+	}
+	return r.GetTargetOverride(ctx, o.UserID)
+}
+
+// DeleteTargetOverride removes the row for good so the unique user index does
+// not trip over it when targets are set again.
+func (r *gormRepo) DeleteTargetOverride(ctx context.Context, userID int) error {
+	res := r.db.WithContext(ctx).Unscoped().
+		Where("user_id = ?", userID).
+		Delete(&model.NutritionTargetOverride{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return &utilsErrors.Error{Message: "Nutrition targets not found", Status: http.StatusNotFound}
+	}
+	return nil
+}
@@ -2,11 +2,15 @@ package nutrition
 
 import (
 	"context"
+	"errors"
+	"net/http"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/msskobelina/fit-profi/internal/domain/model"
 	domainRepo "github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
 	"github.com/msskobelina/fit-profi/pkg/mysql"
 )
 
@@ -25,16 +29,134 @@ func (r *gormRepo) CreateEntry(ctx context.Context, e model.DiaryEntry) (*model.
 	return &e, nil
 }
 
+func (r *gormRepo) CreateEntries(ctx context.Context, es []model.DiaryEntry) ([]model.DiaryEntry, error) {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range es {
+			if err := tx.Create(&es[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return es, nil
+}
+
 func (r *gormRepo) UpdateEntry(ctx context.Context, id, userID int, e model.DiaryEntry) (*model.DiaryEntry, error) {
-	if err := r.db.WithContext(ctx).
-		Model(&model.DiaryEntry{}).
-		Where("id = ? AND user_id = ?", id, userID).
-		Updates(&e).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockEntry(tx, id, userID); err != nil {
+			return err
+		}
+		if err := tx.Model(&model.DiaryEntry{}).
+			Where("id = ?", id).
+			Update("meal_type", e.MealType).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("entry_id = ?", id).Delete(&model.DiaryItem{}).Error; err != nil {
+			return err
+		}
+		if len(e.Items) == 0 {
+			return nil
+		}
+		for i := range e.Items {
+			e.Items[i].ID = 0
+			e.Items[i].EntryID = id
+		}
+		return tx.Create(&e.Items).Error
+	})
+	if err != nil {
 		return nil, err
 	}
 	return r.GetEntryByID(ctx, id, userID)
 }
 
+func (r *gormRepo) AddItem(ctx context.Context, entryID, userID int, it model.DiaryItem) (*model.DiaryEntry, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockEntry(tx, entryID, userID); err != nil {
+			return err
+		}
+		it.ID = 0
+		it.EntryID = entryID
+		return tx.Create(&it).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetEntryByID(ctx, entryID, userID)
+}
+
+func (r *gormRepo) UpdateItem(ctx context.Context, entryID, itemID, userID int, patch func(*model.DiaryItem) error) (*model.DiaryEntry, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockEntry(tx, entryID, userID); err != nil {
+			return err
+		}
+		var it model.DiaryItem
+		if err := tx.Where("id = ? AND entry_id = ?", itemID, entryID).First(&it).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errItemNotFound
+			}
+			return err
+		}
+		if err := patch(&it); err != nil {
+			return err
+		}
+		return tx.Model(&model.DiaryItem{}).
+			Where("id = ?", itemID).
+			Select("food_id", "recipe_id", "name", "grams", "calories", "protein_g", "fat_g", "carbs_g",
+				"fibre_g", "sugar_g", "sodium_mg", "potassium_mg", "caffeine_mg", "water_ml", "updated_at").
+			Updates(&it).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetEntryByID(ctx, entryID, userID)
+}
+
+// DeleteItem refuses to remove the last item of an entry; the entry itself
+// should be deleted instead.
+func (r *gormRepo) DeleteItem(ctx context.Context, entryID, itemID, userID int) (*model.DiaryEntry, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockEntry(tx, entryID, userID); err != nil {
+			return err
+		}
+		var n int64
+		if err := tx.Model(&model.DiaryItem{}).Where("entry_id = ?", entryID).Count(&n).Error; err != nil {
+			return err
+		}
+		res := tx.Where("id = ? AND entry_id = ?", itemID, entryID).Delete(&model.DiaryItem{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return errItemNotFound
+		}
+		if n <= 1 {
+			return &utilsErrors.Error{Message: "Entry must keep at least one item; delete the entry instead", Status: http.StatusConflict}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetEntryByID(ctx, entryID, userID)
+}
+
+var errItemNotFound = &utilsErrors.Error{Message: "Item not found", Status: http.StatusNotFound}
+
+// lockEntry locks the entry row so concurrent item edits of the same entry
+// are applied one after another.
+func lockEntry(tx *gorm.DB, id, userID int) error {
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&model.DiaryEntry{}).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &utilsErrors.Error{Message: "Entry not found", Status: http.StatusNotFound}
+	}
+	return err
+}
+
 func (r *gormRepo) DeleteEntry(ctx context.Context, id, userID int) error {
 	return r.db.WithContext(ctx).
 		Where("id = ? AND user_id = ?", id, userID).
@@ -3,6 +3,7 @@ package profiles
 import (
 	"context"
 	"errors"
+	"net/http"
 
 	"gorm.io/gorm"
 
@@ -15,7 +16,7 @@ func (r *gormRepo) GetUserProfileByUserID(ctx context.Context, userID int) (*mod
 	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&p).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, &utilsErrors.Error{Message: "Profile not found"}
+			return nil, &utilsErrors.Error{Message: "Profile not found", Status: http.StatusNotFound}
 		}
 		return nil, err
 	}
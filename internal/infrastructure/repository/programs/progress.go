@@ -0,0 +1,102 @@
+package programs
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+func (r *gormRepo) ListProgress(ctx context.Context, userID, exerciseID int, from, to time.Time) ([]model.ExerciseProgress, error) {
+	q := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if exerciseID != 0 {
+		q = q.Where("exercise_id = ?", exerciseID)
+	}
+	if !from.IsZero() {
+		q = q.Where("created_at >= ?", from.UTC())
+	}
+	if !to.IsZero() {
+		q = q.Where("created_at < ?", to.UTC())
+	}
+	var res []model.ExerciseProgress
+	err := q.Order("created_at desc").Find(&res).Error
+	return res, err
+}
+
+// performedRow is a session set or a progress row joined with its exercise.
+// SetCount is how many identical sets the row stands for.
+type performedRow struct {
+	ExerciseID int
+	CatalogID  *int
+	Name       string
+	Date       time.Time
+	Reps       int
+	WeightKg   float64
+	SetCount   int
+}
+
+// ListPerformedSets returns the completed sets of finished sessions and the
+// sets recorded as aggregate progress, each progress row expanded into its
+// number of sets. Sets of a catalog exercise are named after the catalog
+// entry, and filtering by an exercise includes every program exercise of its
+// catalog entry.
+func (r *gormRepo) ListPerformedSets(ctx context.Context, userID, exerciseID int, from, to time.Time) ([]model.PerformedSet, error) {
+	db := r.db.WithContext(ctx)
+
+	sessions := db.Table("workout_sets AS s").
+		Select("s.exercise_id, pe.catalog_id, COALESCE(ex.name, pe.name) AS name, ws.started_at AS date, s.reps, s.weight_kg, 1 AS set_count").
+		Joins("JOIN workout_sessions ws ON ws.id = s.session_id AND ws.deleted_at IS NULL").
+		Joins("JOIN program_exercises pe ON pe.id = s.exercise_id").
+		Joins("LEFT JOIN exercises ex ON ex.id = pe.catalog_id").
+		Where("s.deleted_at IS NULL AND s.completed AND ws.user_id = ? AND ws.status = ?", userID, model.SessionFinished)
+	progress := db.Table("exercise_progresses AS p").
+		Select("p.exercise_id, pe.catalog_id, COALESCE(ex.name, pe.name) AS name, p.created_at AS date, p.reps, p.weight_kg, p.sets AS set_count").
+		Joins("JOIN program_exercises pe ON pe.id = p.exercise_id").
+		Joins("LEFT JOIN exercises ex ON ex.id = pe.catalog_id").
+		Where("p.deleted_at IS NULL AND p.user_id = ?", userID)
+	if exerciseID != 0 {
+		sessions = sessions.Where("s.exercise_id IN (?)", r.sameExercise(exerciseID))
+		progress = progress.Where("p.exercise_id IN (?)", r.sameExercise(exerciseID))
+	}
+	if !from.IsZero() {
+		sessions = sessions.Where("ws.started_at >= ?", from.UTC())
+		progress = progress.Where("p.created_at >= ?", from.UTC())
+	}
+	if !to.IsZero() {
+		sessions = sessions.Where("ws.started_at < ?", to.UTC())
+		progress = progress.Where("p.created_at < ?", to.UTC())
+	}
+
+	var rows []performedRow
+	if err := db.Raw("? UNION ALL ? ORDER BY date", sessions, progress).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	var res []model.PerformedSet
+	for _, row := range rows {
+		catalogID := 0
+		if row.CatalogID != nil {
+			catalogID = *row.CatalogID
+		}
+		for i := 0; i < row.SetCount; i++ {
+			res = append(res, model.PerformedSet{
+				ExerciseID: row.ExerciseID,
+				CatalogID:  catalogID,
+				Name:       row.Name,
+				Date:       row.Date,
+				Reps:       row.Reps,
+				WeightKg:   row.WeightKg,
+			})
+		}
+	}
+	return res, nil
+}
+
+// sameExercise selects the IDs of the program exercises that count as the
+// given one: itself and those of the same catalog entry.
+func (r *gormRepo) sameExercise(exerciseID int) *gorm.DB {
+	return r.db.Table("program_exercises").
+		Select("id").
+		Where("id = ? OR catalog_id = (SELECT catalog_id FROM program_exercises WHERE id = ?)", exerciseID, exerciseID)
+}
@@ -3,6 +3,8 @@ package programs
 import (
 	"context"
 	"errors"
+	"net/http"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -17,7 +19,26 @@ func (r *gormRepo) GetProgramByID(ctx context.Context, id int) (*model.TrainingP
 		First(&p, id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, &utilsErrors.Error{Message: "Program not found"}
+			return nil, &utilsErrors.Error{Message: "Program not found", Status: http.StatusNotFound}
+		}
+		return nil, err
+	}
+	return &p, nil
+}
+
+// GetProgramByExerciseID returns the program (without days) that contains the
+// given exercise.
+func (r *gormRepo) GetProgramByExerciseID(ctx context.Context, exerciseID int) (*model.TrainingProgram, error) {
+	var p model.TrainingProgram
+	err := r.db.WithContext(ctx).
+		Preload("Days.Exercises").
+		Joins("JOIN program_days pd ON pd.program_id = training_programs.id AND pd.deleted_at IS NULL").
+		Joins("JOIN program_exercises pe ON pe.day_id = pd.id AND pe.deleted_at IS NULL").
+		Where("pe.id = ?", exerciseID).
+		First(&p).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &utilsErrors.Error{Message: "Exercise not found", Status: http.StatusNotFound}
 		}
 		return nil, err
 	}
@@ -32,3 +53,46 @@ func (r *gormRepo) ListProgramsByUserID(ctx context.Context, userID int) ([]mode
 		Find(&ps).Error
 	return ps, err
 }
+
+// ListProgressByProgram returns the progress logged against a program. With a
+// coachID it also includes the copies assigned from the program to athletes
+// that coach currently coaches.
+func (r *gormRepo) ListProgressByProgram(ctx context.Context, programID, coachID int) ([]model.ExerciseProgress, error) {
+	q := r.db.WithContext(ctx).
+		Model(&model.ExerciseProgress{}).
+		Joins("JOIN program_exercises pe ON pe.id = exercise_progresses.exercise_id AND pe.deleted_at IS NULL").
+		Joins("JOIN program_days pd ON pd.id = pe.day_id AND pd.deleted_at IS NULL").
+		Joins("JOIN training_programs tp ON tp.id = pd.program_id AND tp.deleted_at IS NULL")
+	if coachID != 0 {
+		q = q.Where(`tp.id = ? OR (tp.assigned_from_id = ? AND EXISTS (
+			SELECT 1 FROM coaching_relationships cr
+			WHERE cr.coach_id = ? AND cr.client_id = tp.user_id AND cr.status = ? AND cr.deleted_at IS NULL))`,
+			programID, programID, coachID, model.CoachingActive)
+	} else {
+		q = q.Where("tp.id = ?", programID)
+	}
+
+	var res []model.ExerciseProgress
+	err := q.Order("exercise_progresses.created_at desc").Find(&res).Error
+	return res, err
+}
+
+func (r *gormRepo) GetScheduledWorkoutByID(ctx context.Context, id int) (*model.ScheduledWorkout, error) {
+	var w model.ScheduledWorkout
+	if err := r.db.WithContext(ctx).First(&w, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &utilsErrors.Error{Message: "Scheduled workout not found", Status: http.StatusNotFound}
+		}
+		return nil, err
+	}
+	return &w, nil
+}
+
+func (r *gormRepo) ListScheduledWorkouts(ctx context.Context, userID int, from, to time.Time) ([]model.ScheduledWorkout, error) {
+	var res []model.ScheduledWorkout
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND start_time < ? AND end_time > ?", userID, to.UTC(), from.UTC()).
+		Order("start_time asc").
+		Find(&res).Error
+	return res, err
+}
@@ -0,0 +1,29 @@
+package programs
+
+import (
+	"context"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+)
+
+// ListRecords filters by exercise like ListPerformedSets, so the records of a
+// catalog exercise span every program it appears in.
+func (r *gormRepo) ListRecords(ctx context.Context, userID, exerciseID int, kind model.RecordKind, from, to time.Time) ([]model.PersonalRecord, error) {
+	q := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if exerciseID != 0 {
+		q = q.Where("exercise_id IN (?)", r.sameExercise(exerciseID))
+	}
+	if kind != "" {
+		q = q.Where("kind = ?", kind)
+	}
+	if !from.IsZero() {
+		q = q.Where("achieved_at >= ?", from.UTC())
+	}
+	if !to.IsZero() {
+		q = q.Where("achieved_at < ?", to.UTC())
+	}
+	var res []model.PersonalRecord
+	err := q.Order("achieved_at desc, id desc").Find(&res).Error
+	return res, err
+}
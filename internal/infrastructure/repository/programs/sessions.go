@@ -0,0 +1,106 @@
+package programs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+var errSessionNotFound = &utilsErrors.Error{Message: "Workout session not found", Status: http.StatusNotFound}
+
+func (r *gormRepo) CreateSession(ctx context.Context, s model.WorkoutSession) (*model.WorkoutSession, error) {
+	if err := r.db.WithContext(ctx).Create(&s).Error; err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *gormRepo) GetSessionByID(ctx context.Context, id int) (*model.WorkoutSession, error) {
+	var s model.WorkoutSession
+	err := r.db.WithContext(ctx).
+		Preload("Sets", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Preload("Records", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		First(&s, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errSessionNotFound
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *gormRepo) GetActiveSession(ctx context.Context, userID int) (*model.WorkoutSession, error) {
+	var s model.WorkoutSession
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status = ?", userID, model.SessionInProgress).
+		First(&s).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &s, nil
+}
+
+// CloseSession locks the session row so that a concurrent finish and abandon
+// cannot both succeed. The personal records of the session are saved with its
+// sets.
+func (r *gormRepo) CloseSession(ctx context.Context, s model.WorkoutSession) (*model.WorkoutSession, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var cur model.WorkoutSession
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND user_id = ?", s.ID, s.UserID).
+			First(&cur).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errSessionNotFound
+			}
+			return err
+		}
+		if cur.Status != model.SessionInProgress {
+			return &utilsErrors.Error{Message: "Workout session is already " + string(cur.Status), Status: http.StatusConflict}
+		}
+		for i := range s.Sets {
+			s.Sets[i].SessionID = s.ID
+		}
+		if len(s.Sets) > 0 {
+			if err := tx.Create(&s.Sets).Error; err != nil {
+				return err
+			}
+		}
+		for i := range s.Records {
+			s.Records[i].SessionID = &s.ID
+		}
+		if len(s.Records) > 0 {
+			if err := tx.Create(&s.Records).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&cur).
+			Select("status", "ended_at", "notes", "updated_at").
+			Updates(&model.WorkoutSession{Status: s.Status, EndedAt: s.EndedAt, Notes: s.Notes}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetSessionByID(ctx, s.ID)
+}
+
+func (r *gormRepo) ListSessions(ctx context.Context, userID int, from, to time.Time) ([]model.WorkoutSession, error) {
+	var res []model.WorkoutSession
+	err := r.db.WithContext(ctx).
+		Preload("Sets", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Where("user_id = ? AND started_at >= ? AND started_at < ?", userID, from.UTC(), to.UTC()).
+		Order("started_at desc").
+		Find(&res).Error
+	return res, err
+}
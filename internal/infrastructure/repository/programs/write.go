@@ -2,11 +2,14 @@ package programs
 
 import (
 	"context"
+	"net/http"
+	"time"
 
 	"gorm.io/gorm"
 
 	"github.com/msskobelina/fit-profi/internal/domain/model"
 	domainRepo "github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
 	"github.com/msskobelina/fit-profi/pkg/mysql"
 )
 
@@ -25,10 +28,31 @@ func (r *gormRepo) CreateProgram(ctx context.Context, p model.TrainingProgram) (
 	return &p, nil
 }
 
+func (r *gormRepo) CreatePrograms(ctx context.Context, ps []model.TrainingProgram) ([]model.TrainingProgram, error) {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range ps {
+			if err := tx.Create(&ps[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
 func (r *gormRepo) DeleteProgram(ctx context.Context, id, userID int) error {
-	return r.db.WithContext(ctx).
+	res := r.db.WithContext(ctx).
 		Where("id = ? AND user_id = ?", id, userID).
-		Delete(&model.TrainingProgram{}).Error
+		Delete(&model.TrainingProgram{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return &utilsErrors.Error{Message: "Program not found", Status: http.StatusNotFound}
+	}
+	return nil
 }
 
 func (r *gormRepo) TrackProgress(ctx context.Context, prog model.ExerciseProgress) (*model.ExerciseProgress, error) {
@@ -37,3 +61,33 @@ func (r *gormRepo) TrackProgress(ctx context.Context, prog model.ExerciseProgres
 	}
 	return &prog, nil
 }
+
+func (r *gormRepo) CreateScheduledWorkout(ctx context.Context, w model.ScheduledWorkout) (*model.ScheduledWorkout, error) {
+	if err := r.db.WithContext(ctx).Create(&w).Error; err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+func (r *gormRepo) UpdateScheduledWorkout(ctx context.Context, id int, start, end time.Time) (*model.ScheduledWorkout, error) {
+	if err := r.db.WithContext(ctx).
+		Model(&model.ScheduledWorkout{}).
+		Where("id = ?", id).
+		Updates(map[string]any{"start_time": start, "end_time": end}).Error; err != nil {
+		return nil, err
+	}
+	return r.GetScheduledWorkoutByID(ctx, id)
+}
+
+func (r *gormRepo) DeleteScheduledWorkout(ctx context.Context, id, userID int) error {
+	res := r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		Delete(&model.ScheduledWorkout{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return &utilsErrors.Error{Message: "Scheduled workout not found", Status: http.StatusNotFound}
+	}
+	return nil
+}
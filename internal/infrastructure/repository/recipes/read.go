@@ -0,0 +1,37 @@
+package recipes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"gorm.io/gorm"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+func (r *gormRepo) GetRecipeByID(ctx context.Context, id, userID int) (*model.Recipe, error) {
+	var rec model.Recipe
+	err := r.db.WithContext(ctx).
+		Preload("Ingredients").
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&rec).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &utilsErrors.Error{Message: "Recipe not found", Status: http.StatusNotFound}
+		}
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func (r *gormRepo) ListRecipes(ctx context.Context, userID int) ([]model.Recipe, error) {
+	var res []model.Recipe
+	err := r.db.WithContext(ctx).
+		Preload("Ingredients").
+		Where("user_id = ?", userID).
+		Order("name, id").
+		Find(&res).Error
+	return res, err
+}
@@ -0,0 +1,88 @@
+package recipes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"gorm.io/gorm"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	domainRepo "github.com/msskobelina/fit-profi/internal/domain/repository"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+	"github.com/msskobelina/fit-profi/pkg/mysql"
+)
+
+type gormRepo struct {
+	db *gorm.DB
+}
+
+func NewRepository(sql *mysql.MySQL) domainRepo.RecipesRepository {
+	return &gormRepo{db: sql.DB}
+}
+
+func (r *gormRepo) CreateRecipe(ctx context.Context, rec model.Recipe) (*model.Recipe, error) {
+	if err := r.db.WithContext(ctx).Create(&rec).Error; err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func (r *gormRepo) CreateRecipes(ctx context.Context, rs []model.Recipe) ([]model.Recipe, error) {
+	if err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range rs {
+			if err := tx.Create(&rs[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+func (r *gormRepo) UpdateRecipe(ctx context.Context, id, userID int, rec model.Recipe) (*model.Recipe, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Select("id").Where("id = ? AND user_id = ?", id, userID).First(&model.Recipe{}).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return &utilsErrors.Error{Message: "Recipe not found", Status: http.StatusNotFound}
+			}
+			return err
+		}
+		if err := tx.Model(&model.Recipe{}).
+			Where("id = ?", id).
+			Select("name", "servings", "yield_grams", "updated_at").
+			Updates(&rec).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("recipe_id = ?", id).Delete(&model.RecipeIngredient{}).Error; err != nil {
+			return err
+		}
+		for i := range rec.Ingredients {
+			rec.Ingredients[i].ID = 0
+			rec.Ingredients[i].RecipeID = id
+		}
+		if len(rec.Ingredients) == 0 {
+			return nil
+		}
+		return tx.Create(&rec.Ingredients).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetRecipeByID(ctx, id, userID)
+}
+
+func (r *gormRepo) DeleteRecipe(ctx context.Context, id, userID int) error {
+	res := r.db.WithContext(ctx).
+		Where("id = ? AND user_id = ?", id, userID).
+		Delete(&model.Recipe{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return &utilsErrors.Error{Message: "Recipe not found", Status: http.StatusNotFound}
+	}
+	return nil
+}
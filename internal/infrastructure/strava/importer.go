@@ -0,0 +1,163 @@
+// Package strava imports athlete activities from the Strava v3 API into
+// cardio sessions.
+package strava
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/providers"
+	utilsErrors "github.com/msskobelina/fit-profi/pkg/errors"
+)
+
+// APIURL is the base URL of the Strava v3 API.
+const APIURL = "https://www.strava.com/api/v3"
+
+// Importer pulls the activities a user recorded since the previous import.
+type Importer interface {
+	Import(ctx context.Context, userID int) (int, error)
+}
+
+type Config struct {
+	Providers *providers.Registry
+	// BaseURL overrides APIURL, e.g. to point at a fake server in tests.
+	BaseURL  string
+	PageSize int
+}
+
+type activity struct {
+	ID                 int64     `json:"id"`
+	Name               string    `json:"name"`
+	SportType          string    `json:"sport_type"`
+	StartDate          time.Time `json:"start_date"`
+	Distance           float64   `json:"distance"`
+	MovingTime         int       `json:"moving_time"`
+	ElapsedTime        int       `json:"elapsed_time"`
+	TotalElevationGain float64   `json:"total_elevation_gain"`
+	HasHeartrate       bool      `json:"has_heartrate"`
+	AverageHeartrate   float64   `json:"average_heartrate"`
+	MaxHeartrate       float64   `json:"max_heartrate"`
+}
+
+type importer struct {
+	integrations repository.IntegrationsRepository
+	cardio       repository.CardioRepository
+	cfg          Config
+}
+
+func NewImporter(
+	integrations repository.IntegrationsRepository,
+	cardio repository.CardioRepository,
+	cfg Config,
+) Importer {
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = APIURL
+	}
+	if cfg.PageSize <= 0 {
+		cfg.PageSize = 100
+	}
+	return &importer{integrations: integrations, cardio: cardio, cfg: cfg}
+}
+
+// Import asks Strava for activities started after the integration's cursor,
+// oldest first, and moves the cursor forward after every saved page. An
+// import cut short by an error or rate limit resumes where it stopped.
+func (i *importer) Import(ctx context.Context, userID int) (int, error) {
+	integ, err := i.integrations.GetByUserAndProvider(ctx, userID, model.ProviderStrava)
+	if err != nil {
+		return 0, err
+	}
+	ts, err := i.cfg.Providers.TokenSource(ctx, i.integrations, integ)
+	if err != nil {
+		return 0, err
+	}
+	client := oauth2.NewClient(ctx, ts)
+
+	imported := 0
+	cursor := integ.SyncCursor
+	for {
+		page, err := i.fetch(ctx, client, cursor)
+		if err != nil {
+			return imported, err
+		}
+
+		rows := make([]model.CardioSession, 0, len(page))
+		for _, a := range page {
+			rows = append(rows, toSession(userID, a))
+			if s := a.StartDate.Unix(); s > cursor {
+				cursor = s
+			}
+		}
+		if err = i.cardio.UpsertSessions(ctx, rows); err != nil {
+			return imported, err
+		}
+		if err = i.integrations.UpdateSyncCursor(ctx, userID, model.ProviderStrava, cursor); err != nil {
+			return imported, err
+		}
+		imported += len(rows)
+
+		if len(page) < i.cfg.PageSize {
+			return imported, nil
+		}
+	}
+}
+
+func (i *importer) fetch(ctx context.Context, client *http.Client, after int64) ([]activity, error) {
+	q := url.Values{
+		"after":    {strconv.FormatInt(after, 10)},
+		"per_page": {strconv.Itoa(i.cfg.PageSize)},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, i.cfg.BaseURL+"/athlete/activities?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return nil, &utilsErrors.Error{Message: "Strava rate limit reached, try again later", Status: http.StatusTooManyRequests}
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("strava: list activities: status %d", resp.StatusCode)
+	}
+
+	var page []activity
+	if err = json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func toSession(userID int, a activity) model.CardioSession {
+	s := model.CardioSession{
+		UserID:              userID,
+		Source:              model.CardioSourceStrava,
+		ExternalID:          strconv.FormatInt(a.ID, 10),
+		SportType:           a.SportType,
+		Name:                a.Name,
+		StartTime:           a.StartDate,
+		DistanceMeters:      a.Distance,
+		MovingSeconds:       a.MovingTime,
+		ElapsedSeconds:      a.ElapsedTime,
+		ElevationGainMeters: a.TotalElevationGain,
+	}
+	if a.HasHeartrate {
+		avg, maxHR := a.AverageHeartrate, a.MaxHeartrate
+		s.AvgHeartRate = &avg
+		s.MaxHeartRate = &maxHR
+	}
+	return s
+}
@@ -0,0 +1,183 @@
+package strava_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/msskobelina/fit-profi/internal/domain/model"
+	"github.com/msskobelina/fit-profi/internal/domain/repository"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/providers"
+	"github.com/msskobelina/fit-profi/internal/infrastructure/strava"
+)
+
+type fakeIntegrationsRepo struct {
+	repository.IntegrationsRepository
+
+	integ model.UserIntegration
+}
+
+func (f *fakeIntegrationsRepo) GetByUserAndProvider(_ context.Context, _ int, _ model.Provider) (*model.UserIntegration, error) {
+	cp := f.integ
+	return &cp, nil
+}
+
+func (f *fakeIntegrationsRepo) UpdateSyncCursor(_ context.Context, _ int, _ model.Provider, cursor int64) error {
+	f.integ.SyncCursor = cursor
+	return nil
+}
+
+type fakeCardioRepo struct {
+	repository.CardioRepository
+
+	sessions map[string]model.CardioSession
+}
+
+func (f *fakeCardioRepo) UpsertSessions(_ context.Context, rows []model.CardioSession) error {
+	for _, r := range rows {
+		f.sessions[r.ExternalID] = r
+	}
+	return nil
+}
+
+// fakeStrava serves activities with the API's "after" filtering, oldest
+// first, and fails with 429 once failAfter requests were served.
+type fakeStrava struct {
+	activities []map[string]any
+	requests   []string
+	failAfter  int
+}
+
+func (f *fakeStrava) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/athlete/activities" || r.Header.Get("Authorization") != "Bearer token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	f.requests = append(f.requests, r.URL.Query().Get("after"))
+	if f.failAfter > 0 && len(f.requests) > f.failAfter {
+		w.WriteHeader(http.StatusTooManyRequests)
+		return
+	}
+
+	after, _ := strconv.ParseInt(r.URL.Query().Get("after"), 10, 64)
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	page := []map[string]any{}
+	for _, a := range f.activities {
+		if a["start"].(time.Time).Unix() > after && len(page) < perPage {
+			page = append(page, map[string]any{
+				"id":                   a["id"],
+				"name":                 "Morning Run",
+				"sport_type":           "Run",
+				"start_date":           a["start"],
+				"distance":             5012.3,
+				"moving_time":          1500,
+				"elapsed_time":         1620,
+				"total_elevation_gain": 42.0,
+				"has_heartrate":        a["hr"] != nil,
+				"average_heartrate":    a["hr"],
+				"max_heartrate":        a["hr"],
+			})
+		}
+	}
+	_ = json.NewEncoder(w).Encode(page)
+}
+
+func newImporter(srv *httptest.Server, integ *fakeIntegrationsRepo, cardio *fakeCardioRepo) strava.Importer {
+	return strava.NewImporter(integ, cardio, strava.Config{
+		Providers: providers.NewRegistry(providers.NewStrava(providers.Config{TokenURL: srv.URL + "/oauth/token"})),
+		BaseURL:   srv.URL,
+		PageSize:  2,
+	})
+}
+
+func TestImport(t *testing.T) {
+	base := time.Date(2025, 5, 1, 6, 0, 0, 0, time.UTC)
+	fake := &fakeStrava{}
+	for i := 0; i < 5; i++ {
+		a := map[string]any{"id": 100 + i, "start": base.Add(time.Duration(i) * 24 * time.Hour)}
+		if i%2 == 0 {
+			a["hr"] = 150.0
+		}
+		fake.activities = append(fake.activities, a)
+	}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	integ := &fakeIntegrationsRepo{integ: model.UserIntegration{
+		UserID:      7,
+		Provider:    model.ProviderStrava,
+		AccessToken: "token",
+		ExpiryUnix:  time.Now().Add(time.Hour).Unix(),
+	}}
+	cardio := &fakeCardioRepo{sessions: map[string]model.CardioSession{}}
+	imp := newImporter(srv, integ, cardio)
+
+	n, err := imp.Import(context.Background(), 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 5 || len(cardio.sessions) != 5 {
+		t.Fatalf("imported %d, stored %d, want 5", n, len(cardio.sessions))
+	}
+	if got := integ.integ.SyncCursor; got != base.Add(4*24*time.Hour).Unix() {
+		t.Errorf("cursor = %d, want newest start", got)
+	}
+	s := cardio.sessions["100"]
+	if s.UserID != 7 || s.DistanceMeters != 5012.3 || s.AvgHeartRate == nil || *s.AvgHeartRate != 150 {
+		t.Errorf("unexpected session: %+v", s)
+	}
+	if cardio.sessions["101"].AvgHeartRate != nil {
+		t.Error("expected no heart rate for activity without HR data")
+	}
+
+	// A new activity is the only thing the next import picks up.
+	fake.activities = append(fake.activities, map[string]any{"id": 200, "start": base.Add(10 * 24 * time.Hour)})
+	fake.requests = nil
+	n, err = imp.Import(context.Background(), 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 || len(fake.requests) != 1 || fake.requests[0] != strconv.FormatInt(base.Add(4*24*time.Hour).Unix(), 10) {
+		t.Errorf("imported %d with requests %v, want 1 after previous cursor", n, fake.requests)
+	}
+}
+
+func TestImportResumes(t *testing.T) {
+	base := time.Date(2025, 5, 1, 6, 0, 0, 0, time.UTC)
+	fake := &fakeStrava{failAfter: 1}
+	for i := 0; i < 4; i++ {
+		fake.activities = append(fake.activities, map[string]any{"id": 100 + i, "start": base.Add(time.Duration(i) * time.Hour)})
+	}
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	integ := &fakeIntegrationsRepo{integ: model.UserIntegration{
+		UserID:      7,
+		Provider:    model.ProviderStrava,
+		AccessToken: "token",
+		ExpiryUnix:  time.Now().Add(time.Hour).Unix(),
+	}}
+	cardio := &fakeCardioRepo{sessions: map[string]model.CardioSession{}}
+	imp := newImporter(srv, integ, cardio)
+
+	n, err := imp.Import(context.Background(), 7)
+	if err == nil {
+		t.Fatal("expected rate limit error")
+	}
+	if n != 2 || integ.integ.SyncCursor != base.Add(time.Hour).Unix() {
+		t.Fatalf("imported %d with cursor %d before failing", n, integ.integ.SyncCursor)
+	}
+
+	fake.failAfter = 0
+	n, err = imp.Import(context.Background(), 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 || len(cardio.sessions) != 4 {
+		t.Errorf("resumed import got %d, stored %d", n, len(cardio.sessions))
+	}
+}
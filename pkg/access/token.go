@@ -11,6 +11,7 @@ type UserRole string
 
 var (
 	UserRoleUser  UserRole = "user"
+	UserRoleCoach UserRole = "coach"
 	UserRoleAdmin UserRole = "admin"
 )
 
@@ -18,6 +19,7 @@ type Token struct {
 	jwt.RegisteredClaims
 	UserID   int      `json:"userId"`
 	UserRole UserRole `json:"userRole"`
+	FamilyID string   `json:"fid,omitempty"`
 }
 
 func EncodeToken(t *Token, hmacSecret string) (string, error) {
@@ -0,0 +1,62 @@
+// Package barcode validates retail product codes: EAN-8, UPC-A, EAN-13 and
+// GTIN-14.
+package barcode
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrChecksum = errors.New("barcode: invalid check digit")
+
+// Normalize strips spaces and dashes, verifies the length and check digit
+// and returns the code in a canonical form: EAN-8 codes are kept as they are,
+// UPC-A and GTIN-14 codes with leading zeros become the equivalent EAN-13, so
+// a product scanned as UPC or EAN resolves to the same key.
+func Normalize(code string) (string, error) {
+	code = strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, code)
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return "", fmt.Errorf("barcode: %q must contain only digits", code)
+		}
+	}
+	switch len(code) {
+	case 8, 13:
+	case 12:
+		code = "0" + code
+	case 14:
+		if code[0] != '0' {
+			// Packaging indicator digits identify cases, not consumer units.
+			return "", fmt.Errorf("barcode: GTIN-14 %q is not a consumer unit code", code)
+		}
+	default:
+		return "", fmt.Errorf("barcode: %q must have 8, 12, 13 or 14 digits", code)
+	}
+	if !validChecksum(code) {
+		return "", ErrChecksum
+	}
+	if len(code) == 14 {
+		code = code[1:]
+	}
+	return code, nil
+}
+
+// validChecksum applies the GS1 mod-10 check: digits are weighted 3 and 1
+// alternately from the right, starting next to the check digit.
+func validChecksum(code string) bool {
+	sum := 0
+	for i := len(code) - 2; i >= 0; i-- {
+		d := int(code[i] - '0')
+		if (len(code)-2-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10-sum%10)%10 == int(code[len(code)-1]-'0')
+}
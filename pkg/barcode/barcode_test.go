@@ -0,0 +1,45 @@
+package barcode_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/msskobelina/fit-profi/pkg/barcode"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		want     string
+		wantErr  bool
+		checksum bool
+	}{
+		{name: "ean-13", in: "3017620422003", want: "3017620422003"},
+		{name: "ean-13 with spaces", in: "3 017620 422003", want: "3017620422003"},
+		{name: "upc-a", in: "036000291452", want: "0036000291452"},
+		{name: "ean-8", in: "96385074", want: "96385074"},
+		{name: "gtin-14 consumer unit", in: "03017620422003", want: "3017620422003"},
+		{name: "gtin-14 case", in: "13017620422000", wantErr: true},
+		{name: "bad check digit", in: "3017620422004", wantErr: true, checksum: true},
+		{name: "bad upc check digit", in: "036000291453", wantErr: true, checksum: true},
+		{name: "letters", in: "30176204220AB", wantErr: true},
+		{name: "wrong length", in: "12345", wantErr: true},
+		{name: "empty", in: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := barcode.Normalize(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.checksum && !errors.Is(err, barcode.ErrChecksum) {
+				t.Errorf("err = %v, want ErrChecksum", err)
+			}
+			if got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
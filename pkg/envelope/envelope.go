@@ -0,0 +1,167 @@
+// Package envelope encrypts short secrets with AES-GCM envelope encryption:
+// every value gets a fresh data key, and the data key is sealed with a
+// versioned key-encryption key so keys can be rotated without losing access
+// to older values.
+//
+// Sealed values look like "enc:<keyID>:<sealed data key>:<ciphertext>", with
+// both binary parts base64url-encoded and prefixed by their GCM nonce.
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const prefix = "enc:"
+
+var ErrMalformed = errors.New("envelope: malformed value")
+
+type Keyring struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+// New builds a keyring that encrypts with primaryID and can decrypt with any
+// of keys. Keys must be 16, 24 or 32 bytes long.
+func New(primaryID string, keys map[string][]byte) (*Keyring, error) {
+	if _, ok := keys[primaryID]; !ok {
+		return nil, fmt.Errorf("envelope: primary key %q not found", primaryID)
+	}
+	k := &Keyring{primary: primaryID, keys: make(map[string]cipher.AEAD, len(keys))}
+	for id, key := range keys {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("envelope: invalid key id %q", id)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("envelope: key %q: %w", id, err)
+		}
+		k.keys[id] = aead
+	}
+	return k, nil
+}
+
+// Parse reads keys in the "id:base64key,id:base64key" form used by the
+// TOKEN_ENCRYPTION_KEYS variable.
+func Parse(primaryID, spec string) (*Keyring, error) {
+	keys := map[string][]byte{}
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, enc, ok := strings.Cut(part, ":")
+		if !ok {
+			return nil, fmt.Errorf("envelope: key %q must be id:base64", part)
+		}
+		key, err := base64.StdEncoding.DecodeString(enc)
+		if err != nil {
+			return nil, fmt.Errorf("envelope: key %q: %w", id, err)
+		}
+		keys[id] = key
+	}
+	return New(primaryID, keys)
+}
+
+// PrimaryID returns the ID of the key new values are sealed with.
+func (k *Keyring) PrimaryID() string {
+	return k.primary
+}
+
+// Encrypt seals plaintext with the primary key. The empty string stays empty
+// so optional columns keep their zero value.
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	dek := make([]byte, 32)
+	if _, err := rand.Read(dek); err != nil {
+		return "", err
+	}
+	data, err := newAEAD(dek)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(data, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	wrapped, err := seal(k.keys[k.primary], dek)
+	if err != nil {
+		return "", err
+	}
+	return prefix + k.primary + ":" + wrapped + ":" + ciphertext, nil
+}
+
+// Decrypt opens a sealed value. Values written before encryption was enabled
+// are returned unchanged.
+func (k *Keyring) Decrypt(value string) (string, error) {
+	if !IsSealed(value) {
+		return value, nil
+	}
+	parts := strings.Split(strings.TrimPrefix(value, prefix), ":")
+	if len(parts) != 3 {
+		return "", ErrMalformed
+	}
+	kek, ok := k.keys[parts[0]]
+	if !ok {
+		return "", fmt.Errorf("envelope: unknown key %q", parts[0])
+	}
+	dek, err := open(kek, parts[1])
+	if err != nil {
+		return "", err
+	}
+	data, err := newAEAD(dek)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(data, parts[2])
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation reports whether value is plaintext or sealed with a key other
+// than the primary one.
+func (k *Keyring) NeedsRotation(value string) bool {
+	if value == "" {
+		return false
+	}
+	return !strings.HasPrefix(value, prefix+k.primary+":")
+}
+
+// IsSealed reports whether value was produced by Encrypt.
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func seal(aead cipher.AEAD, plaintext []byte) (string, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(aead.Seal(nonce, nonce, plaintext, nil)), nil
+}
+
+func open(aead cipher.AEAD, encoded string) ([]byte, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil || len(raw) < aead.NonceSize() {
+		return nil, ErrMalformed
+	}
+	nonce, ciphertext := raw[:aead.NonceSize()], raw[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
@@ -0,0 +1,116 @@
+package envelope_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/msskobelina/fit-profi/pkg/envelope"
+)
+
+var (
+	key1 = bytes.Repeat([]byte{1}, 32)
+	key2 = bytes.Repeat([]byte{2}, 32)
+)
+
+func TestRoundTrip(t *testing.T) {
+	k, err := envelope.New("v1", map[string][]byte{"v1": key1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sealed, err := k.Encrypt("ya29.token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !envelope.IsSealed(sealed) || strings.Contains(sealed, "ya29") {
+		t.Fatalf("value not sealed: %q", sealed)
+	}
+	other, _ := k.Encrypt("ya29.token")
+	if other == sealed {
+		t.Error("expected a fresh data key per value")
+	}
+
+	got, err := k.Decrypt(sealed)
+	if err != nil || got != "ya29.token" {
+		t.Fatalf("Decrypt = %q, %v", got, err)
+	}
+
+	if empty, _ := k.Encrypt(""); empty != "" {
+		t.Errorf("empty value sealed to %q", empty)
+	}
+	if plain, _ := k.Decrypt("legacy"); plain != "legacy" {
+		t.Errorf("plaintext value decrypted to %q", plain)
+	}
+}
+
+func TestRotation(t *testing.T) {
+	old, _ := envelope.New("v1", map[string][]byte{"v1": key1})
+	sealed, _ := old.Encrypt("secret")
+
+	k, err := envelope.New("v2", map[string][]byte{"v1": key1, "v2": key2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !k.NeedsRotation(sealed) || !k.NeedsRotation("legacy") || k.NeedsRotation("") {
+		t.Error("unexpected NeedsRotation result")
+	}
+	got, err := k.Decrypt(sealed)
+	if err != nil || got != "secret" {
+		t.Fatalf("Decrypt = %q, %v", got, err)
+	}
+	resealed, _ := k.Encrypt(got)
+	if k.NeedsRotation(resealed) {
+		t.Error("value sealed with primary key needs rotation")
+	}
+
+	if _, err = old.Decrypt(resealed); err == nil {
+		t.Error("expected unknown key error")
+	}
+}
+
+func TestTampered(t *testing.T) {
+	k, _ := envelope.New("v1", map[string][]byte{"v1": key1})
+	sealed, _ := k.Encrypt("secret")
+
+	i := strings.LastIndex(sealed, ":") + 1
+	flipped := []byte(sealed)
+	if flipped[i] == 'A' {
+		flipped[i] = 'B'
+	} else {
+		flipped[i] = 'A'
+	}
+	if _, err := k.Decrypt(string(flipped)); err == nil {
+		t.Error("expected tampered value to fail")
+	}
+	if _, err := k.Decrypt("enc:v1:garbage"); err == nil {
+		t.Error("expected malformed value to fail")
+	}
+}
+
+func TestParse(t *testing.T) {
+	b64 := base64.StdEncoding.EncodeToString
+	tests := []struct {
+		name    string
+		primary string
+		spec    string
+		wantErr bool
+	}{
+		{name: "single", primary: "v1", spec: "v1:" + b64(key1)},
+		{name: "several", primary: "v2", spec: "v1:" + b64(key1) + ", v2:" + b64(key2)},
+		{name: "missing primary", primary: "v3", spec: "v1:" + b64(key1), wantErr: true},
+		{name: "short key", primary: "v1", spec: "v1:" + b64([]byte("short")), wantErr: true},
+		{name: "no id", primary: "v1", spec: b64(key1), wantErr: true},
+		{name: "empty", primary: "v1", spec: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := envelope.Parse(tt.primary, tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
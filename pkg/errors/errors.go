@@ -1,6 +1,10 @@
 package errors
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
 
 // swagger:model serviceError
 type Error struct {
@@ -20,3 +24,10 @@ func (e *Error) Error() string {
 
 	return string(b)
 }
+
+// IsNotFound reports whether err carries a 404 status, as repositories return
+// for missing rows.
+func IsNotFound(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.Status == http.StatusNotFound
+}
@@ -0,0 +1,32 @@
+package mysql
+
+import (
+	"strings"
+	"unicode"
+)
+
+// minTokenLen is InnoDB's default innodb_ft_min_token_size; shorter words are
+// not in the full-text index.
+const minTokenLen = 3
+
+// BooleanTerms turns free text into a boolean-mode query requiring each word
+// as a prefix, e.g. "greek yog" becomes "+greek* +yog*". Operator characters
+// are dropped so user input cannot change the query's meaning. It returns ""
+// when no word is long enough to be indexed.
+func BooleanTerms(query string) string {
+	words := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	terms := make([]string, 0, len(words))
+	for _, w := range words {
+		if len([]rune(w)) >= minTokenLen {
+			terms = append(terms, "+"+w+"*")
+		}
+	}
+	return strings.Join(terms, " ")
+}
+
+// EscapeLike escapes the wildcards of a LIKE pattern.
+func EscapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
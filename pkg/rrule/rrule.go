@@ -0,0 +1,195 @@
+// Package rrule implements the subset of RFC 5545 recurrence rules used for
+// coach availability: FREQ=DAILY|WEEKLY with INTERVAL, BYDAY, COUNT and UNTIL.
+package rrule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	Daily  Frequency = "DAILY"
+	Weekly Frequency = "WEEKLY"
+)
+
+// maxPeriods caps expansion so a rule without COUNT or UNTIL can't loop
+// forever when asked for a far-away window.
+const maxPeriods = 366 * 10
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	ByDay    []time.Weekday
+	Count    int
+	Until    time.Time
+}
+
+// Parse parses an RRULE value such as "FREQ=WEEKLY;BYDAY=MO,WE;UNTIL=20250101".
+// A date-only or floating UNTIL is interpreted in loc.
+func Parse(s string, loc *time.Location) (*Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	r := &Rule{Interval: 1}
+	for _, part := range strings.Split(s, ";") {
+		if part == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("rrule: malformed part %q", part)
+		}
+		switch strings.ToUpper(k) {
+		case "FREQ":
+			r.Freq = Frequency(strings.ToUpper(v))
+			if r.Freq != Daily && r.Freq != Weekly {
+				return nil, fmt.Errorf("rrule: unsupported FREQ %q", v)
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("rrule: invalid INTERVAL %q", v)
+			}
+			r.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("rrule: invalid COUNT %q", v)
+			}
+			r.Count = n
+		case "UNTIL":
+			t, err := parseUntil(v, loc)
+			if err != nil {
+				return nil, err
+			}
+			r.Until = t
+		case "BYDAY":
+			for _, d := range strings.Split(v, ",") {
+				wd, ok := weekdays[strings.ToUpper(d)]
+				if !ok {
+					return nil, fmt.Errorf("rrule: unsupported BYDAY %q", d)
+				}
+				r.ByDay = append(r.ByDay, wd)
+			}
+		case "WKST":
+			if strings.ToUpper(v) != "MO" {
+				return nil, fmt.Errorf("rrule: only WKST=MO is supported")
+			}
+		default:
+			return nil, fmt.Errorf("rrule: unsupported part %q", k)
+		}
+	}
+	if r.Freq == "" {
+		return nil, fmt.Errorf("rrule: FREQ is required")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return nil, fmt.Errorf("rrule: COUNT and UNTIL are mutually exclusive")
+	}
+	return r, nil
+}
+
+func parseUntil(v string, loc *time.Location) (time.Time, error) {
+	if strings.HasSuffix(v, "Z") {
+		if t, err := time.Parse("20060102T150405Z", v); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := time.ParseInLocation("20060102T150405", v, loc); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102", v, loc); err == nil {
+		// a date-only UNTIL includes the whole day
+		return t.AddDate(0, 0, 1).Add(-time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("rrule: invalid UNTIL %q", v)
+}
+
+// Between returns the occurrences of the rule anchored at dtstart whose start
+// falls in [from, to). Occurrences keep dtstart's wall-clock time in its
+// location, so they follow daylight saving changes.
+func (r *Rule) Between(dtstart, from, to time.Time) []time.Time {
+	loc := dtstart.Location()
+	y, m, d := dtstart.Date()
+	hh, mm, ss := dtstart.Clock()
+
+	// periods are counted from the Monday of dtstart's week (WKST=MO) for
+	// weekly rules and from dtstart itself for daily ones
+	periodDays := r.Interval
+	start := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	if r.Freq == Weekly {
+		periodDays = 7 * r.Interval
+		start = start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+	}
+
+	byDay := r.ByDay
+	if len(byDay) == 0 && r.Freq == Weekly {
+		byDay = []time.Weekday{dtstart.Weekday()}
+	}
+
+	// without COUNT nothing depends on earlier occurrences, so jump straight
+	// to the period just before the window
+	first := 0
+	if r.Count == 0 && from.After(start) {
+		first = int(from.Sub(start).Hours()/24)/periodDays - 1
+		if first < 0 {
+			first = 0
+		}
+	}
+
+	var (
+		out   []time.Time
+		count int
+	)
+	for p := first; p < first+maxPeriods; p++ {
+		periodStart := start.AddDate(0, 0, p*periodDays)
+		days := 1
+		if r.Freq == Weekly {
+			days = 7
+		}
+		for i := 0; i < days; i++ {
+			day := periodStart.AddDate(0, 0, i)
+			if len(byDay) > 0 && !containsWeekday(byDay, day.Weekday()) {
+				continue
+			}
+			occ := time.Date(day.Year(), day.Month(), day.Day(), hh, mm, ss, 0, loc)
+			if occ.Before(dtstart) {
+				continue
+			}
+			if !r.Until.IsZero() && occ.After(r.Until) {
+				return out
+			}
+			count++
+			if r.Count > 0 && count > r.Count {
+				return out
+			}
+			if !occ.Before(to) {
+				return out
+			}
+			if !occ.Before(from) {
+				out = append(out, occ)
+			}
+		}
+	}
+	return out
+}
+
+func containsWeekday(days []time.Weekday, d time.Weekday) bool {
+	for _, x := range days {
+		if x == d {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package rrule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/msskobelina/fit-profi/pkg/rrule"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		wantErr bool
+	}{
+		{name: "weekly with days", in: "FREQ=WEEKLY;BYDAY=MO,WE,FR"},
+		{name: "prefixed", in: "RRULE:FREQ=DAILY;INTERVAL=2;COUNT=5"},
+		{name: "until date", in: "FREQ=WEEKLY;UNTIL=20250101"},
+		{name: "missing freq", in: "BYDAY=MO", wantErr: true},
+		{name: "monthly", in: "FREQ=MONTHLY", wantErr: true},
+		{name: "bad day", in: "FREQ=WEEKLY;BYDAY=XX", wantErr: true},
+		{name: "count and until", in: "FREQ=DAILY;COUNT=2;UNTIL=20250101", wantErr: true},
+		{name: "malformed", in: "FREQ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := rrule.Parse(tt.in, time.UTC)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBetween(t *testing.T) {
+	kyiv, err := time.LoadLocation("Europe/Kyiv")
+	if err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+	// Monday 9:00 local
+	dtstart := time.Date(2025, 3, 24, 9, 0, 0, 0, kyiv)
+
+	tests := []struct {
+		name     string
+		rule     string
+		from, to time.Time
+		want     []time.Time
+	}{
+		{
+			name: "weekly on two days",
+			rule: "FREQ=WEEKLY;BYDAY=MO,WE",
+			from: dtstart,
+			to:   dtstart.AddDate(0, 0, 7),
+			want: []time.Time{
+				dtstart,
+				time.Date(2025, 3, 26, 9, 0, 0, 0, kyiv),
+			},
+		},
+		{
+			name: "keeps wall clock across DST",
+			rule: "FREQ=WEEKLY",
+			from: time.Date(2025, 10, 20, 0, 0, 0, 0, kyiv),
+			to:   time.Date(2025, 11, 1, 0, 0, 0, 0, kyiv),
+			want: []time.Time{
+				time.Date(2025, 10, 20, 9, 0, 0, 0, kyiv),
+				time.Date(2025, 10, 27, 9, 0, 0, 0, kyiv),
+			},
+		},
+		{
+			name: "every other week",
+			rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO",
+			from: dtstart,
+			to:   dtstart.AddDate(0, 0, 28),
+			want: []time.Time{
+				dtstart,
+				dtstart.AddDate(0, 0, 14),
+			},
+		},
+		{
+			name: "count stops expansion",
+			rule: "FREQ=DAILY;COUNT=3",
+			from: dtstart.AddDate(0, 0, 1),
+			to:   dtstart.AddDate(0, 0, 10),
+			want: []time.Time{
+				dtstart.AddDate(0, 0, 1),
+				dtstart.AddDate(0, 0, 2),
+			},
+		},
+		{
+			name: "until is inclusive",
+			rule: "FREQ=DAILY;UNTIL=20250325",
+			from: dtstart,
+			to:   dtstart.AddDate(0, 0, 10),
+			want: []time.Time{
+				dtstart,
+				dtstart.AddDate(0, 0, 1),
+			},
+		},
+		{
+			name: "window far after start",
+			rule: "FREQ=DAILY",
+			from: time.Date(2030, 1, 1, 0, 0, 0, 0, kyiv),
+			to:   time.Date(2030, 1, 2, 0, 0, 0, 0, kyiv),
+			want: []time.Time{
+				time.Date(2030, 1, 1, 9, 0, 0, 0, kyiv),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := rrule.Parse(tt.rule, kyiv)
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			got := r.Between(dtstart, tt.from, tt.to)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("occurrence %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}